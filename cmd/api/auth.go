@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/mrityunjaygr8/go-oink/internal/repository"
+	"github.com/mrityunjaygr8/go-oink/internal/validator"
 	"github.com/rs/zerolog/hlog"
 )
 
@@ -33,6 +34,14 @@ func (s *Server) AuthLogin() http.HandlerFunc {
 			return
 		}
 
+		v := validator.New()
+		v.Check(validator.NotBlank(req.Email), "email", "must be provided")
+		v.Check(req.Password != "", "password", "must be provided")
+		if !v.Valid() {
+			s.failedValidationResponse(w, v.Errors)
+			return
+		}
+
 		repo := repository.New(s.db, *logger)
 		user, err := repo.UserRepository.UserAuthenticate(r.Context(), req.Email, req.Password)
 		if err != nil {
@@ -77,12 +86,13 @@ func (s *Server) AuthLogin() http.HandlerFunc {
 
 func (s *Server) AuthMe() http.HandlerFunc {
 	type response struct {
-		Email     string    `json:"email"`
-		Password  string    `json:"-"`
-		Username  string    `json:"username"`
-		ID        string    `json:"id"`
-		CreatedAt time.Time `json:"created_at"`
-		UpdatedAt time.Time `json:"updated_at,omitempty"`
+		Email     string     `json:"email"`
+		Password  string     `json:"-"`
+		Username  string     `json:"username"`
+		ID        string     `json:"id"`
+		Avatar    *imageURLs `json:"avatar"`
+		CreatedAt time.Time  `json:"created_at"`
+		UpdatedAt time.Time  `json:"updated_at,omitempty"`
 	}
 	return func(w http.ResponseWriter, r *http.Request) {
 		logger := hlog.FromRequest(r)
@@ -95,10 +105,15 @@ func (s *Server) AuthMe() http.HandlerFunc {
 			return
 		}
 
+		if s.notModified(w, r, userETag(u)) {
+			return
+		}
+
 		res := response{
 			Email:     u.Email,
 			Username:  u.Username,
 			ID:        u.ID,
+			Avatar:    s.imageURLs(u.AvatarKey),
 			CreatedAt: u.CreatedAt,
 			UpdatedAt: u.UpdatedAt,
 		}
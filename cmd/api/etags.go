@@ -0,0 +1,87 @@
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mrityunjaygr8/go-oink/internal/repository"
+)
+
+// versionTag builds a strong ETag out of what identifies a version of a
+// resource. updated_at is rounded to the microseconds postgres keeps, so a
+// version read back from the database carries the tag of the one that was
+// just written.
+func versionTag(id string, updatedAt time.Time, extra ...string) string {
+	parts := append([]string{id, updatedAt.UTC().Round(time.Microsecond).Format(time.RFC3339Nano)}, extra...)
+	sum := sha256.Sum256([]byte(strings.Join(parts, "\x00")))
+	return `"` + hex.EncodeToString(sum[:16]) + `"`
+}
+
+// oinkETag is the ETag of oink. Joins and leaves do not touch updated_at, so
+// the member count goes into it as well.
+func oinkETag(oink *repository.Oink) string {
+	return versionTag(oink.ID, oink.UpdatedAt, strconv.FormatInt(oink.MemberCount, 10))
+}
+
+func userETag(user *repository.User) string {
+	return versionTag(user.ID, user.UpdatedAt)
+}
+
+func postETag(post *repository.Post) string {
+	return versionTag(post.ID, post.UpdatedAt)
+}
+
+// etagMatches reports whether etag is listed in the value of an If-Match or
+// If-None-Match header. Weak tags only match when weak comparison is asked
+// for, which is what If-None-Match uses.
+func etagMatches(header string, etag string, weak bool) bool {
+	for _, candidate := range strings.Split(header, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" {
+			return true
+		}
+		if strings.HasPrefix(candidate, "W/") {
+			if !weak {
+				continue
+			}
+			candidate = strings.TrimPrefix(candidate, "W/")
+		}
+		if candidate == etag {
+			return true
+		}
+	}
+
+	return false
+}
+
+// notModified sets the ETag of the response and, when the client already
+// holds that version, answers with 304 Not Modified and reports true.
+func (s *Server) notModified(w http.ResponseWriter, r *http.Request, etag string) bool {
+	w.Header().Set("ETag", etag)
+
+	header := r.Header.Get("If-None-Match")
+	if header == "" || !etagMatches(header, etag, true) {
+		return false
+	}
+
+	w.WriteHeader(http.StatusNotModified)
+	return true
+}
+
+// ifMatch answers with 412 Precondition Failed when the resource is no longer
+// at the version named by If-Match, and reports whether the request may go on.
+// Requests without If-Match go on unchecked, so clients that predate ETags
+// keep working.
+func (s *Server) ifMatch(w http.ResponseWriter, r *http.Request, etag string) bool {
+	header := r.Header.Get("If-Match")
+	if header == "" || etagMatches(header, etag, false) {
+		return true
+	}
+
+	s.writeJSON(w, http.StatusPreconditionFailed, envelope{"error": "Resource has changed since it was retrieved"}, http.Header{"Etag": []string{etag}})
+	return false
+}
@@ -0,0 +1,144 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+
+	"github.com/mrityunjaygr8/go-oink/internal/repository"
+)
+
+func TestETagMatches(t *testing.T) {
+	const etag = `"abc"`
+	tests := []struct {
+		header string
+		weak   bool
+		want   bool
+	}{
+		{`"abc"`, false, true},
+		{`"xyz", "abc"`, false, true},
+		{`"xyz"`, false, false},
+		{`*`, false, true},
+		{`W/"abc"`, false, false},
+		{`W/"abc"`, true, true},
+		{`abc`, true, false},
+	}
+	for _, tt := range tests {
+		if got := etagMatches(tt.header, etag, tt.weak); got != tt.want {
+			t.Errorf("etagMatches(%q, weak=%v) = %v, want %v", tt.header, tt.weak, got, tt.want)
+		}
+	}
+}
+
+func TestIfMatch(t *testing.T) {
+	const etag = `"abc"`
+	tests := []struct {
+		header string
+		want   bool
+	}{
+		{"", true},
+		{`"abc"`, true},
+		{`"xyz"`, false},
+	}
+	s, _ := newTestServer(t)
+	for _, tt := range tests {
+		req := httptest.NewRequest(http.MethodDelete, "/", nil)
+		if tt.header != "" {
+			req.Header.Set("If-Match", tt.header)
+		}
+		rec := httptest.NewRecorder()
+		if got := s.ifMatch(rec, req, etag); got != tt.want {
+			t.Errorf("ifMatch with If-Match %q = %v, want %v", tt.header, got, tt.want)
+		}
+		if !tt.want && rec.Code != http.StatusPreconditionFailed {
+			t.Errorf("ifMatch with If-Match %q answered %d, want %d", tt.header, rec.Code, http.StatusPreconditionFailed)
+		}
+	}
+}
+
+func TestVersionTagRoundsToStoredPrecision(t *testing.T) {
+	written := time.Date(2023, 5, 1, 10, 0, 0, 123456789, time.UTC)
+	stored := time.Date(2023, 5, 1, 10, 0, 0, 123457000, time.UTC)
+	if versionTag("id", written) != versionTag("id", stored) {
+		t.Fatalf("tag of the written version differs from the stored one")
+	}
+	if versionTag("id", stored) == versionTag("id", stored.Add(time.Microsecond)) {
+		t.Fatalf("tags of different versions are equal")
+	}
+}
+
+func TestUserRetrieveConditional(t *testing.T) {
+	updatedAt := time.Date(2023, 5, 1, 10, 0, 0, 0, time.UTC)
+	userRows := func() *sqlmock.Rows {
+		return sqlmock.NewRows([]string{"id", "email", "username", "updated_at"}).AddRow("id", "im@oink.in", "im", updatedAt)
+	}
+
+	s, mock := newTestServer(t)
+	mock.ExpectQuery(`(?i)from "users"`).WillReturnRows(userRows())
+	mock.ExpectQuery(`(?i)from "users"`).WillReturnRows(userRows())
+
+	rec := serve(http.MethodGet, "/users/{userID}", "/users/id", s.UserRetrieve())
+	etag := rec.Header().Get("ETag")
+	if rec.Code != http.StatusOK || etag == "" {
+		t.Fatalf("got status %d and ETag %q, want 200 with an ETag", rec.Code, etag)
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/users/id", nil)
+	req.Header.Set("If-None-Match", etag)
+	rec = serveRequest("/users/{userID}", req, s.UserRetrieve())
+	if rec.Code != http.StatusNotModified || rec.Body.Len() != 0 {
+		t.Fatalf("got status %d with %d bytes, want an empty 304", rec.Code, rec.Body.Len())
+	}
+}
+
+func TestUserDeletePreconditions(t *testing.T) {
+	asSelf := func(r *http.Request) *http.Request {
+		return r.WithContext(context.WithValue(r.Context(), "user", &repository.User{ID: "id"}))
+	}
+
+	t.Run("someone-else", func(t *testing.T) {
+		s, _ := newTestServer(t)
+		req := httptest.NewRequest(http.MethodDelete, "/users/other", nil)
+		req.Header.Set("If-Match", versionTag("other", time.Now()))
+		rec := serveRequest("/users/{userID}", asSelf(req), s.UserDelete())
+		if rec.Code != http.StatusForbidden {
+			t.Fatalf("got status %d, want %d", rec.Code, http.StatusForbidden)
+		}
+	})
+
+	t.Run("without-if-match", func(t *testing.T) {
+		s, mock := newTestServer(t)
+		mock.ExpectBegin()
+		mock.ExpectQuery(`(?i)from "users" where .* for update`).WillReturnRows(sqlmock.NewRows([]string{"id"}))
+		mock.ExpectRollback()
+
+		req := httptest.NewRequest(http.MethodDelete, "/users/id", nil)
+		rec := serveRequest("/users/{userID}", asSelf(req), s.UserDelete())
+		if rec.Code != http.StatusNotFound {
+			t.Fatalf("got status %d, want the delete to go on unchecked to a 404", rec.Code)
+		}
+	})
+
+	t.Run("changed", func(t *testing.T) {
+		s, mock := newTestServer(t)
+		seen := versionTag("id", time.Date(2023, 5, 1, 10, 0, 0, 0, time.UTC))
+		mock.ExpectBegin()
+		mock.ExpectQuery(`(?i)from "users" where .* for update`).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "updated_at"}).AddRow("id", time.Date(2023, 5, 2, 10, 0, 0, 0, time.UTC)))
+		mock.ExpectRollback()
+
+		req := httptest.NewRequest(http.MethodDelete, "/users/id", nil)
+		req.Header.Set("If-Match", seen)
+		rec := serveRequest("/users/{userID}", asSelf(req), s.UserDelete())
+		if rec.Code != http.StatusPreconditionFailed {
+			t.Fatalf("got status %d, want %d", rec.Code, http.StatusPreconditionFailed)
+		}
+		if rec.Header().Get("ETag") == seen {
+			t.Fatalf("412 carries the stale ETag")
+		}
+	})
+}
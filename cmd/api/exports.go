@@ -0,0 +1,412 @@
+package main
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/mrityunjaygr8/go-oink/internal/repository"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/hlog"
+)
+
+const (
+	exportStatusPending = "pending"
+	exportStatusReady   = "ready"
+	exportStatusFailed  = "failed"
+
+	// exportTTL is how long a finished export is served before a new one is built.
+	exportTTL = 24 * time.Hour
+	// exportTimeout bounds how long building a single export may take.
+	exportTimeout = 10 * time.Minute
+	// exportSweepInterval is how often expired exports are deleted.
+	exportSweepInterval = time.Hour
+)
+
+type userExport struct {
+	ID        string
+	UserID    string
+	Status    string
+	Path      string
+	CreatedAt time.Time
+}
+
+// exportStore keeps track of the latest personal data export of every user.
+// The archives themselves are written to dir, and deleted by sweep once they
+// expire.
+type exportStore struct {
+	mu      sync.Mutex
+	dir     string
+	exports map[string]*userExport
+}
+
+func newExportStore(dir string) *exportStore {
+	return &exportStore{
+		dir:     dir,
+		exports: make(map[string]*userExport),
+	}
+}
+
+// current returns the latest usable export for userID. When there is none,
+// or it failed or expired, a new pending export is registered and started is
+// true.
+func (e *exportStore) current(userID string) (export userExport, started bool) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	existing, ok := e.exports[userID]
+	if ok && existing.Status != exportStatusFailed && time.Since(existing.CreatedAt) < exportTTL {
+		return *existing, false
+	}
+
+	if ok && existing.Path != "" {
+		os.Remove(existing.Path)
+	}
+
+	export = userExport{
+		ID:        uuid.New().String(),
+		UserID:    userID,
+		Status:    exportStatusPending,
+		CreatedAt: time.Now(),
+	}
+	e.exports[userID] = &export
+
+	return export, true
+}
+
+func (e *exportStore) get(userID, exportID string) (userExport, bool) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	export, ok := e.exports[userID]
+	if !ok || export.ID != exportID || time.Since(export.CreatedAt) >= exportTTL {
+		return userExport{}, false
+	}
+
+	return *export, true
+}
+
+// remove forgets the export of userID and deletes its archive, for users
+// that are deleted.
+func (e *exportStore) remove(userID string) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	export, ok := e.exports[userID]
+	if !ok {
+		return
+	}
+	if export.Path != "" {
+		os.Remove(export.Path)
+	}
+	delete(e.exports, userID)
+}
+
+// sweep forgets the exports that expired before now and deletes every archive
+// in dir older than exportTTL, including those left behind by an earlier run
+// of the server.
+func (e *exportStore) sweep(now time.Time) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	for userID, export := range e.exports {
+		if now.Sub(export.CreatedAt) >= exportTTL {
+			if export.Path != "" {
+				os.Remove(export.Path)
+			}
+			delete(e.exports, userID)
+		}
+	}
+
+	entries, err := os.ReadDir(e.dir)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		return err
+	}
+
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			continue
+		}
+		if now.Sub(info.ModTime()) >= exportTTL {
+			if err := os.Remove(filepath.Join(e.dir, entry.Name())); err != nil && !errors.Is(err, os.ErrNotExist) {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// sweepExports deletes expired exports right away and then every
+// exportSweepInterval, until ctx is done.
+func (s *Server) sweepExports(ctx context.Context) {
+	ticker := time.NewTicker(exportSweepInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := s.exports.sweep(time.Now()); err != nil {
+			s.l.Error().Err(err).Msg("api-sweepExports-sweep")
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (e *exportStore) finish(userID, exportID, path string, err error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	export, ok := e.exports[userID]
+	if !ok || export.ID != exportID {
+		// superseded while running, nobody can download this one anymore
+		os.Remove(path)
+		return
+	}
+
+	if err != nil {
+		os.Remove(path)
+		export.Status = exportStatusFailed
+		return
+	}
+
+	export.Path = path
+	export.Status = exportStatusReady
+}
+
+type exportProfile struct {
+	ID        string    `json:"id"`
+	Email     string    `json:"email"`
+	Username  string    `json:"username"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type exportToken struct {
+	Type      string    `json:"type"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type exportOink struct {
+	ID          string    `json:"id"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// writeUserExport writes the ZIP archive for user to w. Token secrets are
+// never included, only their metadata.
+func writeUserExport(w io.Writer, user repository.User, tokens []repository.Token, oinks []repository.Oink) error {
+	zw := zip.NewWriter(w)
+
+	exportTokens := make([]exportToken, 0, len(tokens))
+	for _, token := range tokens {
+		exportTokens = append(exportTokens, exportToken{
+			Type:      string(token.Type),
+			CreatedAt: token.CreatedAt,
+			UpdatedAt: token.UpdatedAt,
+		})
+	}
+
+	exportOinks := make([]exportOink, 0, len(oinks))
+	for _, oink := range oinks {
+		exportOinks = append(exportOinks, exportOink{
+			ID:          oink.ID,
+			Name:        oink.Name,
+			Description: oink.Description,
+			CreatedAt:   oink.CreatedAt,
+			UpdatedAt:   oink.UpdatedAt,
+		})
+	}
+
+	files := []struct {
+		name string
+		data interface{}
+	}{
+		{"profile.json", exportProfile{
+			ID:        user.ID,
+			Email:     user.Email,
+			Username:  user.Username,
+			CreatedAt: user.CreatedAt,
+			UpdatedAt: user.UpdatedAt,
+		}},
+		{"tokens.json", exportTokens},
+		{"oinks.json", exportOinks},
+	}
+
+	for _, file := range files {
+		fw, err := zw.Create(file.name)
+		if err != nil {
+			return err
+		}
+
+		enc := json.NewEncoder(fw)
+		enc.SetIndent("", "\t")
+		if err := enc.Encode(file.data); err != nil {
+			return err
+		}
+	}
+
+	return zw.Close()
+}
+
+// buildUserExport gathers everything stored about userID and writes it to a
+// new archive in the export directory, returning its path.
+func (s *Server) buildUserExport(ctx context.Context, logger zerolog.Logger, userID, exportID string) (string, error) {
+	repo := repository.New(s.db, logger)
+
+	user, err := repo.UserRepository.UserRetrieve(ctx, userID)
+	if err != nil {
+		return "", err
+	}
+
+	tokens, err := repo.TokenRepository.TokenListUser(ctx, userID)
+	if err != nil {
+		return "", err
+	}
+
+	oinks, err := repo.OinkRepository.OinkListByCreator(ctx, userID)
+	if err != nil {
+		return "", err
+	}
+
+	if err := os.MkdirAll(s.exports.dir, 0o700); err != nil {
+		return "", err
+	}
+
+	path := filepath.Join(s.exports.dir, exportID+".zip")
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o600)
+	if err != nil {
+		return "", err
+	}
+
+	err = writeUserExport(f, *user, *tokens, *oinks)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+
+	return path, err
+}
+
+// canAccessUserData reports whether the requesting user may act on the data
+// of userID: users may access their own data, admins everybody's.
+func canAccessUserData(r *http.Request, userID string) bool {
+	requester, ok := r.Context().Value("user").(*repository.User)
+	if !ok {
+		return false
+	}
+
+	return requester.IsAdmin || requester.ID == userID
+}
+
+func (s *Server) UserExport() http.HandlerFunc {
+	type response struct {
+		ID          string    `json:"id"`
+		Status      string    `json:"status"`
+		CreatedAt   time.Time `json:"created_at"`
+		DownloadURL string    `json:"download_url,omitempty"`
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := hlog.FromRequest(r)
+		userID := chi.URLParam(r, "userID")
+
+		if !canAccessUserData(r, userID) {
+			s.writeJSON(w, http.StatusForbidden, envelope{"error": http.StatusText(http.StatusForbidden)}, nil)
+			return
+		}
+
+		repo := repository.New(s.db, *logger)
+		_, err := repo.UserRepository.UserRetrieve(r.Context(), userID)
+		if err != nil {
+			if errors.Is(err, repository.ErrUserNotFound) {
+				s.writeJSON(w, http.StatusNotFound, envelope{"error": err.Error()}, nil)
+				return
+			}
+			logger.Error().Err(err).Msg("api-UserExport-UserRetrieve")
+			s.writeJSON(w, http.StatusInternalServerError, envelope{"error": http.StatusText(http.StatusInternalServerError)}, nil)
+			return
+		}
+
+		export, started := s.exports.current(userID)
+		if started {
+			exportLogger := logger.With().Str("export_id", export.ID).Logger()
+			s.background(func() {
+				ctx, cancel := context.WithTimeout(context.Background(), exportTimeout)
+				defer cancel()
+
+				path, err := s.buildUserExport(ctx, exportLogger, userID, export.ID)
+				if err != nil {
+					exportLogger.Error().Err(err).Msg("api-UserExport-buildUserExport")
+				}
+				s.exports.finish(userID, export.ID, path, err)
+			})
+		}
+
+		res := response{
+			ID:        export.ID,
+			Status:    export.Status,
+			CreatedAt: export.CreatedAt,
+		}
+
+		status := http.StatusAccepted
+		if export.Status == exportStatusReady {
+			status = http.StatusOK
+			res.DownloadURL = fmt.Sprintf("/api/v1/users/%s/export/%s", userID, export.ID)
+		}
+
+		s.writeJSON(w, status, envelope{"export": res}, nil)
+	}
+}
+
+func (s *Server) UserExportDownload() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		userID := chi.URLParam(r, "userID")
+		exportID := chi.URLParam(r, "exportID")
+
+		if !canAccessUserData(r, userID) {
+			s.writeJSON(w, http.StatusForbidden, envelope{"error": http.StatusText(http.StatusForbidden)}, nil)
+			return
+		}
+
+		export, ok := s.exports.get(userID, exportID)
+		if !ok || export.Status != exportStatusReady {
+			s.writeJSON(w, http.StatusNotFound, envelope{"error": "Export does not exist"}, nil)
+			return
+		}
+
+		f, err := os.Open(export.Path)
+		if err != nil {
+			hlog.FromRequest(r).Error().Err(err).Msg("api-UserExportDownload-open")
+			s.writeJSON(w, http.StatusInternalServerError, envelope{"error": http.StatusText(http.StatusInternalServerError)}, nil)
+			return
+		}
+		defer f.Close()
+
+		w.Header().Set("Content-Type", "application/zip")
+		w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="oink-export-%s.zip"`, export.CreatedAt.Format("20060102")))
+		http.ServeContent(w, r, "", export.CreatedAt, f)
+	}
+}
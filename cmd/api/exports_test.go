@@ -0,0 +1,131 @@
+package main
+
+import (
+	"archive/zip"
+	"bytes"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mrityunjaygr8/go-oink/internal/repository"
+)
+
+func TestWriteUserExport(t *testing.T) {
+	user := repository.User{ID: "user-id", Email: "im@oink.in", Username: "im", Password: "hash"}
+	tokens := []repository.Token{{Token: "secret-token", UserID: "user-id", Type: repository.TokenTypeLogin}}
+	oinks := []repository.Oink{{ID: "oink-id", Name: "chelsea", Description: "the official oink for chelsea FC"}}
+
+	var buf bytes.Buffer
+	if err := writeUserExport(&buf, user, tokens, oinks); err != nil {
+		t.Fatalf("writeUserExport: %v", err)
+	}
+
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatalf("zip.NewReader: %v", err)
+	}
+
+	contents := make(map[string]string)
+	for _, f := range zr.File {
+		rc, err := f.Open()
+		if err != nil {
+			t.Fatalf("open %s: %v", f.Name, err)
+		}
+		b, err := io.ReadAll(rc)
+		rc.Close()
+		if err != nil {
+			t.Fatalf("read %s: %v", f.Name, err)
+		}
+		contents[f.Name] = string(b)
+	}
+
+	want := map[string]string{
+		"profile.json": "im@oink.in",
+		"tokens.json":  `"type": "login"`,
+		"oinks.json":   "chelsea",
+	}
+	for name, needle := range want {
+		if !strings.Contains(contents[name], needle) {
+			t.Errorf("%s does not contain %q: %s", name, needle, contents[name])
+		}
+	}
+
+	for name, content := range contents {
+		if strings.Contains(content, "secret-token") || strings.Contains(content, "hash") {
+			t.Errorf("%s leaks a secret: %s", name, content)
+		}
+	}
+}
+
+func TestExportStoreCurrent(t *testing.T) {
+	store := newExportStore(t.TempDir())
+
+	first, started := store.current("user-id")
+	if !started || first.Status != exportStatusPending {
+		t.Fatalf("got %+v started=%v, want a new pending export", first, started)
+	}
+
+	again, started := store.current("user-id")
+	if started || again.ID != first.ID {
+		t.Fatalf("pending export was not reused: %+v", again)
+	}
+
+	store.finish("user-id", first.ID, "", io.ErrUnexpectedEOF)
+	retry, started := store.current("user-id")
+	if !started || retry.ID == first.ID {
+		t.Fatalf("failed export was not replaced: %+v", retry)
+	}
+}
+
+func TestExportStoreExpiry(t *testing.T) {
+	dir := t.TempDir()
+	store := newExportStore(dir)
+	archive := func(name string, age time.Duration) string {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte("zip"), 0o600); err != nil {
+			t.Fatal(err)
+		}
+		modified := time.Now().Add(-age)
+		if err := os.Chtimes(path, modified, modified); err != nil {
+			t.Fatal(err)
+		}
+		return path
+	}
+
+	stale := archive("stale.zip", exportTTL+time.Minute)
+	store.exports["stale-user"] = &userExport{ID: "stale", UserID: "stale-user", Status: exportStatusReady, Path: stale, CreatedAt: time.Now().Add(-exportTTL - time.Minute)}
+	fresh := archive("fresh.zip", time.Minute)
+	store.exports["fresh-user"] = &userExport{ID: "fresh", UserID: "fresh-user", Status: exportStatusReady, Path: fresh, CreatedAt: time.Now().Add(-time.Minute)}
+	orphan := archive("orphan.zip", exportTTL+time.Hour)
+
+	if _, ok := store.get("stale-user", "stale"); ok {
+		t.Error("get returned an expired export")
+	}
+	if _, ok := store.get("fresh-user", "fresh"); !ok {
+		t.Error("get did not return a fresh export")
+	}
+
+	if err := store.sweep(time.Now()); err != nil {
+		t.Fatalf("sweep: %v", err)
+	}
+	for _, path := range []string{stale, orphan} {
+		if _, err := os.Stat(path); !errors.Is(err, os.ErrNotExist) {
+			t.Errorf("sweep left %s behind", filepath.Base(path))
+		}
+	}
+	if _, ok := store.exports["stale-user"]; ok {
+		t.Error("sweep kept the expired export")
+	}
+
+	store.remove("fresh-user")
+	if _, err := os.Stat(fresh); !errors.Is(err, os.ErrNotExist) {
+		t.Error("remove left the archive of the user behind")
+	}
+	if _, ok := store.get("fresh-user", "fresh"); ok {
+		t.Error("get returned the export of a removed user")
+	}
+}
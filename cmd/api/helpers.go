@@ -0,0 +1,23 @@
+package main
+
+import (
+	"fmt"
+)
+
+// background runs fn in a goroutine tracked by the server's WaitGroup, so a
+// graceful shutdown waits for it to finish. Panics are recovered and logged.
+func (app *Server) background(fn func()) {
+	app.wg.Add(1)
+
+	go func() {
+		defer app.wg.Done()
+
+		defer func() {
+			if err := recover(); err != nil {
+				app.l.Error().Err(fmt.Errorf("%s", err)).Msg("background-panic")
+			}
+		}()
+
+		fn()
+	}()
+}
@@ -0,0 +1,92 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/mrityunjaygr8/go-oink/internal/listquery"
+)
+
+// readInt returns the integer value of key from qs, or defaultValue when the
+// key is absent. A malformed value is reported as an error.
+func (app *Server) readInt(qs url.Values, key string, defaultValue int) (int, error) {
+	s := qs.Get(key)
+	if s == "" {
+		return defaultValue, nil
+	}
+
+	i, err := strconv.Atoi(s)
+	if err != nil {
+		return defaultValue, fmt.Errorf("%s must be an integer value", key)
+	}
+
+	return i, nil
+}
+
+// readPage returns the limit and offset query parameters from qs, checking
+// that limit lies between 1 and maxLimit and that offset is not negative.
+func (app *Server) readPage(qs url.Values, defaultLimit int, maxLimit int) (int, int, error) {
+	limit, err := app.readInt(qs, "limit", defaultLimit)
+	if err != nil {
+		return 0, 0, err
+	}
+	if limit < 1 || limit > maxLimit {
+		return 0, 0, fmt.Errorf("limit must be between 1 and %d", maxLimit)
+	}
+
+	offset, err := app.readInt(qs, "offset", 0)
+	if err != nil {
+		return 0, 0, err
+	}
+	if offset < 0 {
+		return 0, 0, fmt.Errorf("offset must not be negative")
+	}
+
+	return limit, offset, nil
+}
+
+// cursorMetadata describes a page of a cursor paginated listing. NextCursor
+// is left out on the last page.
+type cursorMetadata struct {
+	Limit      int    `json:"limit"`
+	NextCursor string `json:"next_cursor,omitempty"`
+}
+
+// readListQuery parses the filters, sort and cursor a list endpoint described
+// by spec was called with, along with the limit query parameter. The limit
+// defaults to, and may not exceed, the page sizes the server is configured
+// with. handled names the other parameters the endpoint reads itself; any
+// parameter besides those is an error.
+func (app *Server) readListQuery(qs url.Values, spec *listquery.Spec, handled ...string) (*listquery.Query, int, error) {
+	limit, err := app.readInt(qs, "limit", app.config.PageDefaultLimit)
+	if err != nil {
+		return nil, 0, err
+	}
+	if limit < 1 || limit > app.config.PageMaxLimit {
+		return nil, 0, fmt.Errorf("limit must be between 1 and %d", app.config.PageMaxLimit)
+	}
+
+	q, err := spec.Parse(qs, append(handled, "limit")...)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return q, limit, nil
+}
+
+// setNextLink points the Link header of a listing response at the page that
+// follows it. Nothing is set on the last page.
+func (app *Server) setNextLink(w http.ResponseWriter, r *http.Request, nextCursor string) {
+	if nextCursor == "" {
+		return
+	}
+
+	next := *r.URL
+	qs := next.Query()
+	qs.Set("cursor", nextCursor)
+	next.RawQuery = qs.Encode()
+
+	w.Header().Add("Link", fmt.Sprintf(`<%s>; rel="next"`, next.RequestURI()))
+}
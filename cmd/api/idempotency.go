@@ -0,0 +1,209 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"time"
+
+	"github.com/mrityunjaygr8/go-oink/internal/repository"
+	"github.com/rs/zerolog/hlog"
+)
+
+const (
+	idempotencyKeyHeader       = "Idempotency-Key"
+	idempotentReplayedHeader   = "Idempotent-Replayed"
+	maxIdempotencyKeyLength    = 255
+	idempotencyCompleteTimeout = 5 * time.Second
+)
+
+// recordingResponseWriter passes a response through while keeping a copy of
+// its status and body.
+type recordingResponseWriter struct {
+	http.ResponseWriter
+	status int
+	body   bytes.Buffer
+}
+
+func (rw *recordingResponseWriter) WriteHeader(status int) {
+	if rw.status == 0 {
+		rw.status = status
+	}
+	rw.ResponseWriter.WriteHeader(status)
+}
+
+func (rw *recordingResponseWriter) Write(b []byte) (int, error) {
+	if rw.status == 0 {
+		rw.status = http.StatusOK
+	}
+	rw.body.Write(b)
+	return rw.ResponseWriter.Write(b)
+}
+
+// replayBody holds the body a handler leaves to be stored for replays in
+// place of the one it answers with.
+type replayBody struct {
+	body []byte
+	set  bool
+}
+
+// storeForReplay makes the Idempotent middleware store body for replays of r
+// instead of the response written to it, for responses holding secrets that
+// must not be kept. It does nothing for requests whose responses are not
+// stored.
+func storeForReplay(r *http.Request, body []byte) {
+	if replay, ok := r.Context().Value("replayBody").(*replayBody); ok {
+		replay.body, replay.set = body, true
+	}
+}
+
+// unstoredHeaders are the response headers that are not replayed: the
+// hop-by-hop ones, which describe a single connection, and Date.
+var unstoredHeaders = map[string]bool{
+	"Connection":          true,
+	"Date":                true,
+	"Keep-Alive":          true,
+	"Proxy-Authenticate":  true,
+	"Proxy-Authorization": true,
+	"Proxy-Connection":    true,
+	"Te":                  true,
+	"Trailer":             true,
+	"Transfer-Encoding":   true,
+	"Upgrade":             true,
+}
+
+// storedHeaders returns the headers of a response to store with it: the ones
+// the handler set on top of before, which the middlewares in front of it
+// set again on every request, except for unstoredHeaders.
+func storedHeaders(before, after http.Header) map[string][]string {
+	headers := make(map[string][]string)
+	for name, values := range after {
+		if unstoredHeaders[name] || reflect.DeepEqual(before[name], values) {
+			continue
+		}
+		headers[name] = values
+	}
+	return headers
+}
+
+// requestFingerprint identifies a request by its method, target and body, so
+// a reused Idempotency-Key can be told apart from a retry.
+func requestFingerprint(r *http.Request, body []byte) string {
+	h := sha256.New()
+	fmt.Fprintf(h, "%s %s\n", r.Method, r.URL.RequestURI())
+	h.Write(body)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// Idempotent honours the Idempotency-Key header of POST requests made by
+// logged in users. The first request with a key runs and its response is
+// stored, headers included; retries with the same key and body get the
+// stored response back without running again. Handlers may have a different
+// body stored with storeForReplay. Server errors are not stored, so such
+// requests may be retried with the same key, and neither are those of
+// requests that never finished, whose keys are free again once their lease
+// runs out.
+func (s *Server) Idempotent(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key := r.Header.Get(idempotencyKeyHeader)
+		if r.Method != http.MethodPost || key == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		u, ok := r.Context().Value("user").(*repository.User)
+		if !ok {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		logger := hlog.FromRequest(r)
+
+		if len(key) > maxIdempotencyKeyLength {
+			s.writeJSON(w, http.StatusBadRequest, envelope{"error": fmt.Sprintf("%s must not be longer than %d characters", idempotencyKeyHeader, maxIdempotencyKeyLength)}, nil)
+			return
+		}
+
+		body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, s.config.UploadMaxBytes))
+		if err != nil {
+			var maxBytesError *http.MaxBytesError
+			if errors.As(err, &maxBytesError) {
+				s.writeJSON(w, http.StatusRequestEntityTooLarge, envelope{"error": fmt.Sprintf("body must not be larger than %d bytes", s.config.UploadMaxBytes)}, nil)
+				return
+			}
+			s.writeJSON(w, http.StatusBadRequest, envelope{"error": err.Error()}, nil)
+			return
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+
+		repo := repository.New(s.db, *logger)
+		stored, err := repo.IdempotencyKeyRepository.IdempotencyKeyBegin(r.Context(), u.ID, key, requestFingerprint(r, body), s.config.IdempotencyTTL)
+		if err != nil {
+			if errors.Is(err, repository.ErrIdempotencyKeyMismatch) {
+				s.writeJSON(w, http.StatusUnprocessableEntity, envelope{"error": err.Error()}, nil)
+				return
+			}
+			if errors.Is(err, repository.ErrIdempotencyKeyInProgress) {
+				s.writeJSON(w, http.StatusConflict, envelope{"error": err.Error()}, nil)
+				return
+			}
+			logger.Error().Err(err).Msg("middleware-Idempotent-IdempotencyKeyBegin")
+			s.writeJSON(w, http.StatusInternalServerError, envelope{"error": http.StatusText(http.StatusInternalServerError)}, nil)
+			return
+		}
+
+		if stored != nil {
+			for name, values := range stored.Headers {
+				w.Header()[name] = values
+			}
+			if stored.ContentType != "" && w.Header().Get("Content-Type") == "" {
+				w.Header().Set("Content-Type", stored.ContentType)
+			}
+			w.Header().Set(idempotentReplayedHeader, "true")
+			w.WriteHeader(stored.Status)
+			w.Write(stored.Body)
+			return
+		}
+
+		// the response is stored even when the client went away, as that is
+		// when it is going to retry
+		ctx, cancel := context.WithTimeout(context.Background(), idempotencyCompleteTimeout)
+		defer cancel()
+
+		before := w.Header().Clone()
+		rw := &recordingResponseWriter{ResponseWriter: w}
+		replay := &replayBody{}
+		completed := false
+		defer func() {
+			if completed {
+				return
+			}
+			if err := repo.IdempotencyKeyRepository.IdempotencyKeyRelease(ctx, u.ID, key); err != nil {
+				logger.Error().Err(err).Msg("middleware-Idempotent-IdempotencyKeyRelease")
+			}
+		}()
+
+		next.ServeHTTP(rw, r.WithContext(context.WithValue(r.Context(), "replayBody", replay)))
+
+		if rw.status == 0 || rw.status >= http.StatusInternalServerError {
+			return
+		}
+
+		response := rw.body.Bytes()
+		if replay.set {
+			response = replay.body
+		}
+		err = repo.IdempotencyKeyRepository.IdempotencyKeyComplete(ctx, u.ID, key, rw.status, storedHeaders(before, rw.Header()), response)
+		if err != nil {
+			logger.Error().Err(err).Msg("middleware-Idempotent-IdempotencyKeyComplete")
+			return
+		}
+		completed = true
+	})
+}
@@ -0,0 +1,147 @@
+package main
+
+import (
+	"context"
+	"database/sql/driver"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/mrityunjaygr8/go-oink/internal/repository"
+)
+
+func TestIdempotent(t *testing.T) {
+	const body = `{"name": "chelsea"}`
+
+	request := func() *http.Request {
+		r := httptest.NewRequest(http.MethodPost, "/oinks", strings.NewReader(body))
+		r.Header.Set(idempotencyKeyHeader, "key")
+		return r.WithContext(context.WithValue(r.Context(), "user", &repository.User{ID: "user-id"}))
+	}
+	fingerprint := requestFingerprint(request(), []byte(body))
+	storedRows := func(fingerprint string, status int) *sqlmock.Rows {
+		return sqlmock.NewRows([]string{"user", "key", "fingerprint", "status", "content_type", "headers", "body"}).
+			AddRow("user-id", "key", fingerprint, status, "", []byte(`{"Content-Type": ["application/json"], "Location": ["/api/v1/oinks/stored"]}`), []byte(`{"oink": "stored"}`))
+	}
+	serveIdempotent := func(s *Server, status int) (*httptest.ResponseRecorder, int) {
+		calls := 0
+		handler := s.Idempotent(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			s.writeJSON(w, status, envelope{"oink": "created"}, http.Header{"Location": {"/api/v1/oinks/created"}})
+		}))
+		rec := httptest.NewRecorder()
+		rec.Header().Set("X-Request-Id", "request-id")
+		handler.ServeHTTP(rec, request())
+		return rec, calls
+	}
+
+	t.Run("first-request", func(t *testing.T) {
+		s, mock := newTestServer(t)
+		mock.ExpectExec(`(?i)delete from "idempotency_keys"`).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec(`(?i)insert into "idempotency_keys"`).WithArgs("user-id", "key", fingerprint, sqlmock.AnyArg(), sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery(`(?i)from "idempotency_keys"`).WillReturnRows(storedRows(fingerprint, 0))
+		mock.ExpectExec(`(?i)update "idempotency_keys"`).WithArgs(http.StatusCreated, storedHeadersArg{}, sqlmock.AnyArg(), "user-id", "key").WillReturnResult(sqlmock.NewResult(0, 1))
+
+		rec, calls := serveIdempotent(s, http.StatusCreated)
+		if calls != 1 || rec.Code != http.StatusCreated {
+			t.Fatalf("got status %d after %d calls, want the handler to run once", rec.Code, calls)
+		}
+	})
+
+	t.Run("replay", func(t *testing.T) {
+		s, mock := newTestServer(t)
+		mock.ExpectExec(`(?i)delete from "idempotency_keys"`).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec(`(?i)insert into "idempotency_keys"`).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectQuery(`(?i)from "idempotency_keys"`).WillReturnRows(storedRows(fingerprint, http.StatusCreated))
+
+		rec, calls := serveIdempotent(s, http.StatusCreated)
+		if calls != 0 {
+			t.Fatalf("handler ran %d times on a replay", calls)
+		}
+		if rec.Code != http.StatusCreated || rec.Body.String() != `{"oink": "stored"}` {
+			t.Fatalf("got %d %q, want the stored response", rec.Code, rec.Body.String())
+		}
+		if rec.Header().Get("Location") != "/api/v1/oinks/stored" || rec.Header().Get("Content-Type") != "application/json" {
+			t.Fatalf("got headers %v, want the stored ones", rec.Header())
+		}
+		if rec.Header().Get(idempotentReplayedHeader) != "true" {
+			t.Fatalf("replayed response not marked as such")
+		}
+	})
+
+	t.Run("different-body", func(t *testing.T) {
+		s, mock := newTestServer(t)
+		mock.ExpectExec(`(?i)delete from "idempotency_keys"`).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec(`(?i)insert into "idempotency_keys"`).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectQuery(`(?i)from "idempotency_keys"`).WillReturnRows(storedRows("another", http.StatusCreated))
+
+		rec, calls := serveIdempotent(s, http.StatusCreated)
+		if calls != 0 || rec.Code != http.StatusUnprocessableEntity {
+			t.Fatalf("got status %d after %d calls, want %d", rec.Code, calls, http.StatusUnprocessableEntity)
+		}
+	})
+
+	t.Run("in-progress", func(t *testing.T) {
+		s, mock := newTestServer(t)
+		mock.ExpectExec(`(?i)delete from "idempotency_keys"`).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec(`(?i)insert into "idempotency_keys"`).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectQuery(`(?i)from "idempotency_keys"`).WillReturnRows(storedRows(fingerprint, 0))
+
+		rec, calls := serveIdempotent(s, http.StatusCreated)
+		if calls != 0 || rec.Code != http.StatusConflict {
+			t.Fatalf("got status %d after %d calls, want %d", rec.Code, calls, http.StatusConflict)
+		}
+	})
+
+	t.Run("lease-expired", func(t *testing.T) {
+		s, mock := newTestServer(t)
+		mock.ExpectExec(`(?i)delete from "idempotency_keys"`).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec(`(?is)on conflict \("user", "key"\) do update.*"locked_until" < now\(\)`).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery(`(?i)from "idempotency_keys"`).WillReturnRows(storedRows(fingerprint, 0))
+		mock.ExpectExec(`(?i)update "idempotency_keys"`).WillReturnResult(sqlmock.NewResult(0, 1))
+
+		rec, calls := serveIdempotent(s, http.StatusCreated)
+		if calls != 1 || rec.Code != http.StatusCreated {
+			t.Fatalf("got status %d after %d calls, want the handler to run once", rec.Code, calls)
+		}
+	})
+
+	t.Run("server-error-releases-key", func(t *testing.T) {
+		s, mock := newTestServer(t)
+		mock.ExpectExec(`(?i)delete from "idempotency_keys"`).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec(`(?i)insert into "idempotency_keys"`).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(`(?i)delete from "idempotency_keys"`).WithArgs("user-id", "key").WillReturnResult(sqlmock.NewResult(0, 1))
+
+		rec, calls := serveIdempotent(s, http.StatusInternalServerError)
+		if calls != 1 || rec.Code != http.StatusInternalServerError {
+			t.Fatalf("got status %d after %d calls", rec.Code, calls)
+		}
+	})
+}
+
+// storedHeadersArg matches the headers stored with a response, which leave
+// out those set in front of the handler.
+type storedHeadersArg struct{}
+
+func (storedHeadersArg) Match(v driver.Value) bool {
+	var headers http.Header
+	b, _ := v.([]byte)
+	if err := json.Unmarshal(b, &headers); err != nil {
+		return false
+	}
+	return headers.Get("Location") == "/api/v1/oinks/created" && headers.Get("Content-Type") == "application/json" && headers.Get("X-Request-Id") == ""
+}
+
+func TestStoredHeaders(t *testing.T) {
+	before := http.Header{"Vary": {"Origin"}}
+	after := http.Header{"Vary": {"Origin"}, "Content-Type": {"application/json"}, "Date": {"today"}, "Connection": {"close"}}
+
+	got := storedHeaders(before, after)
+	if !reflect.DeepEqual(got, map[string][]string{"Content-Type": {"application/json"}}) {
+		t.Errorf("storedHeaders = %v, want only Content-Type", got)
+	}
+}
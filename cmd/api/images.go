@@ -0,0 +1,341 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"github.com/mrityunjaygr8/go-oink/internal/blob"
+	"github.com/mrityunjaygr8/go-oink/internal/images"
+	"github.com/mrityunjaygr8/go-oink/internal/repository"
+	"github.com/rs/zerolog/hlog"
+)
+
+// imageSize is the square an uploaded image is scaled down to fit, along with
+// the square of its thumbnail.
+type imageSize struct {
+	Full  int
+	Thumb int
+}
+
+var (
+	avatarSize = imageSize{Full: 512, Thumb: 128}
+	bannerSize = imageSize{Full: 1500, Thumb: 500}
+)
+
+// imageUploadField is the multipart form field holding an uploaded image.
+const imageUploadField = "image"
+
+// allowedImageTypes are the content types uploads are sniffed as before they
+// are decoded.
+var allowedImageTypes = map[string]bool{
+	"image/png":  true,
+	"image/jpeg": true,
+	"image/gif":  true,
+}
+
+type imageURLs struct {
+	URL          string `json:"url"`
+	ThumbnailURL string `json:"thumbnail_url"`
+}
+
+// imageURLs returns where the image stored under key and its thumbnail are
+// served, or nil when there is no image.
+func (s *Server) imageURLs(key string) *imageURLs {
+	if key == "" {
+		return nil
+	}
+
+	return &imageURLs{
+		URL:          s.config.Blobs.URL(key),
+		ThumbnailURL: s.config.Blobs.URL(thumbnailKey(key)),
+	}
+}
+
+// thumbnailKey returns the blob key of the thumbnail of the image under key.
+func thumbnailKey(key string) string {
+	dot := strings.LastIndex(key, ".")
+	if dot < strings.LastIndex(key, "/") {
+		return key + "-thumb"
+	}
+	return key[:dot] + "-thumb" + key[dot:]
+}
+
+// readImageUpload reads the image uploaded in the request body and scales it
+// down to size, writing the response when the upload is unusable.
+func (s *Server) readImageUpload(w http.ResponseWriter, r *http.Request, size imageSize) (*images.Processed, bool) {
+	logger := hlog.FromRequest(r)
+
+	r.Body = http.MaxBytesReader(w, r.Body, s.config.UploadMaxBytes)
+	err := r.ParseMultipartForm(1 << 20)
+	if err != nil {
+		var maxBytesError *http.MaxBytesError
+		if errors.As(err, &maxBytesError) {
+			s.writeJSON(w, http.StatusRequestEntityTooLarge, envelope{"error": fmt.Sprintf("image must not be larger than %d bytes", s.config.UploadMaxBytes)}, nil)
+			return nil, false
+		}
+		s.writeJSON(w, http.StatusBadRequest, envelope{"error": "body must be a multipart form"}, nil)
+		return nil, false
+	}
+	defer r.MultipartForm.RemoveAll()
+
+	file, _, err := r.FormFile(imageUploadField)
+	if err != nil {
+		s.writeJSON(w, http.StatusBadRequest, envelope{"error": fmt.Sprintf("%s must be provided", imageUploadField)}, nil)
+		return nil, false
+	}
+	defer file.Close()
+
+	// check what the upload looks like before spending time decoding it
+	buffered := bufio.NewReaderSize(file, 512)
+	head, err := buffered.Peek(512)
+	if err != nil && !errors.Is(err, io.EOF) {
+		logger.Error().Err(err).Msg("api-readImageUpload-Peek")
+		s.writeJSON(w, http.StatusInternalServerError, envelope{"error": http.StatusText(http.StatusInternalServerError)}, nil)
+		return nil, false
+	}
+	if !allowedImageTypes[http.DetectContentType(head)] {
+		s.writeJSON(w, http.StatusUnsupportedMediaType, envelope{"error": images.ErrUnsupported.Error()}, nil)
+		return nil, false
+	}
+
+	processed, err := images.Process(buffered, size.Full, size.Thumb)
+	if err != nil {
+		if errors.Is(err, images.ErrUnsupported) {
+			s.writeJSON(w, http.StatusUnsupportedMediaType, envelope{"error": err.Error()}, nil)
+			return nil, false
+		}
+		if errors.Is(err, images.ErrTooLarge) {
+			s.writeJSON(w, http.StatusRequestEntityTooLarge, envelope{"error": err.Error()}, nil)
+			return nil, false
+		}
+		logger.Error().Err(err).Msg("api-readImageUpload-Process")
+		s.writeJSON(w, http.StatusInternalServerError, envelope{"error": http.StatusText(http.StatusInternalServerError)}, nil)
+		return nil, false
+	}
+
+	return processed, true
+}
+
+// putImage stores an image and its thumbnail under a fresh key below prefix
+// and returns the key of the image.
+func (s *Server) putImage(ctx context.Context, prefix string, name string, image *images.Processed) (string, error) {
+	key := fmt.Sprintf("%s/%s-%s%s", prefix, name, uuid.New().String(), image.Full.Ext)
+
+	err := s.config.Blobs.Put(ctx, key, bytes.NewReader(image.Full.Data), image.Full.ContentType)
+	if err != nil {
+		return "", err
+	}
+
+	err = s.config.Blobs.Put(ctx, thumbnailKey(key), bytes.NewReader(image.Thumb.Data), image.Thumb.ContentType)
+	if err != nil {
+		s.deleteImage(ctx, key)
+		return "", err
+	}
+
+	return key, nil
+}
+
+// deleteImage removes the image under key and its thumbnail. Failures are
+// only logged, an orphaned blob is harmless.
+func (s *Server) deleteImage(ctx context.Context, key string) {
+	if key == "" {
+		return
+	}
+
+	for _, k := range []string{key, thumbnailKey(key)} {
+		if err := s.config.Blobs.Delete(ctx, k); err != nil {
+			s.l.Error().Err(err).Str("key", k).Msg("api-deleteImage-Delete")
+		}
+	}
+}
+
+func (s *Server) OinkAvatarUpload() http.HandlerFunc {
+	return s.oinkImageHandler(repository.OinkImageAvatar, avatarSize, true)
+}
+
+func (s *Server) OinkAvatarDelete() http.HandlerFunc {
+	return s.oinkImageHandler(repository.OinkImageAvatar, avatarSize, false)
+}
+
+func (s *Server) OinkBannerUpload() http.HandlerFunc {
+	return s.oinkImageHandler(repository.OinkImageBanner, bannerSize, true)
+}
+
+func (s *Server) OinkBannerDelete() http.HandlerFunc {
+	return s.oinkImageHandler(repository.OinkImageBanner, bannerSize, false)
+}
+
+// oinkImageHandler replaces or removes one of the images of an oink. Its
+// owner and moderators may do so.
+func (s *Server) oinkImageHandler(kind repository.OinkImage, size imageSize, upload bool) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := hlog.FromRequest(r)
+		oinkName := chi.URLParam(r, "oinkName")
+
+		repo := repository.New(s.db, *logger)
+		oink, ok := s.retrieveVisibleOink(w, r, repo, oinkName)
+		if !ok {
+			return
+		}
+
+		allowed, err := s.authorizeOink(r, repo, oink.Name, repository.OinkRoleOwner, repository.OinkRoleModerator)
+		if err != nil {
+			logger.Error().Err(err).Msg("api-oinkImageHandler-authorizeOink")
+			s.writeJSON(w, http.StatusInternalServerError, envelope{"error": http.StatusText(http.StatusInternalServerError)}, nil)
+			return
+		}
+		if !allowed {
+			s.writeJSON(w, http.StatusForbidden, envelope{"error": http.StatusText(http.StatusForbidden)}, nil)
+			return
+		}
+
+		key := ""
+		if upload {
+			image, ok := s.readImageUpload(w, r, size)
+			if !ok {
+				return
+			}
+
+			key, err = s.putImage(r.Context(), "oinks/"+oink.ID, string(kind), image)
+			if err != nil {
+				logger.Error().Err(err).Msg("api-oinkImageHandler-putImage")
+				s.writeJSON(w, http.StatusInternalServerError, envelope{"error": http.StatusText(http.StatusInternalServerError)}, nil)
+				return
+			}
+		}
+
+		_, previous, err := repo.OinkRepository.OinkSetImage(r.Context(), oink.Name, kind, key)
+		if err != nil {
+			s.deleteImage(r.Context(), key)
+			if errors.Is(err, repository.ErrOinkNotFound) {
+				s.writeJSON(w, http.StatusNotFound, envelope{"error": err.Error()}, nil)
+				return
+			}
+			if errors.Is(err, repository.ErrOinkArchived) {
+				s.writeJSON(w, http.StatusConflict, envelope{"error": err.Error()}, nil)
+				return
+			}
+			logger.Error().Err(err).Msg("api-oinkImageHandler-OinkSetImage")
+			s.writeJSON(w, http.StatusInternalServerError, envelope{"error": http.StatusText(http.StatusInternalServerError)}, nil)
+			return
+		}
+		s.deleteImage(r.Context(), previous)
+
+		if !upload {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		s.writeJSON(w, http.StatusOK, envelope{string(kind): s.imageURLs(key)}, nil)
+	}
+}
+
+func (s *Server) UserAvatarUpload() http.HandlerFunc {
+	return s.userAvatarHandler(true)
+}
+
+func (s *Server) UserAvatarDelete() http.HandlerFunc {
+	return s.userAvatarHandler(false)
+}
+
+// userAvatarHandler replaces or removes the avatar of a user. Users may change
+// their own avatar, admins anybody's.
+func (s *Server) userAvatarHandler(upload bool) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := hlog.FromRequest(r)
+		userID := chi.URLParam(r, "userID")
+
+		requester, ok := r.Context().Value("user").(*repository.User)
+		if !ok || (requester.ID != userID && !requester.IsAdmin) {
+			s.writeJSON(w, http.StatusForbidden, envelope{"error": http.StatusText(http.StatusForbidden)}, nil)
+			return
+		}
+
+		repo := repository.New(s.db, *logger)
+		_, err := repo.UserRepository.UserRetrieve(r.Context(), userID)
+		if err != nil {
+			if errors.Is(err, repository.ErrUserNotFound) {
+				s.writeJSON(w, http.StatusNotFound, envelope{"error": err.Error()}, nil)
+				return
+			}
+			logger.Error().Err(err).Msg("api-userAvatarHandler-UserRetrieve")
+			s.writeJSON(w, http.StatusInternalServerError, envelope{"error": http.StatusText(http.StatusInternalServerError)}, nil)
+			return
+		}
+
+		key := ""
+		if upload {
+			image, ok := s.readImageUpload(w, r, avatarSize)
+			if !ok {
+				return
+			}
+
+			key, err = s.putImage(r.Context(), "users/"+userID, "avatar", image)
+			if err != nil {
+				logger.Error().Err(err).Msg("api-userAvatarHandler-putImage")
+				s.writeJSON(w, http.StatusInternalServerError, envelope{"error": http.StatusText(http.StatusInternalServerError)}, nil)
+				return
+			}
+		}
+
+		_, previous, err := repo.UserRepository.UserSetAvatar(r.Context(), userID, key)
+		if err != nil {
+			s.deleteImage(r.Context(), key)
+			if errors.Is(err, repository.ErrUserNotFound) {
+				s.writeJSON(w, http.StatusNotFound, envelope{"error": err.Error()}, nil)
+				return
+			}
+			if errors.Is(err, repository.ErrUserGhost) {
+				s.writeJSON(w, http.StatusBadRequest, envelope{"error": err.Error()}, nil)
+				return
+			}
+			logger.Error().Err(err).Msg("api-userAvatarHandler-UserSetAvatar")
+			s.writeJSON(w, http.StatusInternalServerError, envelope{"error": http.StatusText(http.StatusInternalServerError)}, nil)
+			return
+		}
+		s.deleteImage(r.Context(), previous)
+
+		if !upload {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		s.writeJSON(w, http.StatusOK, envelope{"avatar": s.imageURLs(key)}, nil)
+	}
+}
+
+// BlobServe serves stored blobs. Their keys are never reused, so they may be
+// cached for good.
+func (s *Server) BlobServe() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := hlog.FromRequest(r)
+		key := chi.URLParam(r, "*")
+
+		body, contentType, err := s.config.Blobs.Get(r.Context(), key)
+		if err != nil {
+			if errors.Is(err, blob.ErrNotFound) || errors.Is(err, blob.ErrInvalidKey) {
+				s.writeJSON(w, http.StatusNotFound, envelope{"error": blob.ErrNotFound.Error()}, nil)
+				return
+			}
+			logger.Error().Err(err).Msg("api-BlobServe-Get")
+			s.writeJSON(w, http.StatusInternalServerError, envelope{"error": http.StatusText(http.StatusInternalServerError)}, nil)
+			return
+		}
+		defer body.Close()
+
+		w.Header().Set("Content-Type", contentType)
+		w.Header().Set("Cache-Control", "public, max-age=31536000, immutable")
+		w.Header().Set("X-Content-Type-Options", "nosniff")
+		if _, err := io.Copy(w, body); err != nil {
+			logger.Error().Err(err).Msg("api-BlobServe-Copy")
+		}
+	}
+}
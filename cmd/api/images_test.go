@@ -0,0 +1,143 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"image"
+	"image/png"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-chi/chi/v5"
+	"github.com/mrityunjaygr8/go-oink/internal/blob"
+	"github.com/mrityunjaygr8/go-oink/internal/repository"
+)
+
+func TestThumbnailKey(t *testing.T) {
+	tests := map[string]string{
+		"oinks/id/avatar-x.png": "oinks/id/avatar-x-thumb.png",
+		"users/id/avatar-x":     "users/id/avatar-x-thumb",
+		"users/i.d/avatar-x":    "users/i.d/avatar-x-thumb",
+	}
+	for key, want := range tests {
+		if got := thumbnailKey(key); got != want {
+			t.Errorf("thumbnailKey(%q) = %q, want %q", key, got, want)
+		}
+	}
+}
+
+func TestUserAvatarUpload(t *testing.T) {
+	pngImage := func() []byte {
+		var buf bytes.Buffer
+		png.Encode(&buf, image.NewRGBA(image.Rect(0, 0, 4, 4)))
+		return buf.Bytes()
+	}
+	upload := func(s *Server, field string, data []byte) *httptest.ResponseRecorder {
+		var body bytes.Buffer
+		form := multipart.NewWriter(&body)
+		part, _ := form.CreateFormFile(field, "upload")
+		part.Write(data)
+		form.Close()
+
+		r := httptest.NewRequest(http.MethodPut, "/users/id/avatar", &body)
+		r.Header.Set("Content-Type", form.FormDataContentType())
+		r = r.WithContext(context.WithValue(r.Context(), "user", &repository.User{ID: "id"}))
+
+		router := chi.NewRouter()
+		router.Put("/users/{userID}/avatar", s.UserAvatarUpload())
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, r)
+		return rec
+	}
+	newServer := func(t *testing.T) (*Server, sqlmock.Sqlmock, string) {
+		s, mock := newTestServer(t)
+		dir := t.TempDir()
+		s.config.Blobs = blob.NewLocalStore(dir, "/api/v1/blobs")
+		mock.ExpectQuery(`(?i)from "users"`).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("id"))
+		return s, mock, dir
+	}
+
+	t.Run("not-an-image", func(t *testing.T) {
+		s, _, _ := newServer(t)
+		rec := upload(s, imageUploadField, []byte("<html><body>oink</body></html>"))
+		if rec.Code != http.StatusUnsupportedMediaType {
+			t.Fatalf("got status %d, want %d", rec.Code, http.StatusUnsupportedMediaType)
+		}
+	})
+
+	t.Run("missing-field", func(t *testing.T) {
+		s, _, _ := newServer(t)
+		rec := upload(s, "file", pngImage())
+		if rec.Code != http.StatusBadRequest {
+			t.Fatalf("got status %d, want %d", rec.Code, http.StatusBadRequest)
+		}
+	})
+
+	t.Run("too-large", func(t *testing.T) {
+		s, _, _ := newServer(t)
+		s.config.UploadMaxBytes = 64
+		rec := upload(s, imageUploadField, pngImage())
+		if rec.Code != http.StatusRequestEntityTooLarge {
+			t.Fatalf("got status %d, want %d", rec.Code, http.StatusRequestEntityTooLarge)
+		}
+	})
+
+	t.Run("db-error-removes-blobs", func(t *testing.T) {
+		s, mock, dir := newServer(t)
+		mock.ExpectQuery(`(?i)from "users"`).WillReturnError(errDB)
+
+		rec := upload(s, imageUploadField, pngImage())
+		if rec.Code != http.StatusInternalServerError {
+			t.Fatalf("got status %d, want %d", rec.Code, http.StatusInternalServerError)
+		}
+
+		entries, err := os.ReadDir(dir + "/users/id")
+		if err != nil {
+			t.Fatalf("reading blob dir: %v", err)
+		}
+		if len(entries) != 0 {
+			t.Fatalf("blobs left behind: %v", entries)
+		}
+	})
+}
+
+func TestUserAvatarUploadForbidden(t *testing.T) {
+	s, _ := newTestServer(t)
+
+	r := httptest.NewRequest(http.MethodPut, "/users/other/avatar", nil)
+	r = r.WithContext(context.WithValue(r.Context(), "user", &repository.User{ID: "id"}))
+	router := chi.NewRouter()
+	router.Put("/users/{userID}/avatar", s.UserAvatarUpload())
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, r)
+
+	if rec.Code != http.StatusForbidden {
+		t.Fatalf("got status %d, want %d", rec.Code, http.StatusForbidden)
+	}
+}
+
+func TestBlobServe(t *testing.T) {
+	s, _ := newTestServer(t)
+	s.config.Blobs = blob.NewLocalStore(t.TempDir(), "/api/v1/blobs")
+	if err := s.config.Blobs.Put(context.Background(), "oinks/id/avatar-x.png", strings.NewReader("png"), "image/png"); err != nil {
+		t.Fatalf("Put: %v", err)
+	}
+
+	rec := serve(http.MethodGet, "/blobs/*", "/blobs/oinks/id/avatar-x.png", s.BlobServe())
+	if rec.Code != http.StatusOK || rec.Body.String() != "png" {
+		t.Fatalf("got %d %q, want the blob", rec.Code, rec.Body.String())
+	}
+	if got := rec.Header().Get("Content-Type"); got != "image/png" {
+		t.Fatalf("got content type %q, want image/png", got)
+	}
+
+	rec = serve(http.MethodGet, "/blobs/*", "/blobs/oinks/id/missing.png", s.BlobServe())
+	if rec.Code != http.StatusNotFound {
+		t.Fatalf("got status %d, want %d", rec.Code, http.StatusNotFound)
+	}
+}
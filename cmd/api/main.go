@@ -8,6 +8,7 @@ import (
 	_ "github.com/lib/pq"
 	"github.com/rs/zerolog"
 
+	"github.com/mrityunjaygr8/go-oink/internal/blob"
 	"github.com/mrityunjaygr8/go-oink/internal/config"
 )
 
@@ -31,7 +32,45 @@ func main() {
 	srvConf := ServerConf{
 		Addr: c.SrvAddr,
 		Port: c.SrvPort,
+
+		SearchLanguage: c.SearchLanguage,
+		UploadMaxBytes: c.UploadMaxBytes,
+
+		PageDefaultLimit: c.PageDefaultLimit,
+		PageMaxLimit:     c.PageMaxLimit,
+
+		IdempotencyTTL: c.IdempotencyTTL,
+	}
+
+	switch c.BlobBackend {
+	case config.BlobBackendS3:
+		srvConf.Blobs = blob.NewS3Store(blob.S3Config{
+			Endpoint:  c.S3Endpoint,
+			Region:    c.S3Region,
+			Bucket:    c.S3Bucket,
+			AccessKey: c.S3AccessKey,
+			SecretKey: c.S3SecretKey,
+			PublicURL: c.S3PublicURL,
+		})
+	case config.BlobBackendLocal:
+		if c.BlobDir == "" {
+			logger.Fatal().Msg("BLOB_DIR must be set for the local blob backend")
+		}
+		if err := checkWritableDir(c.BlobDir, 0o755); err != nil {
+			logger.Fatal().Err(err).Str("dir", c.BlobDir).Msg("blob directory is not writable")
+		}
+		srvConf.Blobs = blob.NewLocalStore(c.BlobDir, c.BlobBaseURL)
+	default:
+		logger.Fatal().Str("backend", c.BlobBackend).Msg("unknown blob backend")
+	}
+
+	if c.ExportDir == "" {
+		logger.Fatal().Msg("EXPORT_DIR must be set")
 	}
+	if err := checkWritableDir(c.ExportDir, 0o700); err != nil {
+		logger.Fatal().Err(err).Str("dir", c.ExportDir).Msg("export directory is not writable")
+	}
+	srvConf.ExportDir = c.ExportDir
 
 	if c.DbDsn == "" && c.DbHost == "" {
 		logger.Fatal().Any("config", c).Msg("DB configuration not found. Either specify the DSN or the individual components.")
@@ -55,3 +94,19 @@ func main() {
 	a := NewServer(logger, db, srvConf)
 	a.Serve()
 }
+
+// checkWritableDir creates dir with perm if it is missing and makes sure
+// files can be written to it, so a misconfigured directory stops the server
+// at startup instead of failing every upload.
+func checkWritableDir(dir string, perm os.FileMode) error {
+	if err := os.MkdirAll(dir, perm); err != nil {
+		return err
+	}
+
+	f, err := os.CreateTemp(dir, ".check-*")
+	if err != nil {
+		return err
+	}
+	f.Close()
+	return os.Remove(f.Name())
+}
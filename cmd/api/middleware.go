@@ -39,6 +39,11 @@ func (s *Server) AddUserCtx() func(next http.Handler) http.Handler {
 
 			user, err := repo.UserRepository.UserRetrieve(r.Context(), token.UserID)
 			if err != nil {
+				if errors.Is(err, repository.ErrUserNotFound) {
+					ctx := context.WithValue(r.Context(), "user", nil)
+					next.ServeHTTP(w, r.WithContext(ctx))
+					return
+				}
 				logger.Error().Err(err).Msg("middleware-AddUserCtx-UserRetrieve")
 				s.writeJSON(w, http.StatusInternalServerError, envelope{"error": http.StatusText(http.StatusInternalServerError)}, nil)
 				return
@@ -0,0 +1,44 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestAddUserCtxTokenLookup(t *testing.T) {
+	guarded := func(s *Server) http.Handler {
+		return s.AddUserCtx()(s.AuthorizedGuard(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		})))
+	}
+	request := func() *http.Request {
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+		r.Header.Set("Authorization", "Bearer token")
+		return r
+	}
+
+	t.Run("token-missing", func(t *testing.T) {
+		s, mock := newTestServer(t)
+		mock.ExpectQuery(`(?i)from "tokens"`).WillReturnRows(sqlmock.NewRows([]string{"token"}))
+
+		rec := httptest.NewRecorder()
+		guarded(s).ServeHTTP(rec, request())
+		if rec.Code != http.StatusForbidden {
+			t.Fatalf("got status %d, want %d", rec.Code, http.StatusForbidden)
+		}
+	})
+
+	t.Run("token-db-error", func(t *testing.T) {
+		s, mock := newTestServer(t)
+		mock.ExpectQuery(`(?i)from "tokens"`).WillReturnError(errDB)
+
+		rec := httptest.NewRecorder()
+		guarded(s).ServeHTTP(rec, request())
+		if rec.Code != http.StatusInternalServerError {
+			t.Fatalf("got status %d, want %d", rec.Code, http.StatusInternalServerError)
+		}
+	})
+}
@@ -0,0 +1,240 @@
+package main
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/mrityunjaygr8/go-oink/internal/repository"
+	"github.com/mrityunjaygr8/go-oink/internal/validator"
+	"github.com/rs/zerolog/hlog"
+)
+
+type oinkInvitationResponse struct {
+	OinkID    string    `json:"oink_id"`
+	UserID    string    `json:"user_id"`
+	Username  string    `json:"username,omitempty"`
+	Kind      string    `json:"kind"`
+	CreatedBy string    `json:"created_by"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func newOinkInvitationResponse(invitation *repository.OinkInvitation) oinkInvitationResponse {
+	return oinkInvitationResponse{
+		OinkID:    invitation.OinkID,
+		UserID:    invitation.UserID,
+		Username:  invitation.Username,
+		Kind:      invitation.Kind,
+		CreatedBy: invitation.CreatedBy,
+		CreatedAt: invitation.CreatedAt,
+	}
+}
+
+// authorizeOinkAdmission checks that the requesting user may let people into
+// oinkName, writing the response when they may not.
+func (s *Server) authorizeOinkAdmission(w http.ResponseWriter, r *http.Request, repo *repository.Repository, oinkName string) bool {
+	logger := hlog.FromRequest(r)
+
+	if _, ok := s.retrieveVisibleOink(w, r, repo, oinkName); !ok {
+		return false
+	}
+
+	allowed, err := s.authorizeOink(r, repo, oinkName, repository.OinkRoleOwner, repository.OinkRoleModerator)
+	if err != nil {
+		logger.Error().Err(err).Msg("api-authorizeOinkAdmission-authorizeOink")
+		s.writeJSON(w, http.StatusInternalServerError, envelope{"error": http.StatusText(http.StatusInternalServerError)}, nil)
+		return false
+	}
+	if !allowed {
+		s.writeJSON(w, http.StatusForbidden, envelope{"error": http.StatusText(http.StatusForbidden)}, nil)
+		return false
+	}
+
+	return true
+}
+
+func (s *Server) OinkInvitationList() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := hlog.FromRequest(r)
+		oinkName := chi.URLParam(r, "oinkName")
+
+		repo := repository.New(s.db, *logger)
+		if !s.authorizeOinkAdmission(w, r, repo, oinkName) {
+			return
+		}
+
+		i, err := repo.OinkInvitationRepository.OinkInvitationList(r.Context(), oinkName)
+		if err != nil {
+			if errors.Is(err, repository.ErrOinkNotFound) {
+				s.writeJSON(w, http.StatusNotFound, envelope{"error": err.Error()}, nil)
+				return
+			}
+			logger.Error().Err(err).Msg("api-OinkInvitationList-OinkInvitationList")
+			s.writeJSON(w, http.StatusInternalServerError, envelope{"error": http.StatusText(http.StatusInternalServerError)}, nil)
+			return
+		}
+
+		invitations := make([]oinkInvitationResponse, 0)
+		for _, invitation := range *i {
+			invitations = append(invitations, newOinkInvitationResponse(&invitation))
+		}
+
+		s.writeJSON(w, http.StatusOK, envelope{"invitations": invitations}, nil)
+	}
+}
+
+func (s *Server) OinkInvite() http.HandlerFunc {
+	type request struct {
+		UserID string `json:"user_id"`
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := hlog.FromRequest(r)
+		oinkName := chi.URLParam(r, "oinkName")
+
+		var req request
+		err := s.readJSON(w, r, &req)
+		if err != nil {
+			logger.Error().Err(err).Msg("api-OinkInvite-readJson")
+			s.writeJSON(w, http.StatusBadRequest, envelope{"error": err.Error()}, nil)
+			return
+		}
+
+		v := validator.New()
+		v.Check(validator.NotBlank(req.UserID), "user_id", "must be provided")
+		if !v.Valid() {
+			s.failedValidationResponse(w, v.Errors)
+			return
+		}
+
+		u, ok := r.Context().Value("user").(*repository.User)
+		if !ok {
+			logger.Error().Any("User", r.Context().Value("user")).Msg("api-OinkInvite-userTypeAssertion")
+			s.writeJSON(w, http.StatusInternalServerError, envelope{"error": http.StatusText(http.StatusInternalServerError)}, nil)
+			return
+		}
+
+		repo := repository.New(s.db, *logger)
+		if !s.authorizeOinkAdmission(w, r, repo, oinkName) {
+			return
+		}
+
+		invitation, err := repo.OinkInvitationRepository.OinkInvite(r.Context(), oinkName, req.UserID, u.ID)
+		if err != nil {
+			if errors.Is(err, repository.ErrOinkNotFound) {
+				s.writeJSON(w, http.StatusNotFound, envelope{"error": err.Error()}, nil)
+				return
+			}
+			if errors.Is(err, repository.ErrUserNotFound) || errors.Is(err, repository.ErrOinkMemberExists) {
+				s.writeJSON(w, http.StatusBadRequest, envelope{"error": err.Error()}, nil)
+				return
+			}
+			if errors.Is(err, repository.ErrOinkArchived) {
+				s.writeJSON(w, http.StatusConflict, envelope{"error": err.Error()}, nil)
+				return
+			}
+			logger.Error().Err(err).Msg("api-OinkInvite-OinkInvite")
+			s.writeJSON(w, http.StatusInternalServerError, envelope{"error": http.StatusText(http.StatusInternalServerError)}, nil)
+			return
+		}
+
+		s.writeJSON(w, http.StatusCreated, envelope{"invitation": newOinkInvitationResponse(invitation)}, nil)
+	}
+}
+
+func (s *Server) OinkRequestApprove() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := hlog.FromRequest(r)
+		oinkName := chi.URLParam(r, "oinkName")
+		userID := chi.URLParam(r, "userID")
+
+		repo := repository.New(s.db, *logger)
+		if !s.authorizeOinkAdmission(w, r, repo, oinkName) {
+			return
+		}
+
+		tx, err := s.db.Begin()
+		if err != nil {
+			logger.Error().Err(err).Msg("error creating transaction")
+			s.writeJSON(w, http.StatusInternalServerError, envelope{"error": http.StatusText(http.StatusInternalServerError)}, nil)
+			return
+		}
+
+		txRepo := repository.New(tx, *logger)
+		member, err := txRepo.OinkInvitationRepository.OinkRequestApprove(r.Context(), oinkName, userID)
+		if err != nil {
+			if rollback := tx.Rollback(); rollback != nil {
+				logger.Error().Err(err).Msg("api-OinkRequestApprove-OinkRequestApprove-RollbackError")
+				s.writeJSON(w, http.StatusInternalServerError, envelope{"error": http.StatusText(http.StatusInternalServerError)}, nil)
+				return
+			}
+			if errors.Is(err, repository.ErrOinkNotFound) || errors.Is(err, repository.ErrOinkInvitationNotFound) {
+				s.writeJSON(w, http.StatusNotFound, envelope{"error": err.Error()}, nil)
+				return
+			}
+			if errors.Is(err, repository.ErrOinkArchived) {
+				s.writeJSON(w, http.StatusConflict, envelope{"error": err.Error()}, nil)
+				return
+			}
+			logger.Error().Err(err).Msg("api-OinkRequestApprove-OinkRequestApprove")
+			s.writeJSON(w, http.StatusInternalServerError, envelope{"error": http.StatusText(http.StatusInternalServerError)}, nil)
+			return
+		}
+
+		if err := tx.Commit(); err != nil {
+			logger.Error().Err(err).Msg("api-OinkRequestApprove-OinkRequestApprove-CommitError")
+			s.writeJSON(w, http.StatusInternalServerError, envelope{"error": http.StatusText(http.StatusInternalServerError)}, nil)
+			return
+		}
+
+		resp := oinkMemberResponse{
+			OinkID:    member.OinkID,
+			UserID:    member.UserID,
+			Username:  member.Username,
+			Role:      member.Role,
+			CreatedAt: member.CreatedAt,
+		}
+
+		s.writeJSON(w, http.StatusCreated, envelope{"member": resp}, nil)
+	}
+}
+
+// OinkInvitationDelete withdraws an invitation or rejects a join request.
+// Invited users may also decline their own invitation.
+func (s *Server) OinkInvitationDelete() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := hlog.FromRequest(r)
+		oinkName := chi.URLParam(r, "oinkName")
+		userID := chi.URLParam(r, "userID")
+
+		u, ok := r.Context().Value("user").(*repository.User)
+		if !ok {
+			logger.Error().Any("User", r.Context().Value("user")).Msg("api-OinkInvitationDelete-userTypeAssertion")
+			s.writeJSON(w, http.StatusInternalServerError, envelope{"error": http.StatusText(http.StatusInternalServerError)}, nil)
+			return
+		}
+
+		repo := repository.New(s.db, *logger)
+		if u.ID != userID && !s.authorizeOinkAdmission(w, r, repo, oinkName) {
+			return
+		}
+
+		err := repo.OinkInvitationRepository.OinkInvitationDelete(r.Context(), oinkName, userID)
+		if err != nil {
+			if errors.Is(err, repository.ErrOinkNotFound) || errors.Is(err, repository.ErrOinkInvitationNotFound) {
+				s.writeJSON(w, http.StatusNotFound, envelope{"error": err.Error()}, nil)
+				return
+			}
+			if errors.Is(err, repository.ErrOinkArchived) {
+				s.writeJSON(w, http.StatusConflict, envelope{"error": err.Error()}, nil)
+				return
+			}
+			logger.Error().Err(err).Msg("api-OinkInvitationDelete-OinkInvitationDelete")
+			s.writeJSON(w, http.StatusInternalServerError, envelope{"error": http.StatusText(http.StatusInternalServerError)}, nil)
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
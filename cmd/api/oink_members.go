@@ -0,0 +1,160 @@
+package main
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/mrityunjaygr8/go-oink/internal/repository"
+	"github.com/rs/zerolog/hlog"
+)
+
+func (s *Server) OinkMemberJoin() http.HandlerFunc {
+	type response struct {
+		OinkID    string    `json:"oink_id"`
+		UserID    string    `json:"user_id"`
+		Role      string    `json:"role"`
+		CreatedAt time.Time `json:"created_at"`
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := hlog.FromRequest(r)
+		oinkName := chi.URLParam(r, "oinkName")
+
+		u, ok := r.Context().Value("user").(*repository.User)
+		if !ok {
+			logger.Error().Any("User", r.Context().Value("user")).Msg("api-OinkMemberJoin-userTypeAssertion")
+			s.writeJSON(w, http.StatusInternalServerError, envelope{"error": http.StatusText(http.StatusInternalServerError)}, nil)
+			return
+		}
+
+		repo := repository.New(s.db, *logger)
+		member, err := repo.OinkMemberRepository.OinkMemberJoin(r.Context(), oinkName, u.ID)
+		if err != nil {
+			if errors.Is(err, repository.ErrOinkNotFound) {
+				s.writeJSON(w, http.StatusNotFound, envelope{"error": err.Error()}, nil)
+				return
+			}
+			if errors.Is(err, repository.ErrOinkMemberExists) {
+				s.writeJSON(w, http.StatusBadRequest, envelope{"error": err.Error()}, nil)
+				return
+			}
+			if errors.Is(err, repository.ErrOinkJoinRequested) {
+				s.writeJSON(w, http.StatusAccepted, envelope{"message": err.Error()}, nil)
+				return
+			}
+			if errors.Is(err, repository.ErrOinkArchived) {
+				s.writeJSON(w, http.StatusConflict, envelope{"error": err.Error()}, nil)
+				return
+			}
+			logger.Error().Err(err).Msg("api-OinkMemberJoin-OinkMemberJoin")
+			s.writeJSON(w, http.StatusInternalServerError, envelope{"error": http.StatusText(http.StatusInternalServerError)}, nil)
+			return
+		}
+
+		resp := response{
+			OinkID:    member.OinkID,
+			UserID:    member.UserID,
+			Role:      member.Role,
+			CreatedAt: member.CreatedAt,
+		}
+
+		s.writeJSON(w, http.StatusCreated, envelope{"member": resp}, nil)
+	}
+}
+
+func (s *Server) OinkMemberLeave() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := hlog.FromRequest(r)
+		oinkName := chi.URLParam(r, "oinkName")
+
+		u, ok := r.Context().Value("user").(*repository.User)
+		if !ok {
+			logger.Error().Any("User", r.Context().Value("user")).Msg("api-OinkMemberLeave-userTypeAssertion")
+			s.writeJSON(w, http.StatusInternalServerError, envelope{"error": http.StatusText(http.StatusInternalServerError)}, nil)
+			return
+		}
+
+		repo := repository.New(s.db, *logger)
+		err := repo.OinkMemberRepository.OinkMemberLeave(r.Context(), oinkName, u.ID)
+		if err != nil {
+			if errors.Is(err, repository.ErrOinkNotFound) || errors.Is(err, repository.ErrOinkMemberNotFound) {
+				s.writeJSON(w, http.StatusNotFound, envelope{"error": err.Error()}, nil)
+				return
+			}
+			if errors.Is(err, repository.ErrOinkOwnerRole) {
+				s.writeJSON(w, http.StatusBadRequest, envelope{"error": err.Error()}, nil)
+				return
+			}
+			if errors.Is(err, repository.ErrOinkArchived) {
+				s.writeJSON(w, http.StatusConflict, envelope{"error": err.Error()}, nil)
+				return
+			}
+			logger.Error().Err(err).Msg("api-OinkMemberLeave-OinkMemberLeave")
+			s.writeJSON(w, http.StatusInternalServerError, envelope{"error": http.StatusText(http.StatusInternalServerError)}, nil)
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func (s *Server) OinkMemberList() http.HandlerFunc {
+	const (
+		defaultLimit = 20
+		maxLimit     = 100
+	)
+
+	type Member struct {
+		UserID    string    `json:"user_id"`
+		Username  string    `json:"username"`
+		Role      string    `json:"role"`
+		CreatedAt time.Time `json:"created_at"`
+	}
+
+	type metadata struct {
+		Total  int64 `json:"total"`
+		Limit  int   `json:"limit"`
+		Offset int   `json:"offset"`
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := hlog.FromRequest(r)
+		oinkName := chi.URLParam(r, "oinkName")
+
+		limit, offset, err := s.readPage(r.URL.Query(), defaultLimit, maxLimit)
+		if err != nil {
+			s.writeJSON(w, http.StatusBadRequest, envelope{"error": err.Error()}, nil)
+			return
+		}
+
+		repo := repository.New(s.db, *logger)
+		if _, ok := s.retrieveVisibleOink(w, r, repo, oinkName); !ok {
+			return
+		}
+
+		m, total, err := repo.OinkMemberRepository.OinkMemberList(r.Context(), oinkName, limit, offset)
+		if err != nil {
+			if errors.Is(err, repository.ErrOinkNotFound) {
+				s.writeJSON(w, http.StatusNotFound, envelope{"error": err.Error()}, nil)
+				return
+			}
+			logger.Error().Err(err).Msg("api-OinkMemberList-OinkMemberList")
+			s.writeJSON(w, http.StatusInternalServerError, envelope{"error": http.StatusText(http.StatusInternalServerError)}, nil)
+			return
+		}
+
+		members := make([]Member, 0)
+		for _, member := range *m {
+			members = append(members, Member{
+				UserID:    member.UserID,
+				Username:  member.Username,
+				Role:      member.Role,
+				CreatedAt: member.CreatedAt,
+			})
+		}
+
+		s.writeJSON(w, http.StatusOK, envelope{"members": members, "metadata": metadata{Total: total, Limit: limit, Offset: offset}}, nil)
+	}
+}
@@ -0,0 +1,292 @@
+package main
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/mrityunjaygr8/go-oink/internal/repository"
+	"github.com/mrityunjaygr8/go-oink/internal/validator"
+	"github.com/rs/zerolog/hlog"
+)
+
+type oinkMemberResponse struct {
+	OinkID    string    `json:"oink_id"`
+	UserID    string    `json:"user_id"`
+	Username  string    `json:"username"`
+	Role      string    `json:"role"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type oinkTransferResponse struct {
+	OinkID     string    `json:"oink_id"`
+	FromUserID string    `json:"from_user_id"`
+	ToUserID   string    `json:"to_user_id"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// oinkModeratorHandler grants or revokes the moderator role of a member.
+// Only the oink's owner, or an admin, may do so.
+func (s *Server) oinkModeratorHandler(moderator bool) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := hlog.FromRequest(r)
+		oinkName := chi.URLParam(r, "oinkName")
+		userID := chi.URLParam(r, "userID")
+
+		repo := repository.New(s.db, *logger)
+		allowed, err := s.authorizeOink(r, repo, oinkName, repository.OinkRoleOwner)
+		if err != nil {
+			if errors.Is(err, repository.ErrOinkNotFound) {
+				s.writeJSON(w, http.StatusNotFound, envelope{"error": err.Error()}, nil)
+				return
+			}
+			logger.Error().Err(err).Msg("api-oinkModeratorHandler-authorizeOink")
+			s.writeJSON(w, http.StatusInternalServerError, envelope{"error": http.StatusText(http.StatusInternalServerError)}, nil)
+			return
+		}
+		if !allowed {
+			s.writeJSON(w, http.StatusForbidden, envelope{"error": http.StatusText(http.StatusForbidden)}, nil)
+			return
+		}
+
+		member, err := repo.OinkMemberRepository.OinkMemberSetModerator(r.Context(), oinkName, userID, moderator)
+		if err != nil {
+			if errors.Is(err, repository.ErrOinkNotFound) || errors.Is(err, repository.ErrOinkMemberNotFound) {
+				s.writeJSON(w, http.StatusNotFound, envelope{"error": err.Error()}, nil)
+				return
+			}
+			if errors.Is(err, repository.ErrOinkOwnerRole) {
+				s.writeJSON(w, http.StatusBadRequest, envelope{"error": err.Error()}, nil)
+				return
+			}
+			if errors.Is(err, repository.ErrOinkArchived) {
+				s.writeJSON(w, http.StatusConflict, envelope{"error": err.Error()}, nil)
+				return
+			}
+			logger.Error().Err(err).Msg("api-oinkModeratorHandler-OinkMemberSetModerator")
+			s.writeJSON(w, http.StatusInternalServerError, envelope{"error": http.StatusText(http.StatusInternalServerError)}, nil)
+			return
+		}
+
+		if !moderator {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		resp := oinkMemberResponse{
+			OinkID:    member.OinkID,
+			UserID:    member.UserID,
+			Username:  member.Username,
+			Role:      member.Role,
+			CreatedAt: member.CreatedAt,
+		}
+
+		s.writeJSON(w, http.StatusOK, envelope{"member": resp}, nil)
+	}
+}
+
+func (s *Server) OinkModeratorGrant() http.HandlerFunc {
+	return s.oinkModeratorHandler(true)
+}
+
+func (s *Server) OinkModeratorRevoke() http.HandlerFunc {
+	return s.oinkModeratorHandler(false)
+}
+
+func (s *Server) OinkTransferOffer() http.HandlerFunc {
+	type request struct {
+		UserID string `json:"user_id"`
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := hlog.FromRequest(r)
+		oinkName := chi.URLParam(r, "oinkName")
+
+		var req request
+		err := s.readJSON(w, r, &req)
+		if err != nil {
+			logger.Error().Err(err).Msg("api-OinkTransferOffer-readJson")
+			s.writeJSON(w, http.StatusBadRequest, envelope{"error": err.Error()}, nil)
+			return
+		}
+
+		v := validator.New()
+		v.Check(validator.NotBlank(req.UserID), "user_id", "must be provided")
+		if !v.Valid() {
+			s.failedValidationResponse(w, v.Errors)
+			return
+		}
+
+		u, ok := r.Context().Value("user").(*repository.User)
+		if !ok {
+			logger.Error().Any("User", r.Context().Value("user")).Msg("api-OinkTransferOffer-userTypeAssertion")
+			s.writeJSON(w, http.StatusInternalServerError, envelope{"error": http.StatusText(http.StatusInternalServerError)}, nil)
+			return
+		}
+
+		repo := repository.New(s.db, *logger)
+		allowed, err := s.authorizeOink(r, repo, oinkName, repository.OinkRoleOwner)
+		if err != nil {
+			if errors.Is(err, repository.ErrOinkNotFound) {
+				s.writeJSON(w, http.StatusNotFound, envelope{"error": err.Error()}, nil)
+				return
+			}
+			logger.Error().Err(err).Msg("api-OinkTransferOffer-authorizeOink")
+			s.writeJSON(w, http.StatusInternalServerError, envelope{"error": http.StatusText(http.StatusInternalServerError)}, nil)
+			return
+		}
+		if !allowed {
+			s.writeJSON(w, http.StatusForbidden, envelope{"error": http.StatusText(http.StatusForbidden)}, nil)
+			return
+		}
+
+		transfer, err := repo.OinkTransferRepository.OinkTransferOffer(r.Context(), oinkName, u.ID, req.UserID)
+		if err != nil {
+			if errors.Is(err, repository.ErrOinkNotFound) {
+				s.writeJSON(w, http.StatusNotFound, envelope{"error": err.Error()}, nil)
+				return
+			}
+			if errors.Is(err, repository.ErrOinkMemberNotFound) || errors.Is(err, repository.ErrOinkTransferToOwner) {
+				s.writeJSON(w, http.StatusBadRequest, envelope{"error": err.Error()}, nil)
+				return
+			}
+			if errors.Is(err, repository.ErrOinkArchived) {
+				s.writeJSON(w, http.StatusConflict, envelope{"error": err.Error()}, nil)
+				return
+			}
+			logger.Error().Err(err).Msg("api-OinkTransferOffer-OinkTransferOffer")
+			s.writeJSON(w, http.StatusInternalServerError, envelope{"error": http.StatusText(http.StatusInternalServerError)}, nil)
+			return
+		}
+
+		resp := oinkTransferResponse{
+			OinkID:     transfer.OinkID,
+			FromUserID: transfer.FromUserID,
+			ToUserID:   transfer.ToUserID,
+			CreatedAt:  transfer.CreatedAt,
+		}
+
+		s.writeJSON(w, http.StatusCreated, envelope{"transfer": resp}, nil)
+	}
+}
+
+func (s *Server) OinkTransferAccept() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := hlog.FromRequest(r)
+		oinkName := chi.URLParam(r, "oinkName")
+
+		u, ok := r.Context().Value("user").(*repository.User)
+		if !ok {
+			logger.Error().Any("User", r.Context().Value("user")).Msg("api-OinkTransferAccept-userTypeAssertion")
+			s.writeJSON(w, http.StatusInternalServerError, envelope{"error": http.StatusText(http.StatusInternalServerError)}, nil)
+			return
+		}
+
+		tx, err := s.db.Begin()
+		if err != nil {
+			logger.Error().Err(err).Msg("error creating transaction")
+			s.writeJSON(w, http.StatusInternalServerError, envelope{"error": http.StatusText(http.StatusInternalServerError)}, nil)
+			return
+		}
+
+		repo := repository.New(tx, *logger)
+		member, err := repo.OinkTransferRepository.OinkTransferAccept(r.Context(), oinkName, u.ID)
+		if err != nil {
+			if rollback := tx.Rollback(); rollback != nil {
+				logger.Error().Err(err).Msg("api-OinkTransferAccept-OinkTransferAccept-RollbackError")
+				s.writeJSON(w, http.StatusInternalServerError, envelope{"error": http.StatusText(http.StatusInternalServerError)}, nil)
+				return
+			}
+			if errors.Is(err, repository.ErrOinkNotFound) || errors.Is(err, repository.ErrOinkTransferNotFound) {
+				s.writeJSON(w, http.StatusNotFound, envelope{"error": err.Error()}, nil)
+				return
+			}
+			if errors.Is(err, repository.ErrOinkMemberNotFound) {
+				s.writeJSON(w, http.StatusBadRequest, envelope{"error": err.Error()}, nil)
+				return
+			}
+			if errors.Is(err, repository.ErrOinkArchived) {
+				s.writeJSON(w, http.StatusConflict, envelope{"error": err.Error()}, nil)
+				return
+			}
+			logger.Error().Err(err).Msg("api-OinkTransferAccept-OinkTransferAccept")
+			s.writeJSON(w, http.StatusInternalServerError, envelope{"error": http.StatusText(http.StatusInternalServerError)}, nil)
+			return
+		}
+
+		if err := tx.Commit(); err != nil {
+			logger.Error().Err(err).Msg("api-OinkTransferAccept-OinkTransferAccept-CommitError")
+			s.writeJSON(w, http.StatusInternalServerError, envelope{"error": http.StatusText(http.StatusInternalServerError)}, nil)
+			return
+		}
+
+		resp := oinkMemberResponse{
+			OinkID:    member.OinkID,
+			UserID:    member.UserID,
+			Username:  member.Username,
+			Role:      member.Role,
+			CreatedAt: member.CreatedAt,
+		}
+
+		s.writeJSON(w, http.StatusOK, envelope{"member": resp}, nil)
+	}
+}
+
+// OinkTransferCancel withdraws a pending transfer. The owner may cancel it and
+// the recipient may decline it.
+func (s *Server) OinkTransferCancel() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := hlog.FromRequest(r)
+		oinkName := chi.URLParam(r, "oinkName")
+
+		u, ok := r.Context().Value("user").(*repository.User)
+		if !ok {
+			logger.Error().Any("User", r.Context().Value("user")).Msg("api-OinkTransferCancel-userTypeAssertion")
+			s.writeJSON(w, http.StatusInternalServerError, envelope{"error": http.StatusText(http.StatusInternalServerError)}, nil)
+			return
+		}
+
+		repo := repository.New(s.db, *logger)
+		transfer, err := repo.OinkTransferRepository.OinkTransferRetrieve(r.Context(), oinkName)
+		if err != nil {
+			if errors.Is(err, repository.ErrOinkNotFound) || errors.Is(err, repository.ErrOinkTransferNotFound) {
+				s.writeJSON(w, http.StatusNotFound, envelope{"error": err.Error()}, nil)
+				return
+			}
+			logger.Error().Err(err).Msg("api-OinkTransferCancel-OinkTransferRetrieve")
+			s.writeJSON(w, http.StatusInternalServerError, envelope{"error": http.StatusText(http.StatusInternalServerError)}, nil)
+			return
+		}
+
+		if transfer.ToUserID != u.ID {
+			allowed, err := s.authorizeOink(r, repo, oinkName, repository.OinkRoleOwner)
+			if err != nil {
+				logger.Error().Err(err).Msg("api-OinkTransferCancel-authorizeOink")
+				s.writeJSON(w, http.StatusInternalServerError, envelope{"error": http.StatusText(http.StatusInternalServerError)}, nil)
+				return
+			}
+			if !allowed {
+				s.writeJSON(w, http.StatusForbidden, envelope{"error": http.StatusText(http.StatusForbidden)}, nil)
+				return
+			}
+		}
+
+		err = repo.OinkTransferRepository.OinkTransferCancel(r.Context(), oinkName)
+		if err != nil {
+			if errors.Is(err, repository.ErrOinkNotFound) || errors.Is(err, repository.ErrOinkTransferNotFound) {
+				s.writeJSON(w, http.StatusNotFound, envelope{"error": err.Error()}, nil)
+				return
+			}
+			if errors.Is(err, repository.ErrOinkArchived) {
+				s.writeJSON(w, http.StatusConflict, envelope{"error": err.Error()}, nil)
+				return
+			}
+			logger.Error().Err(err).Msg("api-OinkTransferCancel-OinkTransferCancel")
+			s.writeJSON(w, http.StatusInternalServerError, envelope{"error": http.StatusText(http.StatusInternalServerError)}, nil)
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
@@ -0,0 +1,142 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/mrityunjaygr8/go-oink/internal/repository"
+	"github.com/rs/zerolog/hlog"
+)
+
+// statsTTL is how long computed oink statistics are served from the cache.
+const statsTTL = 30 * time.Second
+
+// statsCache keeps recently computed oink statistics so dashboards polling
+// the stats endpoint do not recompute them on every request.
+type statsCache struct {
+	mu      sync.Mutex
+	entries map[string]statsEntry
+}
+
+type statsEntry struct {
+	stats     repository.OinkStats
+	expiresAt time.Time
+}
+
+func newStatsCache() *statsCache {
+	return &statsCache{entries: make(map[string]statsEntry)}
+}
+
+func statsKey(oinkID string, days int) string {
+	return oinkID + "/" + strconv.Itoa(days)
+}
+
+func (c *statsCache) get(oinkID string, days int) (repository.OinkStats, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[statsKey(oinkID, days)]
+	if !ok || time.Now().After(entry.expiresAt) {
+		return repository.OinkStats{}, false
+	}
+
+	return entry.stats, true
+}
+
+func (c *statsCache) set(stats repository.OinkStats) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	for key, entry := range c.entries {
+		if now.After(entry.expiresAt) {
+			delete(c.entries, key)
+		}
+	}
+
+	c.entries[statsKey(stats.OinkID, stats.Days)] = statsEntry{stats: stats, expiresAt: now.Add(statsTTL)}
+}
+
+// OinkStats reports the age, the member and post counts and the daily
+// activity of an oink over the last days days (30 by default).
+func (s *Server) OinkStats() http.HandlerFunc {
+	type activity struct {
+		Date       string `json:"date"`
+		NewMembers int64  `json:"new_members"`
+		NewPosts   int64  `json:"new_posts"`
+	}
+	type response struct {
+		Name        string     `json:"name"`
+		CreatedAt   time.Time  `json:"created_at"`
+		AgeDays     int        `json:"age_days"`
+		MemberCount int64      `json:"member_count"`
+		PostCount   int64      `json:"post_count"`
+		Days        int        `json:"days"`
+		NewMembers  int64      `json:"new_members"`
+		NewPosts    int64      `json:"new_posts"`
+		Activity    []activity `json:"activity"`
+	}
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := hlog.FromRequest(r)
+		repo := repository.New(s.db, *logger)
+		oinkName := chi.URLParam(r, "oinkName")
+
+		days, err := s.readInt(r.URL.Query(), "days", 30)
+		if err != nil {
+			s.writeJSON(w, http.StatusBadRequest, envelope{"error": err.Error()}, nil)
+			return
+		}
+		if days < 1 || days > 365 {
+			s.writeJSON(w, http.StatusBadRequest, envelope{"error": "days must be between 1 and 365"}, nil)
+			return
+		}
+
+		oink, ok := s.retrieveVisibleOink(w, r, repo, oinkName)
+		if !ok {
+			return
+		}
+
+		stats, cached := s.stats.get(oink.ID, days)
+		if !cached {
+			computed, err := repo.OinkRepository.OinkStats(r.Context(), oink.Name, days)
+			if err != nil {
+				if errors.Is(err, repository.ErrOinkNotFound) {
+					s.writeJSON(w, http.StatusNotFound, envelope{"error": err.Error()}, nil)
+					return
+				}
+				logger.Error().Err(err).Msg("api-OinkStats-OinkStats")
+				s.writeJSON(w, http.StatusInternalServerError, envelope{"error": http.StatusText(http.StatusInternalServerError)}, nil)
+				return
+			}
+			stats = *computed
+			s.stats.set(stats)
+		}
+
+		res := response{
+			Name:        oink.Name,
+			CreatedAt:   stats.CreatedAt,
+			AgeDays:     int(time.Since(stats.CreatedAt) / (24 * time.Hour)),
+			MemberCount: stats.Members,
+			PostCount:   stats.Posts,
+			Days:        stats.Days,
+			NewMembers:  stats.NewMembers,
+			NewPosts:    stats.NewPosts,
+			Activity:    make([]activity, 0, len(stats.Activity)),
+		}
+		for _, day := range stats.Activity {
+			res.Activity = append(res.Activity, activity{
+				Date:       day.Day.Format("2006-01-02"),
+				NewMembers: day.NewMembers,
+				NewPosts:   day.NewPosts,
+			})
+		}
+
+		headers := http.Header{"Cache-Control": []string{fmt.Sprintf("private, max-age=%d", int(statsTTL.Seconds()))}}
+		s.writeJSON(w, http.StatusOK, envelope{"stats": res}, headers)
+	}
+}
@@ -0,0 +1,26 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/mrityunjaygr8/go-oink/internal/repository"
+)
+
+func TestStatsCache(t *testing.T) {
+	c := newStatsCache()
+	c.set(repository.OinkStats{OinkID: "oink-id", Days: 30, Posts: 3})
+
+	if stats, ok := c.get("oink-id", 30); !ok || stats.Posts != 3 {
+		t.Fatalf("got %+v, %v, want the cached stats", stats, ok)
+	}
+
+	if _, ok := c.get("oink-id", 7); ok {
+		t.Fatal("stats for another window served from the cache")
+	}
+
+	c.entries[statsKey("oink-id", 30)] = statsEntry{expiresAt: time.Now().Add(-time.Second)}
+	if _, ok := c.get("oink-id", 30); ok {
+		t.Fatal("expired stats served from the cache")
+	}
+}
@@ -3,21 +3,36 @@ package main
 import (
 	"errors"
 	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/mrityunjaygr8/go-oink/internal/repository"
+	"github.com/mrityunjaygr8/go-oink/internal/validator"
 	"github.com/rs/zerolog/hlog"
 )
 
+// OinkList returns a page of the oinks listed for the requesting user.
+// Clients accepting application/x-ndjson or text/csv are streamed every
+// matching oink from the cursor on instead.
 func (s *Server) OinkList() http.HandlerFunc {
 	type Oink struct {
-		Name        string    `json:"name"`
-		Description string    `json:"description"`
-		ID          string    `json:"id"`
-		CreatorID   string    `json:"creator_id"`
-		CreatedAt   time.Time `json:"created_at"`
-		UpdatedAt   time.Time `json:"updated_at,omitempty"`
+		Name        string     `json:"name"`
+		Description string     `json:"description"`
+		ID          string     `json:"id"`
+		CreatorID   string     `json:"creator_id"`
+		Visibility  string     `json:"visibility"`
+		Language    string     `json:"language"`
+		Tags        []string   `json:"tags"`
+		Avatar      *imageURLs `json:"avatar"`
+		Banner      *imageURLs `json:"banner"`
+		MemberCount int64      `json:"member_count"`
+		CreatedAt   time.Time  `json:"created_at"`
+		UpdatedAt   time.Time  `json:"updated_at,omitempty"`
+		ArchivedAt  *time.Time `json:"archived_at,omitempty"`
+		ArchivedBy  string     `json:"archived_by,omitempty"`
 	}
 	type response struct {
 		Oinks []Oink `json:"oinks"`
@@ -25,8 +40,79 @@ func (s *Server) OinkList() http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		logger := hlog.FromRequest(r)
 		repo := repository.New(s.db, *logger)
-		o, err := repo.OinkRepository.OinkList(r.Context())
+
+		// admins are shown every oink, everybody else what is listed for them
+		viewerID := ""
+		if u, ok := r.Context().Value("user").(*repository.User); ok && !u.IsAdmin {
+			viewerID = u.ID
+		}
+
+		tags := make([]string, 0)
+		for _, tag := range r.URL.Query()["tag"] {
+			tags = append(tags, strings.Split(tag, ",")...)
+		}
+
+		q, limit, err := s.readListQuery(r.URL.Query(), repository.OinkListSpec, "tag", "include")
+		if err != nil {
+			s.writeJSON(w, http.StatusBadRequest, envelope{"error": err.Error()}, nil)
+			return
+		}
+
+		includeArchived := false
+		for _, include := range r.URL.Query()["include"] {
+			for _, value := range strings.Split(include, ",") {
+				if value != "archived" {
+					s.writeJSON(w, http.StatusBadRequest, envelope{"error": "include must be archived"}, nil)
+					return
+				}
+				includeArchived = true
+			}
+		}
+
+		w.Header().Add("Vary", "Accept")
+		if contentType := negotiate(r, contentTypeJSON, contentTypeNDJSON, contentTypeCSV); contentType != contentTypeJSON {
+			header := []string{"id", "name", "description", "creator_id", "visibility", "language", "tags", "member_count",
+				"avatar_url", "banner_url", "created_at", "updated_at", "archived_at", "archived_by"}
+			err := s.streamRows(w, r, contentType, header, func(write func(interface{}, []string) error) error {
+				return repo.OinkRepository.OinkStream(r.Context(), viewerID, tags, includeArchived, q, func(oink *repository.Oink) error {
+					avatar, banner := s.imageURLs(oink.AvatarKey), s.imageURLs(oink.BannerKey)
+					return write(Oink{
+						Name:        oink.Name,
+						Description: oink.Description,
+						ID:          oink.ID,
+						CreatorID:   oink.CreatorID,
+						Visibility:  oink.Visibility,
+						Language:    oink.Language,
+						Tags:        oink.Tags,
+						Avatar:      avatar,
+						Banner:      banner,
+						MemberCount: oink.MemberCount,
+						CreatedAt:   oink.CreatedAt,
+						UpdatedAt:   oink.UpdatedAt,
+						ArchivedAt:  oink.ArchivedAt,
+						ArchivedBy:  oink.ArchivedBy,
+					}, []string{
+						oink.ID, oink.Name, oink.Description, oink.CreatorID, oink.Visibility, oink.Language,
+						strings.Join(oink.Tags, " "), strconv.FormatInt(oink.MemberCount, 10), csvImageURL(avatar), csvImageURL(banner),
+						csvTime(&oink.CreatedAt), csvTime(&oink.UpdatedAt), csvTime(oink.ArchivedAt), oink.ArchivedBy,
+					})
+				})
+			})
+			if errors.Is(err, repository.ErrTagInvalid) {
+				s.writeJSON(w, http.StatusBadRequest, envelope{"error": err.Error()}, nil)
+			} else if err != nil {
+				logger.Error().Err(err).Msg("api-OinkList-Stream")
+				s.writeJSON(w, http.StatusInternalServerError, envelope{"error": http.StatusText(http.StatusInternalServerError)}, nil)
+			}
+			return
+		}
+
+		o, next, err := repo.OinkRepository.OinkList(r.Context(), viewerID, tags, includeArchived, q, limit)
 		if err != nil {
+			if errors.Is(err, repository.ErrTagInvalid) {
+				s.writeJSON(w, http.StatusBadRequest, envelope{"error": err.Error()}, nil)
+				return
+			}
 			logger.Error().Err(err).Msg("api-OinkList-List")
 			s.writeJSON(w, http.StatusInternalServerError, envelope{"error": http.StatusText(http.StatusInternalServerError)}, nil)
 			return
@@ -39,23 +125,130 @@ func (s *Server) OinkList() http.HandlerFunc {
 				Description: oink.Description,
 				ID:          oink.ID,
 				CreatorID:   oink.CreatorID,
+				Visibility:  oink.Visibility,
+				Language:    oink.Language,
+				Tags:        oink.Tags,
+				Avatar:      s.imageURLs(oink.AvatarKey),
+				Banner:      s.imageURLs(oink.BannerKey),
+				MemberCount: oink.MemberCount,
 				CreatedAt:   oink.CreatedAt,
 				UpdatedAt:   oink.UpdatedAt,
+				ArchivedAt:  oink.ArchivedAt,
+				ArchivedBy:  oink.ArchivedBy,
 			})
 		}
 
-		s.writeJSON(w, http.StatusOK, envelope{"oinks": oinks}, nil)
+		s.setNextLink(w, r, next)
+		s.writeJSON(w, http.StatusOK, envelope{"oinks": oinks, "metadata": cursorMetadata{Limit: limit, NextCursor: next}}, nil)
+	}
+}
+
+// OinkSearch runs a full-text search over the names and descriptions of the
+// oinks listed for the requesting user. The lang query parameter picks the
+// text search language, falling back to the server's default.
+func (s *Server) OinkSearch() http.HandlerFunc {
+	type Result struct {
+		Name          string     `json:"name"`
+		Description   string     `json:"description"`
+		ID            string     `json:"id"`
+		CreatorID     string     `json:"creator_id"`
+		Visibility    string     `json:"visibility"`
+		Language      string     `json:"language"`
+		Tags          []string   `json:"tags"`
+		Avatar        *imageURLs `json:"avatar"`
+		Banner        *imageURLs `json:"banner"`
+		MemberCount   int64      `json:"member_count"`
+		CreatedAt     time.Time  `json:"created_at"`
+		UpdatedAt     time.Time  `json:"updated_at,omitempty"`
+		Rank          float32    `json:"rank"`
+		NameHighlight string     `json:"name_highlight"`
+		Snippet       string     `json:"snippet"`
+	}
+	type metadata struct {
+		Total  int64 `json:"total"`
+		Limit  int   `json:"limit"`
+		Offset int   `json:"offset"`
+	}
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := hlog.FromRequest(r)
+		qs := r.URL.Query()
+
+		query := strings.TrimSpace(qs.Get("q"))
+		if query == "" {
+			s.writeJSON(w, http.StatusBadRequest, envelope{"error": "q must be provided"}, nil)
+			return
+		}
+
+		language := qs.Get("lang")
+		if language == "" {
+			language = s.config.SearchLanguage
+		}
+
+		limit, offset, err := s.readPage(qs, 20, 100)
+		if err != nil {
+			s.writeJSON(w, http.StatusBadRequest, envelope{"error": err.Error()}, nil)
+			return
+		}
+
+		// admins search every oink, everybody else what is listed for them
+		viewerID := ""
+		if u, ok := r.Context().Value("user").(*repository.User); ok && !u.IsAdmin {
+			viewerID = u.ID
+		}
+
+		repo := repository.New(s.db, *logger)
+		matches, total, err := repo.OinkRepository.OinkSearch(r.Context(), viewerID, language, query, limit, offset)
+		if err != nil {
+			if errors.Is(err, repository.ErrOinkLanguageInvalid) {
+				s.writeJSON(w, http.StatusBadRequest, envelope{"error": err.Error()}, nil)
+				return
+			}
+			logger.Error().Err(err).Msg("api-OinkSearch-Search")
+			s.writeJSON(w, http.StatusInternalServerError, envelope{"error": http.StatusText(http.StatusInternalServerError)}, nil)
+			return
+		}
+
+		results := make([]Result, 0)
+		for _, match := range *matches {
+			results = append(results, Result{
+				Name:          match.Name,
+				Description:   match.Description,
+				ID:            match.ID,
+				CreatorID:     match.CreatorID,
+				Visibility:    match.Visibility,
+				Language:      match.Language,
+				Tags:          match.Tags,
+				Avatar:        s.imageURLs(match.AvatarKey),
+				Banner:        s.imageURLs(match.BannerKey),
+				MemberCount:   match.MemberCount,
+				CreatedAt:     match.CreatedAt,
+				UpdatedAt:     match.UpdatedAt,
+				Rank:          match.Rank,
+				NameHighlight: match.NameHighlight,
+				Snippet:       match.Snippet,
+			})
+		}
+
+		s.writeJSON(w, http.StatusOK, envelope{"results": results, "metadata": metadata{Total: total, Limit: limit, Offset: offset}}, nil)
 	}
 }
 
 func (s *Server) OinkRetrieve() http.HandlerFunc {
 	type response struct {
-		Name        string    `json:"name"`
-		Description string    `json:"description"`
-		ID          string    `json:"id"`
-		CreatorID   string    `json:"creator_id"`
-		CreatedAt   time.Time `json:"created_at"`
-		UpdatedAt   time.Time `json:"updated_at,omitempty"`
+		Name        string     `json:"name"`
+		Description string     `json:"description"`
+		ID          string     `json:"id"`
+		CreatorID   string     `json:"creator_id"`
+		Visibility  string     `json:"visibility"`
+		Language    string     `json:"language"`
+		Tags        []string   `json:"tags"`
+		Avatar      *imageURLs `json:"avatar"`
+		Banner      *imageURLs `json:"banner"`
+		MemberCount int64      `json:"member_count"`
+		CreatedAt   time.Time  `json:"created_at"`
+		UpdatedAt   time.Time  `json:"updated_at,omitempty"`
+		ArchivedAt  *time.Time `json:"archived_at,omitempty"`
+		ArchivedBy  string     `json:"archived_by,omitempty"`
 	}
 	return func(w http.ResponseWriter, r *http.Request) {
 		logger := hlog.FromRequest(r)
@@ -64,7 +257,7 @@ func (s *Server) OinkRetrieve() http.HandlerFunc {
 		oink, err := repo.OinkRepository.OinkRetrieve(r.Context(), oinkName)
 		if err != nil {
 			if errors.Is(err, repository.ErrOinkNotFound) {
-				s.writeJSON(w, http.StatusNotFound, envelope{"error": err.Error()}, nil)
+				s.redirectOinkAlias(w, r, oinkName)
 				return
 			}
 			logger.Error().Err(err).Msg("api-OinkRetrieve-Retrieve")
@@ -72,25 +265,339 @@ func (s *Server) OinkRetrieve() http.HandlerFunc {
 			return
 		}
 
-		s.writeJSON(w, http.StatusOK, envelope{"oink": oink}, nil)
+		visible, err := s.canViewOink(r, repo, oink)
+		if err != nil {
+			logger.Error().Err(err).Msg("api-OinkRetrieve-canViewOink")
+			s.writeJSON(w, http.StatusInternalServerError, envelope{"error": http.StatusText(http.StatusInternalServerError)}, nil)
+			return
+		}
+		if !visible {
+			s.writeJSON(w, http.StatusNotFound, envelope{"error": repository.ErrOinkNotFound.Error()}, nil)
+			return
+		}
+
+		if s.notModified(w, r, oinkETag(oink)) {
+			return
+		}
+
+		res := response{
+			Name:        oink.Name,
+			Description: oink.Description,
+			ID:          oink.ID,
+			CreatorID:   oink.CreatorID,
+			Visibility:  oink.Visibility,
+			Language:    oink.Language,
+			Tags:        oink.Tags,
+			Avatar:      s.imageURLs(oink.AvatarKey),
+			Banner:      s.imageURLs(oink.BannerKey),
+			MemberCount: oink.MemberCount,
+			CreatedAt:   oink.CreatedAt,
+			UpdatedAt:   oink.UpdatedAt,
+			ArchivedAt:  oink.ArchivedAt,
+			ArchivedBy:  oink.ArchivedBy,
+		}
+
+		s.writeJSON(w, http.StatusOK, envelope{"oink": res}, nil)
 	}
 }
 
-func (s *Server) OinkDelete() http.HandlerFunc {
+// redirectOinkAlias answers a lookup of a name the oink no longer has with a
+// redirect to its current name, or a 404 when nothing was ever called so or
+// the requesting user may not see the oink it now names.
+func (s *Server) redirectOinkAlias(w http.ResponseWriter, r *http.Request, oinkName string) {
+	logger := hlog.FromRequest(r)
+	repo := repository.New(s.db, *logger)
+
+	oink, err := repo.OinkRepository.OinkResolveAlias(r.Context(), oinkName)
+	if err != nil {
+		if errors.Is(err, repository.ErrOinkNotFound) {
+			s.writeJSON(w, http.StatusNotFound, envelope{"error": err.Error()}, nil)
+			return
+		}
+		logger.Error().Err(err).Msg("api-redirectOinkAlias-OinkResolveAlias")
+		s.writeJSON(w, http.StatusInternalServerError, envelope{"error": http.StatusText(http.StatusInternalServerError)}, nil)
+		return
+	}
+
+	visible, err := s.canViewOink(r, repo, oink)
+	if err != nil {
+		logger.Error().Err(err).Msg("api-redirectOinkAlias-canViewOink")
+		s.writeJSON(w, http.StatusInternalServerError, envelope{"error": http.StatusText(http.StatusInternalServerError)}, nil)
+		return
+	}
+	if !visible {
+		s.writeJSON(w, http.StatusNotFound, envelope{"error": repository.ErrOinkNotFound.Error()}, nil)
+		return
+	}
+
+	location := "/api/v1/oinks/" + url.PathEscape(oink.Name)
+	s.writeJSON(w, http.StatusMovedPermanently, envelope{"location": location}, http.Header{"Location": []string{location}})
+}
+
+// canViewOink reports whether the requesting user may see oink. Private oinks
+// are only visible to their members and to admins.
+func (s *Server) canViewOink(r *http.Request, repo *repository.Repository, oink *repository.Oink) (bool, error) {
+	if oink.Visibility != repository.OinkVisibilityPrivate {
+		return true, nil
+	}
+
+	return s.authorizeOink(r, repo, oink.Name, repository.OinkRoleOwner, repository.OinkRoleModerator, repository.OinkRoleMember)
+}
+
+// retrieveVisibleOink looks oinkName up for handlers of things that live
+// inside an oink. When the oink is missing or hidden from the requesting
+// user, the response is written and ok is false.
+func (s *Server) retrieveVisibleOink(w http.ResponseWriter, r *http.Request, repo *repository.Repository, oinkName string) (*repository.Oink, bool) {
+	logger := hlog.FromRequest(r)
+
+	oink, err := repo.OinkRepository.OinkRetrieve(r.Context(), oinkName)
+	if err != nil {
+		if errors.Is(err, repository.ErrOinkNotFound) {
+			s.writeJSON(w, http.StatusNotFound, envelope{"error": err.Error()}, nil)
+			return nil, false
+		}
+		logger.Error().Err(err).Msg("api-retrieveVisibleOink-OinkRetrieve")
+		s.writeJSON(w, http.StatusInternalServerError, envelope{"error": http.StatusText(http.StatusInternalServerError)}, nil)
+		return nil, false
+	}
+
+	visible, err := s.canViewOink(r, repo, oink)
+	if err != nil {
+		logger.Error().Err(err).Msg("api-retrieveVisibleOink-canViewOink")
+		s.writeJSON(w, http.StatusInternalServerError, envelope{"error": http.StatusText(http.StatusInternalServerError)}, nil)
+		return nil, false
+	}
+	if !visible {
+		s.writeJSON(w, http.StatusNotFound, envelope{"error": repository.ErrOinkNotFound.Error()}, nil)
+		return nil, false
+	}
+
+	return oink, true
+}
+
+// authorizeOink reports whether the requesting user holds one of roles in
+// oinkName. Admins may act on every oink.
+func (s *Server) authorizeOink(r *http.Request, repo *repository.Repository, oinkName string, roles ...string) (bool, error) {
+	requester, ok := r.Context().Value("user").(*repository.User)
+	if !ok {
+		return false, nil
+	}
+
+	if requester.IsAdmin {
+		return true, nil
+	}
+
+	member, err := repo.OinkMemberRepository.OinkMemberRetrieve(r.Context(), oinkName, requester.ID)
+	if err != nil {
+		if errors.Is(err, repository.ErrOinkMemberNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	for _, role := range roles {
+		if member.Role == role {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func (s *Server) OinkUpdate() http.HandlerFunc {
+	type request struct {
+		Name        *string   `json:"name"`
+		Description *string   `json:"description"`
+		Visibility  *string   `json:"visibility"`
+		Language    *string   `json:"language"`
+		Tags        *[]string `json:"tags"`
+	}
+
 	type response struct {
-		Name        string    `json:"name"`
-		Description string    `json:"description"`
-		ID          string    `json:"id"`
-		CreatorID   string    `json:"creator_id"`
-		CreatedAt   time.Time `json:"created_at"`
-		UpdatedAt   time.Time `json:"updated_at,omitempty"`
+		Name        string     `json:"name"`
+		Description string     `json:"description"`
+		ID          string     `json:"id"`
+		CreatorID   string     `json:"creator_id"`
+		Visibility  string     `json:"visibility"`
+		Language    string     `json:"language"`
+		Tags        []string   `json:"tags"`
+		Avatar      *imageURLs `json:"avatar"`
+		Banner      *imageURLs `json:"banner"`
+		MemberCount int64      `json:"member_count"`
+		CreatedAt   time.Time  `json:"created_at"`
+		UpdatedAt   time.Time  `json:"updated_at,omitempty"`
 	}
+
 	return func(w http.ResponseWriter, r *http.Request) {
 		logger := hlog.FromRequest(r)
+		oinkName := chi.URLParam(r, "oinkName")
+
+		var req request
+		err := s.readJSON(w, r, &req)
+		if err != nil {
+			logger.Error().Err(err).Msg("api-OinkUpdate-readJson")
+			s.writeJSON(w, http.StatusBadRequest, envelope{"error": err.Error()}, nil)
+			return
+		}
+
+		if req.Name == nil && req.Description == nil && req.Visibility == nil && req.Language == nil && req.Tags == nil {
+			s.writeJSON(w, http.StatusBadRequest, envelope{"error": "name, description, visibility, language or tags must be provided"}, nil)
+			return
+		}
+
+		v := validator.New()
+		if req.Name != nil {
+			validateOinkName(v, "name", *req.Name)
+		}
+		if req.Description != nil {
+			validateDescription(v, "description", *req.Description)
+		}
+		if req.Visibility != nil {
+			validateOinkVisibility(v, "visibility", *req.Visibility)
+		}
+		if req.Language != nil {
+			validateOinkLanguage(v, "language", *req.Language)
+		}
+		if !v.Valid() {
+			s.failedValidationResponse(w, v.Errors)
+			return
+		}
+
 		repo := repository.New(s.db, *logger)
+		oink, ok := s.retrieveVisibleOink(w, r, repo, oinkName)
+		if !ok {
+			return
+		}
+
+		allowed, err := s.authorizeOink(r, repo, oink.Name, repository.OinkRoleOwner, repository.OinkRoleModerator)
+		if err != nil {
+			logger.Error().Err(err).Msg("api-OinkUpdate-authorizeOink")
+			s.writeJSON(w, http.StatusInternalServerError, envelope{"error": http.StatusText(http.StatusInternalServerError)}, nil)
+			return
+		}
+		if !allowed {
+			s.writeJSON(w, http.StatusForbidden, envelope{"error": http.StatusText(http.StatusForbidden)}, nil)
+			return
+		}
+
+		tx, err := s.db.Begin()
+		if err != nil {
+			logger.Error().Err(err).Msg("error creating transaction")
+			s.writeJSON(w, http.StatusInternalServerError, envelope{"error": http.StatusText(http.StatusInternalServerError)}, nil)
+			return
+		}
+
+		txRepo := repository.New(tx, *logger)
+		current, err := txRepo.OinkRepository.OinkLock(r.Context(), oinkName)
+		if err != nil {
+			if rollback := tx.Rollback(); rollback != nil {
+				logger.Error().Err(err).Msg("api-OinkUpdate-OinkLock-RollbackError")
+				s.writeJSON(w, http.StatusInternalServerError, envelope{"error": http.StatusText(http.StatusInternalServerError)}, nil)
+				return
+			}
+			if errors.Is(err, repository.ErrOinkNotFound) {
+				s.writeJSON(w, http.StatusNotFound, envelope{"error": err.Error()}, nil)
+				return
+			}
+			logger.Error().Err(err).Msg("api-OinkUpdate-OinkLock")
+			s.writeJSON(w, http.StatusInternalServerError, envelope{"error": http.StatusText(http.StatusInternalServerError)}, nil)
+			return
+		}
+
+		if !s.ifMatch(w, r, oinkETag(current)) {
+			if rollback := tx.Rollback(); rollback != nil {
+				logger.Error().Err(rollback).Msg("api-OinkUpdate-ifMatch-RollbackError")
+			}
+			return
+		}
+
+		oink, err = txRepo.OinkRepository.OinkUpdate(r.Context(), oinkName, req.Name, req.Description, req.Visibility, req.Language, req.Tags)
+		if err != nil {
+			if rollback := tx.Rollback(); rollback != nil {
+				logger.Error().Err(err).Msg("api-OinkUpdate-OinkUpdate-RollbackError")
+				s.writeJSON(w, http.StatusInternalServerError, envelope{"error": http.StatusText(http.StatusInternalServerError)}, nil)
+				return
+			}
+			if errors.Is(err, repository.ErrOinkNotFound) {
+				s.writeJSON(w, http.StatusNotFound, envelope{"error": err.Error()}, nil)
+				return
+			}
+			if errors.Is(err, repository.ErrOinkExists) || errors.Is(err, repository.ErrOinkVisibilityInvalid) ||
+				errors.Is(err, repository.ErrOinkLanguageInvalid) || errors.Is(err, repository.ErrTagInvalid) || errors.Is(err, repository.ErrTagsTooMany) {
+				s.writeJSON(w, http.StatusBadRequest, envelope{"error": err.Error()}, nil)
+				return
+			}
+			if errors.Is(err, repository.ErrOinkArchived) {
+				s.writeJSON(w, http.StatusConflict, envelope{"error": err.Error()}, nil)
+				return
+			}
+			logger.Error().Err(err).Msg("api-OinkUpdate-OinkUpdate")
+			s.writeJSON(w, http.StatusInternalServerError, envelope{"error": http.StatusText(http.StatusInternalServerError)}, nil)
+			return
+		}
+
+		if err := tx.Commit(); err != nil {
+			logger.Error().Err(err).Msg("api-OinkUpdate-OinkUpdate-CommitError")
+			s.writeJSON(w, http.StatusInternalServerError, envelope{"error": http.StatusText(http.StatusInternalServerError)}, nil)
+			return
+		}
+
+		resp := response{
+			Name:        oink.Name,
+			Description: oink.Description,
+			ID:          oink.ID,
+			CreatorID:   oink.CreatorID,
+			Visibility:  oink.Visibility,
+			Language:    oink.Language,
+			Tags:        oink.Tags,
+			Avatar:      s.imageURLs(oink.AvatarKey),
+			Banner:      s.imageURLs(oink.BannerKey),
+			MemberCount: oink.MemberCount,
+			CreatedAt:   oink.CreatedAt,
+			UpdatedAt:   oink.UpdatedAt,
+		}
+
+		w.Header().Set("ETag", oinkETag(oink))
+		s.writeJSON(w, http.StatusOK, envelope{"oink": resp}, nil)
+	}
+}
+
+// OinkDelete removes an oink for good. Only admins may do so; owners archive
+// their oinks instead. If-Match, when sent, must carry the ETag of the oink.
+func (s *Server) OinkDelete() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := hlog.FromRequest(r)
 		oinkName := chi.URLParam(r, "oinkName")
-		err := repo.OinkRepository.OinkDelete(r.Context(), oinkName)
+
+		if u, ok := r.Context().Value("user").(*repository.User); !ok || !u.IsAdmin {
+			s.writeJSON(w, http.StatusForbidden, envelope{"error": http.StatusText(http.StatusForbidden)}, nil)
+			return
+		}
+
+		tx, err := s.db.Begin()
+		if err != nil {
+			logger.Error().Err(err).Msg("error creating transaction")
+			s.writeJSON(w, http.StatusInternalServerError, envelope{"error": http.StatusText(http.StatusInternalServerError)}, nil)
+			return
+		}
+
+		repo := repository.New(tx, *logger)
+		oink, err := repo.OinkRepository.OinkLock(r.Context(), oinkName)
+		if err == nil && !s.ifMatch(w, r, oinkETag(oink)) {
+			if rollback := tx.Rollback(); rollback != nil {
+				logger.Error().Err(rollback).Msg("api-OinkDelete-ifMatch-RollbackError")
+			}
+			return
+		}
+		if err == nil {
+			err = repo.OinkRepository.OinkDelete(r.Context(), oinkName)
+		}
 		if err != nil {
+			if rollback := tx.Rollback(); rollback != nil {
+				logger.Error().Err(err).Msg("api-OinkDelete-Delete-RollbackError")
+				s.writeJSON(w, http.StatusInternalServerError, envelope{"error": http.StatusText(http.StatusInternalServerError)}, nil)
+				return
+			}
 			if errors.Is(err, repository.ErrOinkNotFound) {
 				s.writeJSON(w, http.StatusNotFound, envelope{"error": err.Error()}, nil)
 				return
@@ -100,23 +607,134 @@ func (s *Server) OinkDelete() http.HandlerFunc {
 			return
 		}
 
-		s.writeJSON(w, http.StatusNoContent, nil, nil)
+		if err := tx.Commit(); err != nil {
+			logger.Error().Err(err).Msg("api-OinkDelete-Delete-CommitError")
+			s.writeJSON(w, http.StatusInternalServerError, envelope{"error": http.StatusText(http.StatusInternalServerError)}, nil)
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// OinkArchive makes an oink read-only and hides it from listings. Only its
+// owner may archive it.
+func (s *Server) OinkArchive() http.HandlerFunc {
+	return s.oinkArchiveHandler(true)
+}
+
+// OinkUnarchive brings an archived oink back. Only its owner may do so.
+func (s *Server) OinkUnarchive() http.HandlerFunc {
+	return s.oinkArchiveHandler(false)
+}
+
+func (s *Server) oinkArchiveHandler(archive bool) http.HandlerFunc {
+	type response struct {
+		Name        string     `json:"name"`
+		Description string     `json:"description"`
+		ID          string     `json:"id"`
+		CreatorID   string     `json:"creator_id"`
+		Visibility  string     `json:"visibility"`
+		Language    string     `json:"language"`
+		Tags        []string   `json:"tags"`
+		Avatar      *imageURLs `json:"avatar"`
+		Banner      *imageURLs `json:"banner"`
+		MemberCount int64      `json:"member_count"`
+		CreatedAt   time.Time  `json:"created_at"`
+		UpdatedAt   time.Time  `json:"updated_at,omitempty"`
+		ArchivedAt  *time.Time `json:"archived_at,omitempty"`
+		ArchivedBy  string     `json:"archived_by,omitempty"`
+	}
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := hlog.FromRequest(r)
+		repo := repository.New(s.db, *logger)
+		oinkName := chi.URLParam(r, "oinkName")
+
+		u, ok := r.Context().Value("user").(*repository.User)
+		if !ok {
+			logger.Error().Any("User", r.Context().Value("user")).Msg("api-oinkArchiveHandler-userTypeAssertion")
+			s.writeJSON(w, http.StatusInternalServerError, envelope{"error": http.StatusText(http.StatusInternalServerError)}, nil)
+			return
+		}
+
+		oink, ok := s.retrieveVisibleOink(w, r, repo, oinkName)
+		if !ok {
+			return
+		}
+
+		allowed, err := s.authorizeOink(r, repo, oink.Name, repository.OinkRoleOwner)
+		if err != nil {
+			logger.Error().Err(err).Msg("api-oinkArchiveHandler-authorizeOink")
+			s.writeJSON(w, http.StatusInternalServerError, envelope{"error": http.StatusText(http.StatusInternalServerError)}, nil)
+			return
+		}
+		if !allowed {
+			s.writeJSON(w, http.StatusForbidden, envelope{"error": http.StatusText(http.StatusForbidden)}, nil)
+			return
+		}
+
+		if archive {
+			oink, err = repo.OinkRepository.OinkArchive(r.Context(), oink.Name, u.ID)
+		} else {
+			oink, err = repo.OinkRepository.OinkUnarchive(r.Context(), oink.Name)
+		}
+		if err != nil {
+			if errors.Is(err, repository.ErrOinkNotFound) {
+				s.writeJSON(w, http.StatusNotFound, envelope{"error": err.Error()}, nil)
+				return
+			}
+			if errors.Is(err, repository.ErrOinkArchived) || errors.Is(err, repository.ErrOinkNotArchived) {
+				s.writeJSON(w, http.StatusConflict, envelope{"error": err.Error()}, nil)
+				return
+			}
+			logger.Error().Err(err).Msg("api-oinkArchiveHandler-Archive")
+			s.writeJSON(w, http.StatusInternalServerError, envelope{"error": http.StatusText(http.StatusInternalServerError)}, nil)
+			return
+		}
+
+		res := response{
+			Name:        oink.Name,
+			Description: oink.Description,
+			ID:          oink.ID,
+			CreatorID:   oink.CreatorID,
+			Visibility:  oink.Visibility,
+			Language:    oink.Language,
+			Tags:        oink.Tags,
+			Avatar:      s.imageURLs(oink.AvatarKey),
+			Banner:      s.imageURLs(oink.BannerKey),
+			MemberCount: oink.MemberCount,
+			CreatedAt:   oink.CreatedAt,
+			UpdatedAt:   oink.UpdatedAt,
+			ArchivedAt:  oink.ArchivedAt,
+			ArchivedBy:  oink.ArchivedBy,
+		}
+
+		s.writeJSON(w, http.StatusOK, envelope{"oink": res}, nil)
 	}
 }
 
 func (s *Server) OinkInsert() http.HandlerFunc {
 	type request struct {
-		Name        string `json:"name"`
-		Description string `json:"description"`
+		Name        string   `json:"name"`
+		Description string   `json:"description"`
+		Visibility  string   `json:"visibility"`
+		Language    string   `json:"language"`
+		Tags        []string `json:"tags"`
 	}
 
 	type response struct {
-		Name        string    `json:"name"`
-		Description string    `json:"description"`
-		ID          string    `json:"id"`
-		CreatorID   string    `json:"creator_id"`
-		CreatedAt   time.Time `json:"created_at"`
-		UpdatedAt   time.Time `json:"updated_at,omitempty"`
+		Name        string     `json:"name"`
+		Description string     `json:"description"`
+		ID          string     `json:"id"`
+		CreatorID   string     `json:"creator_id"`
+		Visibility  string     `json:"visibility"`
+		Language    string     `json:"language"`
+		Tags        []string   `json:"tags"`
+		Avatar      *imageURLs `json:"avatar"`
+		Banner      *imageURLs `json:"banner"`
+		MemberCount int64      `json:"member_count"`
+		CreatedAt   time.Time  `json:"created_at"`
+		UpdatedAt   time.Time  `json:"updated_at,omitempty"`
 	}
 
 	return func(w http.ResponseWriter, r *http.Request) {
@@ -128,7 +746,20 @@ func (s *Server) OinkInsert() http.HandlerFunc {
 			s.writeJSON(w, http.StatusBadRequest, envelope{"error": err.Error()}, nil)
 			return
 		}
-		repo := repository.New(s.db, *logger)
+
+		v := validator.New()
+		validateOinkName(v, "name", req.Name)
+		validateDescription(v, "description", req.Description)
+		if req.Visibility != "" {
+			validateOinkVisibility(v, "visibility", req.Visibility)
+		}
+		if req.Language != "" {
+			validateOinkLanguage(v, "language", req.Language)
+		}
+		if !v.Valid() {
+			s.failedValidationResponse(w, v.Errors)
+			return
+		}
 
 		user := r.Context().Value("user")
 
@@ -145,7 +776,9 @@ func (s *Server) OinkInsert() http.HandlerFunc {
 			return
 		}
 
-		oink, err := repo.OinkRepository.OinkInsert(r.Context(), req.Name, req.Description, u.ID)
+		repo := repository.New(tx, *logger)
+
+		oink, err := repo.OinkRepository.OinkInsert(r.Context(), req.Name, req.Description, req.Visibility, req.Language, req.Tags, u.ID)
 		if err != nil {
 			if rollback := tx.Rollback(); rollback != nil {
 				logger.Error().Err(err).Msg("api-OinkInsert-OinkInsert-RollbackError")
@@ -156,6 +789,11 @@ func (s *Server) OinkInsert() http.HandlerFunc {
 				s.writeJSON(w, http.StatusBadRequest, envelope{"error": repository.ErrOinkExists.Error()}, nil)
 				return
 			}
+			if errors.Is(err, repository.ErrOinkVisibilityInvalid) || errors.Is(err, repository.ErrOinkLanguageInvalid) ||
+				errors.Is(err, repository.ErrTagInvalid) || errors.Is(err, repository.ErrTagsTooMany) {
+				s.writeJSON(w, http.StatusBadRequest, envelope{"error": err.Error()}, nil)
+				return
+			}
 			logger.Error().Err(err).Msg("api-OinkInsert-OinkInsert")
 			s.writeJSON(w, http.StatusInternalServerError, envelope{"error": http.StatusText(http.StatusInternalServerError)}, nil)
 			return
@@ -176,6 +814,13 @@ func (s *Server) OinkInsert() http.HandlerFunc {
 			Name:        oink.Name,
 			Description: oink.Description,
 			ID:          oink.ID,
+			CreatorID:   oink.CreatorID,
+			Visibility:  oink.Visibility,
+			Language:    oink.Language,
+			Tags:        oink.Tags,
+			Avatar:      s.imageURLs(oink.AvatarKey),
+			Banner:      s.imageURLs(oink.BannerKey),
+			MemberCount: oink.MemberCount,
 			CreatedAt:   oink.CreatedAt,
 			UpdatedAt:   oink.UpdatedAt,
 		}
@@ -0,0 +1,169 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-chi/chi/v5"
+	"github.com/mrityunjaygr8/go-oink/internal/repository"
+)
+
+func TestOinkLookupStatus(t *testing.T) {
+	tests := []struct {
+		name    string
+		method  string
+		handler func(*Server) http.HandlerFunc
+		// missing sets up the queries run after the oink was not found by name
+		missing func(sqlmock.Sqlmock)
+	}{
+		{"OinkRetrieve", http.MethodGet, (*Server).OinkRetrieve, func(mock sqlmock.Sqlmock) {
+			mock.ExpectQuery(`(?i)from "oink_aliases"`).WillReturnRows(sqlmock.NewRows([]string{"name"}))
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name+"/missing", func(t *testing.T) {
+			s, mock := newTestServer(t)
+			mock.ExpectQuery(`(?i)from "oinks"`).WillReturnRows(sqlmock.NewRows([]string{"id"}))
+			tt.missing(mock)
+
+			rec := serve(tt.method, "/oinks/{oinkName}", "/oinks/chelsea", tt.handler(s))
+			if rec.Code != http.StatusNotFound {
+				t.Fatalf("got status %d, want %d", rec.Code, http.StatusNotFound)
+			}
+		})
+
+		t.Run(tt.name+"/db-error", func(t *testing.T) {
+			s, mock := newTestServer(t)
+			mock.ExpectQuery(`(?i)from "oinks"`).WillReturnError(errDB)
+
+			rec := serve(tt.method, "/oinks/{oinkName}", "/oinks/chelsea", tt.handler(s))
+			if rec.Code != http.StatusInternalServerError {
+				t.Fatalf("got status %d, want %d", rec.Code, http.StatusInternalServerError)
+			}
+		})
+	}
+}
+
+func TestOinkRetrieveRedirectsOldName(t *testing.T) {
+	s, mock := newTestServer(t)
+	mock.ExpectQuery(`(?i)from "oinks"`).WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	mock.ExpectQuery(`(?i)from "oink_aliases"`).
+		WillReturnRows(sqlmock.NewRows([]string{"name", "oink"}).AddRow("chelsea", "oink-id"))
+	mock.ExpectQuery(`(?i)from "oinks"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "creator"}).AddRow("oink-id", "chelsea fc", "user-id"))
+	mock.ExpectQuery(`(?i)from "users"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "username"}).AddRow("user-id", "im"))
+
+	rec := serve(http.MethodGet, "/oinks/{oinkName}", "/oinks/chelsea", s.OinkRetrieve())
+	if rec.Code != http.StatusMovedPermanently {
+		t.Fatalf("got status %d, want %d", rec.Code, http.StatusMovedPermanently)
+	}
+	if got, want := rec.Header().Get("Location"), "/api/v1/oinks/chelsea%20fc"; got != want {
+		t.Fatalf("got Location %q, want %q", got, want)
+	}
+}
+
+func TestOinkDeleteIsAdminOnly(t *testing.T) {
+	del := func(s *Server, requester *repository.User) *httptest.ResponseRecorder {
+		r := chi.NewRouter()
+		r.Delete("/oinks/{oinkName}", s.OinkDelete())
+
+		req := httptest.NewRequest(http.MethodDelete, "/oinks/chelsea", nil)
+		req.Header.Set("If-Match", "*")
+		req = req.WithContext(context.WithValue(req.Context(), "user", requester))
+		rec := httptest.NewRecorder()
+		r.ServeHTTP(rec, req)
+		return rec
+	}
+
+	t.Run("owner", func(t *testing.T) {
+		s, _ := newTestServer(t)
+		rec := del(s, &repository.User{ID: "user-id"})
+		if rec.Code != http.StatusForbidden {
+			t.Fatalf("got status %d, want %d", rec.Code, http.StatusForbidden)
+		}
+	})
+
+	t.Run("admin/missing", func(t *testing.T) {
+		s, mock := newTestServer(t)
+		mock.ExpectBegin()
+		mock.ExpectQuery(`(?i)from "oinks"`).WillReturnRows(sqlmock.NewRows([]string{"id"}))
+		mock.ExpectRollback()
+
+		rec := del(s, &repository.User{ID: "user-id", IsAdmin: true})
+		if rec.Code != http.StatusNotFound {
+			t.Fatalf("got status %d, want %d", rec.Code, http.StatusNotFound)
+		}
+	})
+}
+
+func TestOinkListRejectsBadQueries(t *testing.T) {
+	for _, target := range []string{
+		"/oinks?colour=pink",
+		"/oinks?sort=members",
+		"/oinks?created_after=yesterday",
+		"/oinks?creator=a&creator=b",
+	} {
+		t.Run(target, func(t *testing.T) {
+			s, _ := newTestServer(t)
+			rec := serve(http.MethodGet, "/oinks", target, s.OinkList())
+			if rec.Code != http.StatusBadRequest {
+				t.Fatalf("got status %d, want %d", rec.Code, http.StatusBadRequest)
+			}
+		})
+	}
+}
+
+func TestOinkListFilters(t *testing.T) {
+	s, mock := newTestServer(t)
+	mock.ExpectQuery(`(?i)from "oinks" where \("oinks"."created_at" < \$1\) and \("oinks"."creator" = \$2\) and .*order by "oinks"."name" desc, "oinks"."id" desc`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	rec := serve(http.MethodGet, "/oinks", "/oinks?creator=user-id&created_before=2023-05-01&sort=-name&tag=football", s.OinkList())
+	if rec.Code != http.StatusOK {
+		t.Fatalf("got status %d, want %d: %s", rec.Code, http.StatusOK, rec.Body.String())
+	}
+}
+
+func TestOinkRetrieveHidesOldNameOfPrivateOink(t *testing.T) {
+	s, mock := newTestServer(t)
+	mock.ExpectQuery(`(?i)from "oinks"`).WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	mock.ExpectQuery(`(?i)from "oink_aliases"`).
+		WillReturnRows(sqlmock.NewRows([]string{"name", "oink"}).AddRow("chelsea", "oink-id"))
+	mock.ExpectQuery(`(?i)from "oinks"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "creator", "visibility"}).AddRow("oink-id", "chelsea-fc", "user-id", repository.OinkVisibilityPrivate))
+	mock.ExpectQuery(`(?i)from "users"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "username"}).AddRow("user-id", "im"))
+	mock.ExpectQuery(`(?i)from "oinks"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "creator", "visibility"}).AddRow("oink-id", "chelsea-fc", "user-id", repository.OinkVisibilityPrivate))
+	mock.ExpectQuery(`(?i)from "users"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "username"}).AddRow("user-id", "im"))
+	mock.ExpectQuery(`(?i)from "oink_members"`).WillReturnRows(sqlmock.NewRows([]string{"oink"}))
+
+	req := httptest.NewRequest(http.MethodGet, "/oinks/chelsea", nil)
+	req = req.WithContext(context.WithValue(req.Context(), "user", &repository.User{ID: "someone-else"}))
+	rec := serveRequest("/oinks/{oinkName}", req, s.OinkRetrieve())
+	if rec.Code != http.StatusNotFound {
+		t.Fatalf("got status %d, want %d", rec.Code, http.StatusNotFound)
+	}
+	if location := rec.Header().Get("Location"); location != "" {
+		t.Fatalf("got Location %q, want none", location)
+	}
+}
+
+func TestOinkInsertRejectsImages(t *testing.T) {
+	s, _ := newTestServer(t)
+	for _, field := range []string{"avatar", "banner"} {
+		body := `{"name": "chelsea", "` + field + `": {"original": "https://example.com/a.png"}}`
+		req := httptest.NewRequest(http.MethodPost, "/oinks", strings.NewReader(body))
+		rec := serveRequest("/oinks", req, s.OinkInsert())
+		if rec.Code != http.StatusBadRequest || !strings.Contains(rec.Body.String(), field) {
+			t.Fatalf("%s: got status %d with %s, want a 400 naming the field", field, rec.Code, rec.Body.String())
+		}
+	}
+}
@@ -0,0 +1,662 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/rs/zerolog/hlog"
+)
+
+// spec is a node of the OpenAPI document.
+type spec map[string]interface{}
+
+func ref(name string) spec {
+	return spec{"$ref": "#/components/schemas/" + name}
+}
+
+func object(required []string, properties spec) spec {
+	s := spec{"type": "object", "properties": properties}
+	if len(required) > 0 {
+		s["required"] = required
+	}
+	return s
+}
+
+func array(items spec) spec {
+	return spec{"type": "array", "items": items}
+}
+
+func nullable(s spec) spec {
+	return spec{"oneOf": []spec{s, {"type": "null"}}}
+}
+
+func typed(name string, description string) spec {
+	s := spec{"type": name}
+	if description != "" {
+		s["description"] = description
+	}
+	return s
+}
+
+func formatted(format string, description string) spec {
+	s := typed("string", description)
+	s["format"] = format
+	return s
+}
+
+func enum(description string, values ...string) spec {
+	s := typed("string", description)
+	s["enum"] = values
+	return s
+}
+
+func content(contentType string, schema spec) spec {
+	return spec{contentType: spec{"schema": schema}}
+}
+
+// operation describes one method of a path of the API.
+type operation struct {
+	tag         string
+	summary     string
+	anonymous   bool
+	parameters  []spec
+	requestBody spec
+	responses   spec
+}
+
+func op(tag string, summary string) *operation {
+	return &operation{tag: tag, summary: summary, responses: spec{}}
+}
+
+// public marks an operation as open to requests without a token.
+func (o *operation) public() *operation {
+	o.anonymous = true
+	return o
+}
+
+func (o *operation) query(name string, schema spec) *operation {
+	o.parameters = append(o.parameters, spec{"name": name, "in": "query", "schema": schema})
+	return o
+}
+
+func (o *operation) header(name string, required bool, description string) *operation {
+	o.parameters = append(o.parameters, spec{"name": name, "in": "header", "required": required, "description": description, "schema": typed("string", "")})
+	return o
+}
+
+func (o *operation) body(c spec) *operation {
+	o.requestBody = spec{"required": true, "content": c}
+	return o
+}
+
+// returns documents the response sent with status. c may be nil for
+// responses without a body.
+func (o *operation) returns(status int, description string, c spec) *operation {
+	response := spec{"description": description}
+	if c != nil {
+		response["content"] = c
+	}
+	o.responses[strconv.Itoa(status)] = response
+	return o
+}
+
+// fails documents the error envelope as the response sent with each of
+// statuses.
+func (o *operation) fails(statuses ...int) *operation {
+	for _, status := range statuses {
+		o.returns(status, http.StatusText(status), content(contentTypeJSON, ref("Error")))
+	}
+	return o
+}
+
+// withHeader documents a header set on the response sent with status.
+func (o *operation) withHeader(status int, name string, description string) *operation {
+	response := o.responses[strconv.Itoa(status)].(spec)
+	headers, ok := response["headers"].(spec)
+	if !ok {
+		headers = spec{}
+		response["headers"] = headers
+	}
+	headers[name] = spec{"description": description, "schema": typed("string", "")}
+	return o
+}
+
+// conditional documents the ETag of a resource returned with 200 and the
+// If-None-Match header answered with 304.
+func (o *operation) conditional() *operation {
+	o.header("If-None-Match", false, "ETag of the version the client holds")
+	o.withHeader(http.StatusOK, "ETag", "Version of the resource")
+	return o.returns(http.StatusNotModified, "The client holds the current version", nil)
+}
+
+// preconditioned documents the If-Match header an operation changing a
+// resource honors when it is sent.
+func (o *operation) preconditioned() *operation {
+	o.header("If-Match", false, "ETag of the version the change was made against")
+	return o.fails(http.StatusPreconditionFailed)
+}
+
+// pathParameterPattern matches the parameters of a path template.
+var pathParameterPattern = regexp.MustCompile(`{([^}]+)}`)
+
+var pathParameterDescriptions = map[string]string{
+	"userID":   "ID of the user",
+	"oinkName": "Name of the oink",
+	"postID":   "ID of the post",
+	"exportID": "ID of the export",
+	"key":      "Key of the blob",
+}
+
+// build turns o into an OpenAPI operation of path, adding the parameters of
+// path and what every operation of its kind may respond with.
+func (o *operation) build(method string, path string) spec {
+	if !o.anonymous {
+		o.fails(http.StatusForbidden)
+		if method == http.MethodPost {
+			o.header(idempotencyKeyHeader, false, "Makes retries of the request safe; retries with the same key and body get the first response back")
+			o.fails(http.StatusConflict)
+			if _, ok := o.responses["422"]; !ok {
+				o.fails(http.StatusUnprocessableEntity)
+			}
+		}
+	}
+	o.fails(http.StatusInternalServerError)
+
+	var parameters []spec
+	for _, match := range pathParameterPattern.FindAllStringSubmatch(path, -1) {
+		parameters = append(parameters, spec{
+			"name":        match[1],
+			"in":          "path",
+			"required":    true,
+			"description": pathParameterDescriptions[match[1]],
+			"schema":      typed("string", ""),
+		})
+	}
+	parameters = append(parameters, o.parameters...)
+
+	operation := spec{
+		"tags":      []string{o.tag},
+		"summary":   o.summary,
+		"responses": o.responses,
+	}
+	if len(parameters) > 0 {
+		operation["parameters"] = parameters
+	}
+	if o.requestBody != nil {
+		operation["requestBody"] = o.requestBody
+	}
+	if o.anonymous {
+		operation["security"] = []spec{}
+	}
+
+	return operation
+}
+
+// pathItems collects the operations of the API by path and method.
+type pathItems map[string]spec
+
+func (p pathItems) add(method string, path string, o *operation) {
+	item, ok := p[path]
+	if !ok {
+		item = spec{}
+		p[path] = item
+	}
+	item[strings.ToLower(method)] = o.build(method, path)
+}
+
+// The query parameters the listings share.
+var (
+	limitParameter  = typed("integer", "Number of items on a page")
+	offsetParameter = typed("integer", "Number of items to skip")
+	cursorParameter = typed("string", "Cursor of the page to return, taken from next_cursor or the Link header of the previous one")
+)
+
+// openAPIDocument describes every route of the API.
+func openAPIDocument() spec {
+	schemas := spec{
+		"Error": object([]string{"error"}, spec{
+			"error": spec{
+				"description": "What went wrong, or for invalid input the problems found with each field",
+				"oneOf": []spec{
+					typed("string", ""),
+					{"type": "object", "additionalProperties": array(typed("string", ""))},
+				},
+			},
+		}),
+		"Status": object([]string{"status"}, spec{"status": typed("string", "")}),
+		"ImageURLs": object([]string{"url", "thumbnail_url"}, spec{
+			"url":           formatted("uri", ""),
+			"thumbnail_url": formatted("uri", ""),
+		}),
+		"CursorMetadata": object([]string{"limit"}, spec{
+			"limit":       typed("integer", ""),
+			"next_cursor": typed("string", "Cursor of the next page, left out on the last one"),
+		}),
+		"PageMetadata": object([]string{"total", "limit", "offset"}, spec{
+			"total":  typed("integer", ""),
+			"limit":  typed("integer", ""),
+			"offset": typed("integer", ""),
+		}),
+
+		"Login": object([]string{"email", "password"}, spec{
+			"email":    formatted("email", ""),
+			"password": typed("string", ""),
+		}),
+		"Token": object([]string{"token", "type", "create_at", "userID"}, spec{
+			"token":     typed("string", "Bearer token to send in the Authorization header"),
+			"type":      typed("string", ""),
+			"create_at": formatted("date-time", ""),
+			"userID":    typed("string", ""),
+		}),
+
+		"User": object([]string{"id", "username", "avatar", "created_at"}, spec{
+			"id":         typed("string", ""),
+			"email":      formatted("email", "Left out of search results for other users"),
+			"username":   typed("string", ""),
+			"avatar":     nullable(ref("ImageURLs")),
+			"created_at": formatted("date-time", ""),
+			"updated_at": formatted("date-time", ""),
+		}),
+		"UserCreate": object([]string{"email", "username", "password"}, spec{
+			"email":    formatted("email", ""),
+			"username": typed("string", ""),
+			"password": typed("string", ""),
+		}),
+		"PasswordUpdate": object([]string{"password"}, spec{
+			"password": typed("string", ""),
+		}),
+		"BulkUserResult": object([]string{"row", "email", "username", "status"}, spec{
+			"row":                typed("integer", "Position of the user in the input, starting at 1"),
+			"email":              typed("string", ""),
+			"username":           typed("string", ""),
+			"status":             enum("", bulkStatusCreated, bulkStatusInvalid, bulkStatusFailed, bulkStatusSkipped),
+			"id":                 typed("string", ""),
+			"temporary_password": typed("string", "Password generated for the user, left out of replays of the request"),
+			"error":              typed("string", ""),
+			"errors":             spec{"type": "object", "additionalProperties": array(typed("string", ""))},
+		}),
+		"BulkUserReport": object([]string{"results", "metadata"}, spec{
+			"results": array(ref("BulkUserResult")),
+			"metadata": object([]string{"mode", "created", "failed"}, spec{
+				"mode":    enum("", bulkModeAtomic, bulkModePerRow),
+				"created": typed("integer", ""),
+				"failed":  typed("integer", ""),
+			}),
+		}),
+		"Export": object([]string{"id", "status", "created_at"}, spec{
+			"id":           typed("string", ""),
+			"status":       enum("", exportStatusPending, exportStatusReady, exportStatusFailed),
+			"created_at":   formatted("date-time", ""),
+			"download_url": typed("string", "Set once the export is ready"),
+		}),
+
+		"Oink": object([]string{"id", "name", "description", "creator_id", "visibility", "tags", "avatar", "banner", "member_count", "created_at"}, spec{
+			"id":           typed("string", ""),
+			"name":         typed("string", ""),
+			"description":  typed("string", ""),
+			"creator_id":   typed("string", ""),
+			"visibility":   enum("", "public", "unlisted", "private"),
+			"language":     typed("string", ""),
+			"tags":         array(typed("string", "")),
+			"avatar":       nullable(ref("ImageURLs")),
+			"banner":       nullable(ref("ImageURLs")),
+			"member_count": typed("integer", ""),
+			"created_at":   formatted("date-time", ""),
+			"updated_at":   formatted("date-time", ""),
+			"archived_at":  formatted("date-time", "Set on archived oinks"),
+			"archived_by":  typed("string", "Set on archived oinks"),
+		}),
+		"OinkCreate": object([]string{"name"}, spec{
+			"name":        typed("string", ""),
+			"description": typed("string", ""),
+			"visibility":  enum("Defaults to public", "public", "unlisted", "private"),
+			"language":    typed("string", ""),
+			"tags":        array(typed("string", "")),
+		}),
+		"OinkUpdate": object(nil, spec{
+			"name":        typed("string", ""),
+			"description": typed("string", ""),
+			"visibility":  enum("", "public", "unlisted", "private"),
+			"language":    typed("string", ""),
+			"tags":        array(typed("string", "")),
+		}),
+		"OinkSearchResult": spec{"allOf": []spec{
+			ref("Oink"),
+			object([]string{"rank", "name_highlight", "snippet"}, spec{
+				"rank":           typed("number", ""),
+				"name_highlight": typed("string", ""),
+				"snippet":        typed("string", ""),
+			}),
+		}},
+		"OinkStats": object([]string{"name", "created_at", "age_days", "member_count", "post_count", "days", "new_members", "new_posts", "activity"}, spec{
+			"name":         typed("string", ""),
+			"created_at":   formatted("date-time", ""),
+			"age_days":     typed("integer", ""),
+			"member_count": typed("integer", ""),
+			"post_count":   typed("integer", ""),
+			"days":         typed("integer", ""),
+			"new_members":  typed("integer", ""),
+			"new_posts":    typed("integer", ""),
+			"activity": array(object([]string{"date", "new_members", "new_posts"}, spec{
+				"date":        formatted("date", ""),
+				"new_members": typed("integer", ""),
+				"new_posts":   typed("integer", ""),
+			})),
+		}),
+		"Member": object([]string{"oink_id", "user_id", "role", "created_at"}, spec{
+			"oink_id":    typed("string", ""),
+			"user_id":    typed("string", ""),
+			"username":   typed("string", ""),
+			"role":       enum("", "owner", "moderator", "member"),
+			"created_at": formatted("date-time", ""),
+		}),
+		"UserReference": object([]string{"user_id"}, spec{
+			"user_id": typed("string", ""),
+		}),
+		"Invitation": object([]string{"oink_id", "user_id", "kind", "created_by", "created_at"}, spec{
+			"oink_id":    typed("string", ""),
+			"user_id":    typed("string", ""),
+			"username":   typed("string", ""),
+			"kind":       enum("An invitation sent by a moderator, or a request to join sent by the user", "invitation", "request"),
+			"created_by": typed("string", ""),
+			"created_at": formatted("date-time", ""),
+		}),
+		"Transfer": object([]string{"oink_id", "from_user_id", "to_user_id", "created_at"}, spec{
+			"oink_id":      typed("string", ""),
+			"from_user_id": typed("string", ""),
+			"to_user_id":   typed("string", ""),
+			"created_at":   formatted("date-time", ""),
+		}),
+		"Post": object([]string{"id", "oink_id", "oink_name", "author_id", "author_username", "body", "created_at", "updated_at"}, spec{
+			"id":              typed("string", ""),
+			"oink_id":         typed("string", ""),
+			"oink_name":       typed("string", ""),
+			"author_id":       typed("string", ""),
+			"author_username": typed("string", ""),
+			"body":            typed("string", ""),
+			"created_at":      formatted("date-time", ""),
+			"updated_at":      formatted("date-time", ""),
+		}),
+		"PostBody": object([]string{"body"}, spec{
+			"body": typed("string", ""),
+		}),
+		"Tag": object([]string{"name", "oink_count"}, spec{
+			"name":       typed("string", ""),
+			"oink_count": typed("integer", ""),
+		}),
+	}
+
+	// wrapped is the JSON content of an envelope holding schema under key.
+	wrapped := func(key string, schema spec) spec {
+		return content(contentTypeJSON, object([]string{key}, spec{key: schema}))
+	}
+	streamed := func(row string, c spec) spec {
+		c[contentTypeNDJSON] = spec{"schema": ref(row)}
+		c[contentTypeCSV] = spec{"schema": typed("string", "")}
+		return c
+	}
+	imageUpload := content("multipart/form-data", object([]string{imageUploadField}, spec{
+		imageUploadField: formatted("binary", ""),
+	}))
+
+	paths := pathItems{}
+
+	paths.add(http.MethodGet, "/health", op("meta", "Report the health of the service").public().
+		returns(http.StatusOK, "The service is up", content(contentTypeJSON, spec{"type": "object"})).
+		returns(http.StatusServiceUnavailable, "The service is down", content(contentTypeJSON, spec{"type": "object"})))
+	paths.add(http.MethodGet, "/api/v1/openapi.json", op("meta", "Describe the API").public().
+		returns(http.StatusOK, "This document", content(contentTypeJSON, spec{"type": "object"})))
+	paths.add(http.MethodGet, "/api/v1/blobs/{key}", op("meta", "Download a stored image").public().
+		returns(http.StatusOK, "The image", content("image/*", formatted("binary", ""))).
+		fails(http.StatusNotFound))
+
+	paths.add(http.MethodPost, "/api/v1/auth/login", op("auth", "Log in").public().
+		body(content(contentTypeJSON, ref("Login"))).
+		returns(http.StatusOK, "A token for the user", wrapped("token", ref("Token"))).
+		fails(http.StatusBadRequest, http.StatusForbidden, http.StatusUnprocessableEntity))
+	paths.add(http.MethodGet, "/api/v1/auth/me", op("auth", "Retrieve the logged in user").
+		returns(http.StatusOK, "The user", wrapped("user", ref("User"))).
+		conditional())
+
+	paths.add(http.MethodGet, "/api/v1/users", op("users", "List users").
+		query("created_after", formatted("date-time", "")).
+		query("created_before", formatted("date-time", "")).
+		query("username_prefix", typed("string", "")).
+		query("sort", enum("Field to sort by, prefixed with - for descending order", "created_at", "-created_at", "username", "-username")).
+		query("cursor", cursorParameter).
+		query("limit", limitParameter).
+		returns(http.StatusOK, "A page of users, or all of them when streamed", streamed("User", content(contentTypeJSON, object([]string{"users", "metadata"}, spec{
+			"users":    array(ref("User")),
+			"metadata": ref("CursorMetadata"),
+		})))).
+		withHeader(http.StatusOK, "Link", "Link to the next page").
+		fails(http.StatusBadRequest, http.StatusForbidden))
+	paths.add(http.MethodPost, "/api/v1/users", op("users", "Create a user").
+		body(content(contentTypeJSON, ref("UserCreate"))).
+		returns(http.StatusCreated, "The user", wrapped("user", ref("User"))).
+		fails(http.StatusBadRequest, http.StatusUnprocessableEntity))
+	paths.add(http.MethodPost, "/api/v1/users/bulk", op("users", "Create users in bulk").
+		query("mode", enum("Whether one invalid user rolls back all of them", bulkModeAtomic, bulkModePerRow)).
+		query("generate_passwords", typed("boolean", "Generate passwords for users given without one")).
+		body(spec{
+			contentTypeJSON:       spec{"schema": array(object([]string{"email", "username"}, spec{"email": typed("string", ""), "username": typed("string", ""), "password": typed("string", "")}))},
+			contentTypeCSV:        spec{"schema": typed("string", "Records with email, username and password columns, under a header naming them")},
+			"multipart/form-data": spec{"schema": object([]string{"file"}, spec{"file": formatted("binary", "A CSV file")})},
+		}).
+		returns(http.StatusCreated, "Every user was created", content(contentTypeJSON, ref("BulkUserReport"))).
+		returns(http.StatusOK, "The outcome for each user", content(contentTypeJSON, ref("BulkUserReport"))).
+		returns(http.StatusUnprocessableEntity, "Some users are invalid or already exist, none was created", content(contentTypeJSON, ref("BulkUserReport"))).
+		fails(http.StatusBadRequest, http.StatusRequestEntityTooLarge, http.StatusUnsupportedMediaType))
+	paths.add(http.MethodGet, "/api/v1/users/search", op("users", "Search users").
+		query("q", typed("string", "")).
+		query("limit", limitParameter).
+		returns(http.StatusOK, "The matching users", wrapped("users", array(ref("User")))).
+		fails(http.StatusBadRequest))
+	paths.add(http.MethodGet, "/api/v1/users/{userID}", op("users", "Retrieve a user").
+		returns(http.StatusOK, "The user", wrapped("user", ref("User"))).
+		conditional().
+		fails(http.StatusNotFound))
+	paths.add(http.MethodDelete, "/api/v1/users/{userID}", op("users", "Delete a user").
+		query("strategy", enum("What happens to the oinks of the user", "reassign", "ghost", "cascade")).
+		query("reassign_to", typed("string", "ID of the user to hand the oinks to with the reassign strategy")).
+		preconditioned().
+		returns(http.StatusOK, "The user was deleted", content(contentTypeJSON, ref("Status"))).
+		fails(http.StatusBadRequest, http.StatusForbidden, http.StatusNotFound))
+	paths.add(http.MethodPost, "/api/v1/users/{userID}/password", op("users", "Change the password of a user").
+		body(content(contentTypeJSON, ref("PasswordUpdate"))).
+		returns(http.StatusOK, "The password was changed", content(contentTypeJSON, ref("Status"))).
+		fails(http.StatusBadRequest, http.StatusNotFound, http.StatusUnprocessableEntity))
+	paths.add(http.MethodPut, "/api/v1/users/{userID}/avatar", op("users", "Upload the avatar of a user").
+		body(imageUpload).
+		returns(http.StatusOK, "The avatar", wrapped("avatar", ref("ImageURLs"))).
+		fails(http.StatusBadRequest, http.StatusNotFound, http.StatusRequestEntityTooLarge, http.StatusUnsupportedMediaType))
+	paths.add(http.MethodDelete, "/api/v1/users/{userID}/avatar", op("users", "Remove the avatar of a user").
+		returns(http.StatusNoContent, "The avatar was removed", nil).
+		fails(http.StatusBadRequest, http.StatusNotFound))
+	paths.add(http.MethodGet, "/api/v1/users/{userID}/oinks", op("users", "List the oinks of a user").
+		returns(http.StatusOK, "The oinks", wrapped("oinks", array(ref("Oink")))).
+		fails(http.StatusNotFound))
+	paths.add(http.MethodGet, "/api/v1/users/{userID}/export", op("users", "Export the data of a user").
+		returns(http.StatusOK, "The export is ready", wrapped("export", ref("Export"))).
+		returns(http.StatusAccepted, "The export is being prepared", wrapped("export", ref("Export"))).
+		fails(http.StatusNotFound))
+	paths.add(http.MethodGet, "/api/v1/users/{userID}/export/{exportID}", op("users", "Download the export of a user").
+		returns(http.StatusOK, "The export", content("application/zip", formatted("binary", ""))).
+		fails(http.StatusNotFound))
+
+	paths.add(http.MethodGet, "/api/v1/oinks", op("oinks", "List oinks").
+		query("creator", typed("string", "ID of the user who created the oinks")).
+		query("created_after", formatted("date-time", "")).
+		query("created_before", formatted("date-time", "")).
+		query("name_prefix", typed("string", "")).
+		query("tag", typed("string", "Comma separated tags the oinks all carry")).
+		query("include", enum("Include archived oinks", "archived")).
+		query("sort", enum("Field to sort by, prefixed with - for descending order", "created_at", "-created_at", "name", "-name")).
+		query("cursor", cursorParameter).
+		query("limit", limitParameter).
+		returns(http.StatusOK, "A page of oinks, or all of them when streamed", streamed("Oink", content(contentTypeJSON, object([]string{"oinks", "metadata"}, spec{
+			"oinks":    array(ref("Oink")),
+			"metadata": ref("CursorMetadata"),
+		})))).
+		withHeader(http.StatusOK, "Link", "Link to the next page").
+		fails(http.StatusBadRequest))
+	paths.add(http.MethodPost, "/api/v1/oinks", op("oinks", "Create an oink").
+		body(content(contentTypeJSON, ref("OinkCreate"))).
+		returns(http.StatusCreated, "The oink", wrapped("oink", ref("Oink"))).
+		fails(http.StatusBadRequest, http.StatusUnprocessableEntity))
+	paths.add(http.MethodGet, "/api/v1/oinks/search", op("oinks", "Search oinks").
+		query("q", typed("string", "")).
+		query("lang", typed("string", "Language to search in")).
+		query("limit", limitParameter).
+		query("offset", offsetParameter).
+		returns(http.StatusOK, "The matching oinks, best first", content(contentTypeJSON, object([]string{"results", "metadata"}, spec{
+			"results":  array(ref("OinkSearchResult")),
+			"metadata": ref("PageMetadata"),
+		}))).
+		fails(http.StatusBadRequest))
+	paths.add(http.MethodGet, "/api/v1/oinks/{oinkName}", op("oinks", "Retrieve an oink").
+		returns(http.StatusOK, "The oink", wrapped("oink", ref("Oink"))).
+		conditional().
+		returns(http.StatusMovedPermanently, "The oink was renamed", wrapped("location", typed("string", ""))).
+		withHeader(http.StatusMovedPermanently, "Location", "Path of the oink under its current name").
+		fails(http.StatusNotFound))
+	paths.add(http.MethodPatch, "/api/v1/oinks/{oinkName}", op("oinks", "Update an oink").
+		body(content(contentTypeJSON, ref("OinkUpdate"))).
+		preconditioned().
+		returns(http.StatusOK, "The oink", wrapped("oink", ref("Oink"))).
+		withHeader(http.StatusOK, "ETag", "Version of the oink").
+		fails(http.StatusBadRequest, http.StatusNotFound, http.StatusConflict, http.StatusUnprocessableEntity))
+	paths.add(http.MethodDelete, "/api/v1/oinks/{oinkName}", op("oinks", "Delete an oink").
+		preconditioned().
+		returns(http.StatusNoContent, "The oink was deleted", nil).
+		fails(http.StatusNotFound))
+	paths.add(http.MethodPost, "/api/v1/oinks/{oinkName}/archive", op("oinks", "Archive an oink").
+		returns(http.StatusOK, "The oink", wrapped("oink", ref("Oink"))).
+		fails(http.StatusNotFound))
+	paths.add(http.MethodDelete, "/api/v1/oinks/{oinkName}/archive", op("oinks", "Unarchive an oink").
+		returns(http.StatusOK, "The oink", wrapped("oink", ref("Oink"))).
+		fails(http.StatusNotFound))
+	paths.add(http.MethodGet, "/api/v1/oinks/{oinkName}/stats", op("oinks", "Retrieve the activity of an oink").
+		query("days", typed("integer", "Number of days of activity to report")).
+		returns(http.StatusOK, "The stats", wrapped("stats", ref("OinkStats"))).
+		fails(http.StatusBadRequest, http.StatusNotFound))
+	for _, kind := range []string{"avatar", "banner"} {
+		paths.add(http.MethodPut, "/api/v1/oinks/{oinkName}/"+kind, op("oinks", "Upload the "+kind+" of an oink").
+			body(imageUpload).
+			returns(http.StatusOK, "The "+kind, wrapped(kind, ref("ImageURLs"))).
+			fails(http.StatusBadRequest, http.StatusNotFound, http.StatusRequestEntityTooLarge, http.StatusUnsupportedMediaType))
+		paths.add(http.MethodDelete, "/api/v1/oinks/{oinkName}/"+kind, op("oinks", "Remove the "+kind+" of an oink").
+			returns(http.StatusNoContent, "The "+kind+" was removed", nil).
+			fails(http.StatusNotFound))
+	}
+
+	paths.add(http.MethodGet, "/api/v1/oinks/{oinkName}/members", op("members", "List the members of an oink").
+		query("limit", limitParameter).
+		query("offset", offsetParameter).
+		returns(http.StatusOK, "A page of members", content(contentTypeJSON, object([]string{"members", "metadata"}, spec{
+			"members":  array(ref("Member")),
+			"metadata": ref("PageMetadata"),
+		}))).
+		fails(http.StatusBadRequest, http.StatusNotFound))
+	paths.add(http.MethodPost, "/api/v1/oinks/{oinkName}/members/me", op("members", "Join an oink").
+		returns(http.StatusCreated, "The membership", wrapped("member", ref("Member"))).
+		returns(http.StatusAccepted, "A request to join the private oink was sent", wrapped("message", typed("string", ""))).
+		fails(http.StatusNotFound))
+	paths.add(http.MethodDelete, "/api/v1/oinks/{oinkName}/members/me", op("members", "Leave an oink").
+		returns(http.StatusNoContent, "The oink was left", nil).
+		fails(http.StatusNotFound, http.StatusConflict))
+	paths.add(http.MethodGet, "/api/v1/oinks/{oinkName}/invitations", op("members", "List the pending invitations and requests to join an oink").
+		returns(http.StatusOK, "The invitations", wrapped("invitations", array(ref("Invitation")))).
+		fails(http.StatusNotFound))
+	paths.add(http.MethodPost, "/api/v1/oinks/{oinkName}/invitations", op("members", "Invite a user to an oink").
+		body(content(contentTypeJSON, ref("UserReference"))).
+		returns(http.StatusCreated, "The invitation", wrapped("invitation", ref("Invitation"))).
+		fails(http.StatusBadRequest, http.StatusNotFound))
+	paths.add(http.MethodDelete, "/api/v1/oinks/{oinkName}/invitations/{userID}", op("members", "Withdraw or decline an invitation or request to join").
+		returns(http.StatusNoContent, "The invitation was removed", nil).
+		fails(http.StatusNotFound))
+	paths.add(http.MethodPost, "/api/v1/oinks/{oinkName}/invitations/{userID}/approve", op("members", "Approve a request to join an oink").
+		returns(http.StatusCreated, "The membership", wrapped("member", ref("Member"))).
+		fails(http.StatusNotFound))
+	paths.add(http.MethodPut, "/api/v1/oinks/{oinkName}/moderators/{userID}", op("members", "Make a member a moderator").
+		returns(http.StatusOK, "The membership", wrapped("member", ref("Member"))).
+		fails(http.StatusNotFound, http.StatusConflict))
+	paths.add(http.MethodDelete, "/api/v1/oinks/{oinkName}/moderators/{userID}", op("members", "Revoke the moderator role of a member").
+		returns(http.StatusNoContent, "The role was revoked", nil).
+		fails(http.StatusNotFound, http.StatusConflict))
+	paths.add(http.MethodPost, "/api/v1/oinks/{oinkName}/transfer", op("members", "Offer the ownership of an oink to a member").
+		body(content(contentTypeJSON, ref("UserReference"))).
+		returns(http.StatusCreated, "The offer", wrapped("transfer", ref("Transfer"))).
+		fails(http.StatusBadRequest, http.StatusNotFound))
+	paths.add(http.MethodDelete, "/api/v1/oinks/{oinkName}/transfer", op("members", "Cancel an offer of ownership").
+		returns(http.StatusNoContent, "The offer was cancelled", nil).
+		fails(http.StatusNotFound))
+	paths.add(http.MethodPost, "/api/v1/oinks/{oinkName}/transfer/accept", op("members", "Accept the ownership of an oink").
+		returns(http.StatusOK, "The membership of the new owner", wrapped("member", ref("Member"))).
+		fails(http.StatusNotFound))
+
+	paths.add(http.MethodGet, "/api/v1/oinks/{oinkName}/posts", op("posts", "List the posts of an oink").
+		query("limit", limitParameter).
+		query("offset", offsetParameter).
+		returns(http.StatusOK, "A page of posts", content(contentTypeJSON, object([]string{"posts", "metadata"}, spec{
+			"posts":    array(ref("Post")),
+			"metadata": ref("PageMetadata"),
+		}))).
+		fails(http.StatusBadRequest, http.StatusNotFound))
+	paths.add(http.MethodPost, "/api/v1/oinks/{oinkName}/posts", op("posts", "Write a post").
+		body(content(contentTypeJSON, ref("PostBody"))).
+		returns(http.StatusCreated, "The post", wrapped("post", ref("Post"))).
+		fails(http.StatusBadRequest, http.StatusNotFound, http.StatusUnprocessableEntity))
+	paths.add(http.MethodGet, "/api/v1/oinks/{oinkName}/posts/{postID}", op("posts", "Retrieve a post").
+		returns(http.StatusOK, "The post", wrapped("post", ref("Post"))).
+		conditional().
+		fails(http.StatusNotFound))
+	paths.add(http.MethodPatch, "/api/v1/oinks/{oinkName}/posts/{postID}", op("posts", "Edit a post").
+		body(content(contentTypeJSON, ref("PostBody"))).
+		preconditioned().
+		returns(http.StatusOK, "The post", wrapped("post", ref("Post"))).
+		withHeader(http.StatusOK, "ETag", "Version of the post").
+		fails(http.StatusBadRequest, http.StatusNotFound, http.StatusUnprocessableEntity))
+	paths.add(http.MethodDelete, "/api/v1/oinks/{oinkName}/posts/{postID}", op("posts", "Delete a post").
+		preconditioned().
+		returns(http.StatusNoContent, "The post was deleted", nil).
+		fails(http.StatusNotFound))
+
+	paths.add(http.MethodGet, "/api/v1/tags", op("oinks", "List the tags oinks carry").
+		returns(http.StatusOK, "The tags", wrapped("tags", array(ref("Tag")))))
+
+	return spec{
+		"openapi": "3.1.0",
+		"info": spec{
+			"title":   "go-oink",
+			"version": "1",
+		},
+		"paths": paths,
+		"components": spec{
+			"schemas": schemas,
+			"securitySchemes": spec{
+				"bearerAuth": spec{"type": "http", "scheme": "bearer"},
+			},
+		},
+		"security": []spec{{"bearerAuth": []string{}}},
+	}
+}
+
+// OpenAPI serves the OpenAPI document describing the API.
+func (s *Server) OpenAPI() http.HandlerFunc {
+	document, err := json.Marshal(openAPIDocument())
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		if err != nil {
+			hlog.FromRequest(r).Error().Err(err).Msg("api-OpenAPI-Marshal")
+			s.writeJSON(w, http.StatusInternalServerError, envelope{"error": http.StatusText(http.StatusInternalServerError)}, nil)
+			return
+		}
+
+		w.Header().Set("Content-Type", contentTypeJSON)
+		w.Write(document)
+	}
+}
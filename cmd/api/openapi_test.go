@@ -0,0 +1,91 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// servedOpenAPIDocument fetches the OpenAPI document the way clients do.
+func servedOpenAPIDocument(t *testing.T, s *Server) map[string]interface{} {
+	t.Helper()
+
+	rec := serve(http.MethodGet, "/api/v1/openapi.json", "/api/v1/openapi.json", s.OpenAPI())
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusOK)
+	}
+	if got := rec.Header().Get("Content-Type"); got != contentTypeJSON {
+		t.Errorf("Content-Type = %q, want %q", got, contentTypeJSON)
+	}
+
+	var document map[string]interface{}
+	if err := json.Unmarshal(rec.Body.Bytes(), &document); err != nil {
+		t.Fatalf("decoding document: %v", err)
+	}
+	if document["openapi"] != "3.1.0" {
+		t.Errorf("openapi = %v, want 3.1.0", document["openapi"])
+	}
+
+	return document
+}
+
+func TestOpenAPIDocumentCoversRoutes(t *testing.T) {
+	s, _ := newTestServer(t)
+	document := servedOpenAPIDocument(t, s)
+	paths := document["paths"].(map[string]interface{})
+
+	routed := map[string]bool{}
+	err := chi.Walk(s.routes().(chi.Routes), func(method string, route string, handler http.Handler, middlewares ...func(http.Handler) http.Handler) error {
+		// wildcards are documented as a trailing path parameter
+		path := strings.Replace(route, "/*", "/{key}", 1)
+		operation := strings.ToLower(method) + " " + path
+		routed[operation] = true
+
+		item, ok := paths[path].(map[string]interface{})
+		if !ok || item[strings.ToLower(method)] == nil {
+			t.Errorf("%s %s has no entry in the OpenAPI document", method, route)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("chi.Walk: %v", err)
+	}
+
+	for path, item := range paths {
+		for method := range item.(map[string]interface{}) {
+			if !routed[method+" "+path] {
+				t.Errorf("%s %s is documented but not routed", strings.ToUpper(method), path)
+			}
+		}
+	}
+}
+
+func TestOpenAPIDocumentReferences(t *testing.T) {
+	s, _ := newTestServer(t)
+	document := servedOpenAPIDocument(t, s)
+	schemas := document["components"].(map[string]interface{})["schemas"].(map[string]interface{})
+
+	var walk func(node interface{})
+	walk = func(node interface{}) {
+		switch node := node.(type) {
+		case map[string]interface{}:
+			if ref, ok := node["$ref"].(string); ok {
+				name := strings.TrimPrefix(ref, "#/components/schemas/")
+				if _, ok := schemas[name]; !ok || name == ref {
+					t.Errorf("$ref %q does not resolve", ref)
+				}
+			}
+			for _, child := range node {
+				walk(child)
+			}
+		case []interface{}:
+			for _, child := range node {
+				walk(child)
+			}
+		}
+	}
+	walk(document)
+}
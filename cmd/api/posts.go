@@ -0,0 +1,356 @@
+package main
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/mrityunjaygr8/go-oink/internal/repository"
+	"github.com/mrityunjaygr8/go-oink/internal/validator"
+	"github.com/rs/zerolog/hlog"
+)
+
+type postResponse struct {
+	ID             string    `json:"id"`
+	OinkID         string    `json:"oink_id"`
+	OinkName       string    `json:"oink_name"`
+	AuthorID       string    `json:"author_id"`
+	AuthorUsername string    `json:"author_username"`
+	Body           string    `json:"body"`
+	CreatedAt      time.Time `json:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
+
+func newPostResponse(post *repository.Post) postResponse {
+	return postResponse{
+		ID:             post.ID,
+		OinkID:         post.OinkID,
+		OinkName:       post.OinkName,
+		AuthorID:       post.AuthorID,
+		AuthorUsername: post.AuthorUsername,
+		Body:           post.Body,
+		CreatedAt:      post.CreatedAt,
+		UpdatedAt:      post.UpdatedAt,
+	}
+}
+
+func (s *Server) PostList() http.HandlerFunc {
+	const (
+		defaultLimit = 20
+		maxLimit     = 100
+	)
+
+	type metadata struct {
+		Total  int64 `json:"total"`
+		Limit  int   `json:"limit"`
+		Offset int   `json:"offset"`
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := hlog.FromRequest(r)
+		oinkName := chi.URLParam(r, "oinkName")
+
+		limit, offset, err := s.readPage(r.URL.Query(), defaultLimit, maxLimit)
+		if err != nil {
+			s.writeJSON(w, http.StatusBadRequest, envelope{"error": err.Error()}, nil)
+			return
+		}
+
+		repo := repository.New(s.db, *logger)
+		if _, ok := s.retrieveVisibleOink(w, r, repo, oinkName); !ok {
+			return
+		}
+
+		p, total, err := repo.PostRepository.PostList(r.Context(), oinkName, limit, offset)
+		if err != nil {
+			if errors.Is(err, repository.ErrOinkNotFound) {
+				s.writeJSON(w, http.StatusNotFound, envelope{"error": err.Error()}, nil)
+				return
+			}
+			logger.Error().Err(err).Msg("api-PostList-PostList")
+			s.writeJSON(w, http.StatusInternalServerError, envelope{"error": http.StatusText(http.StatusInternalServerError)}, nil)
+			return
+		}
+
+		posts := make([]postResponse, 0)
+		for _, post := range *p {
+			posts = append(posts, newPostResponse(&post))
+		}
+
+		s.writeJSON(w, http.StatusOK, envelope{"posts": posts, "metadata": metadata{Total: total, Limit: limit, Offset: offset}}, nil)
+	}
+}
+
+func (s *Server) PostRetrieve() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := hlog.FromRequest(r)
+		oinkName := chi.URLParam(r, "oinkName")
+		postID := chi.URLParam(r, "postID")
+
+		repo := repository.New(s.db, *logger)
+		if _, ok := s.retrieveVisibleOink(w, r, repo, oinkName); !ok {
+			return
+		}
+
+		post, err := repo.PostRepository.PostRetrieve(r.Context(), oinkName, postID)
+		if err != nil {
+			if errors.Is(err, repository.ErrOinkNotFound) || errors.Is(err, repository.ErrPostNotFound) {
+				s.writeJSON(w, http.StatusNotFound, envelope{"error": err.Error()}, nil)
+				return
+			}
+			logger.Error().Err(err).Msg("api-PostRetrieve-PostRetrieve")
+			s.writeJSON(w, http.StatusInternalServerError, envelope{"error": http.StatusText(http.StatusInternalServerError)}, nil)
+			return
+		}
+
+		if s.notModified(w, r, postETag(post)) {
+			return
+		}
+
+		s.writeJSON(w, http.StatusOK, envelope{"post": newPostResponse(post)}, nil)
+	}
+}
+
+func (s *Server) PostInsert() http.HandlerFunc {
+	type request struct {
+		Body string `json:"body"`
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := hlog.FromRequest(r)
+		oinkName := chi.URLParam(r, "oinkName")
+
+		var req request
+		err := s.readJSON(w, r, &req)
+		if err != nil {
+			logger.Error().Err(err).Msg("api-PostInsert-readJson")
+			s.writeJSON(w, http.StatusBadRequest, envelope{"error": err.Error()}, nil)
+			return
+		}
+
+		v := validator.New()
+		validatePostBody(v, "body", req.Body)
+		if !v.Valid() {
+			s.failedValidationResponse(w, v.Errors)
+			return
+		}
+
+		u, ok := r.Context().Value("user").(*repository.User)
+		if !ok {
+			logger.Error().Any("User", r.Context().Value("user")).Msg("api-PostInsert-userTypeAssertion")
+			s.writeJSON(w, http.StatusInternalServerError, envelope{"error": http.StatusText(http.StatusInternalServerError)}, nil)
+			return
+		}
+
+		repo := repository.New(s.db, *logger)
+		if _, ok := s.retrieveVisibleOink(w, r, repo, oinkName); !ok {
+			return
+		}
+
+		post, err := repo.PostRepository.PostInsert(r.Context(), oinkName, u.ID, req.Body)
+		if err != nil {
+			if errors.Is(err, repository.ErrOinkNotFound) {
+				s.writeJSON(w, http.StatusNotFound, envelope{"error": err.Error()}, nil)
+				return
+			}
+			if errors.Is(err, repository.ErrOinkMemberNotFound) {
+				s.writeJSON(w, http.StatusForbidden, envelope{"error": err.Error()}, nil)
+				return
+			}
+			if errors.Is(err, repository.ErrOinkArchived) {
+				s.writeJSON(w, http.StatusConflict, envelope{"error": err.Error()}, nil)
+				return
+			}
+			logger.Error().Err(err).Msg("api-PostInsert-PostInsert")
+			s.writeJSON(w, http.StatusInternalServerError, envelope{"error": http.StatusText(http.StatusInternalServerError)}, nil)
+			return
+		}
+
+		s.writeJSON(w, http.StatusCreated, envelope{"post": newPostResponse(post)}, nil)
+	}
+}
+
+func (s *Server) PostUpdate() http.HandlerFunc {
+	type request struct {
+		Body string `json:"body"`
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := hlog.FromRequest(r)
+		oinkName := chi.URLParam(r, "oinkName")
+		postID := chi.URLParam(r, "postID")
+
+		var req request
+		err := s.readJSON(w, r, &req)
+		if err != nil {
+			logger.Error().Err(err).Msg("api-PostUpdate-readJson")
+			s.writeJSON(w, http.StatusBadRequest, envelope{"error": err.Error()}, nil)
+			return
+		}
+
+		v := validator.New()
+		validatePostBody(v, "body", req.Body)
+		if !v.Valid() {
+			s.failedValidationResponse(w, v.Errors)
+			return
+		}
+
+		repo := repository.New(s.db, *logger)
+		if _, ok := s.retrieveVisibleOink(w, r, repo, oinkName); !ok {
+			return
+		}
+
+		post, err := repo.PostRepository.PostRetrieve(r.Context(), oinkName, postID)
+		if err != nil {
+			if errors.Is(err, repository.ErrOinkNotFound) || errors.Is(err, repository.ErrPostNotFound) {
+				s.writeJSON(w, http.StatusNotFound, envelope{"error": err.Error()}, nil)
+				return
+			}
+			logger.Error().Err(err).Msg("api-PostUpdate-PostRetrieve")
+			s.writeJSON(w, http.StatusInternalServerError, envelope{"error": http.StatusText(http.StatusInternalServerError)}, nil)
+			return
+		}
+
+		requester, ok := r.Context().Value("user").(*repository.User)
+		if !ok || requester.ID != post.AuthorID {
+			s.writeJSON(w, http.StatusForbidden, envelope{"error": http.StatusText(http.StatusForbidden)}, nil)
+			return
+		}
+
+		tx, err := s.db.Begin()
+		if err != nil {
+			logger.Error().Err(err).Msg("error creating transaction")
+			s.writeJSON(w, http.StatusInternalServerError, envelope{"error": http.StatusText(http.StatusInternalServerError)}, nil)
+			return
+		}
+
+		txRepo := repository.New(tx, *logger)
+		post, err = txRepo.PostRepository.PostLock(r.Context(), oinkName, postID)
+		if err == nil && !s.ifMatch(w, r, postETag(post)) {
+			if rollback := tx.Rollback(); rollback != nil {
+				logger.Error().Err(rollback).Msg("api-PostUpdate-ifMatch-RollbackError")
+			}
+			return
+		}
+		if err == nil {
+			post, err = txRepo.PostRepository.PostUpdate(r.Context(), oinkName, postID, req.Body)
+		}
+		if err != nil {
+			if rollback := tx.Rollback(); rollback != nil {
+				logger.Error().Err(err).Msg("api-PostUpdate-PostUpdate-RollbackError")
+				s.writeJSON(w, http.StatusInternalServerError, envelope{"error": http.StatusText(http.StatusInternalServerError)}, nil)
+				return
+			}
+			if errors.Is(err, repository.ErrOinkNotFound) || errors.Is(err, repository.ErrPostNotFound) {
+				s.writeJSON(w, http.StatusNotFound, envelope{"error": err.Error()}, nil)
+				return
+			}
+			if errors.Is(err, repository.ErrOinkArchived) {
+				s.writeJSON(w, http.StatusConflict, envelope{"error": err.Error()}, nil)
+				return
+			}
+			logger.Error().Err(err).Msg("api-PostUpdate-PostUpdate")
+			s.writeJSON(w, http.StatusInternalServerError, envelope{"error": http.StatusText(http.StatusInternalServerError)}, nil)
+			return
+		}
+
+		if err := tx.Commit(); err != nil {
+			logger.Error().Err(err).Msg("api-PostUpdate-PostUpdate-CommitError")
+			s.writeJSON(w, http.StatusInternalServerError, envelope{"error": http.StatusText(http.StatusInternalServerError)}, nil)
+			return
+		}
+
+		w.Header().Set("ETag", postETag(post))
+		s.writeJSON(w, http.StatusOK, envelope{"post": newPostResponse(post)}, nil)
+	}
+}
+
+func (s *Server) PostDelete() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := hlog.FromRequest(r)
+		oinkName := chi.URLParam(r, "oinkName")
+		postID := chi.URLParam(r, "postID")
+
+		repo := repository.New(s.db, *logger)
+		if _, ok := s.retrieveVisibleOink(w, r, repo, oinkName); !ok {
+			return
+		}
+
+		post, err := repo.PostRepository.PostRetrieve(r.Context(), oinkName, postID)
+		if err != nil {
+			if errors.Is(err, repository.ErrOinkNotFound) || errors.Is(err, repository.ErrPostNotFound) {
+				s.writeJSON(w, http.StatusNotFound, envelope{"error": err.Error()}, nil)
+				return
+			}
+			logger.Error().Err(err).Msg("api-PostDelete-PostRetrieve")
+			s.writeJSON(w, http.StatusInternalServerError, envelope{"error": http.StatusText(http.StatusInternalServerError)}, nil)
+			return
+		}
+
+		requester, ok := r.Context().Value("user").(*repository.User)
+		if !ok {
+			s.writeJSON(w, http.StatusForbidden, envelope{"error": http.StatusText(http.StatusForbidden)}, nil)
+			return
+		}
+
+		// Besides the author, the oink's owner and moderators may remove posts.
+		if requester.ID != post.AuthorID {
+			allowed, err := s.authorizeOink(r, repo, oinkName, repository.OinkRoleOwner, repository.OinkRoleModerator)
+			if err != nil {
+				logger.Error().Err(err).Msg("api-PostDelete-authorizeOink")
+				s.writeJSON(w, http.StatusInternalServerError, envelope{"error": http.StatusText(http.StatusInternalServerError)}, nil)
+				return
+			}
+			if !allowed {
+				s.writeJSON(w, http.StatusForbidden, envelope{"error": http.StatusText(http.StatusForbidden)}, nil)
+				return
+			}
+		}
+
+		tx, err := s.db.Begin()
+		if err != nil {
+			logger.Error().Err(err).Msg("error creating transaction")
+			s.writeJSON(w, http.StatusInternalServerError, envelope{"error": http.StatusText(http.StatusInternalServerError)}, nil)
+			return
+		}
+
+		txRepo := repository.New(tx, *logger)
+		post, err = txRepo.PostRepository.PostLock(r.Context(), oinkName, postID)
+		if err == nil && !s.ifMatch(w, r, postETag(post)) {
+			if rollback := tx.Rollback(); rollback != nil {
+				logger.Error().Err(rollback).Msg("api-PostDelete-ifMatch-RollbackError")
+			}
+			return
+		}
+		if err == nil {
+			err = txRepo.PostRepository.PostDelete(r.Context(), oinkName, postID)
+		}
+		if err != nil {
+			if rollback := tx.Rollback(); rollback != nil {
+				logger.Error().Err(err).Msg("api-PostDelete-PostDelete-RollbackError")
+				s.writeJSON(w, http.StatusInternalServerError, envelope{"error": http.StatusText(http.StatusInternalServerError)}, nil)
+				return
+			}
+			if errors.Is(err, repository.ErrOinkNotFound) || errors.Is(err, repository.ErrPostNotFound) {
+				s.writeJSON(w, http.StatusNotFound, envelope{"error": err.Error()}, nil)
+				return
+			}
+			if errors.Is(err, repository.ErrOinkArchived) {
+				s.writeJSON(w, http.StatusConflict, envelope{"error": err.Error()}, nil)
+				return
+			}
+			logger.Error().Err(err).Msg("api-PostDelete-PostDelete")
+			s.writeJSON(w, http.StatusInternalServerError, envelope{"error": http.StatusText(http.StatusInternalServerError)}, nil)
+			return
+		}
+
+		if err := tx.Commit(); err != nil {
+			logger.Error().Err(err).Msg("api-PostDelete-PostDelete-CommitError")
+			s.writeJSON(w, http.StatusInternalServerError, envelope{"error": http.StatusText(http.StatusInternalServerError)}, nil)
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
@@ -40,24 +40,61 @@ func (s *Server) routes() http.Handler {
 	r.Use(s.AddUserCtx())
 
 	r.Route("/api/v1", func(r chi.Router) {
+		r.Get("/openapi.json", s.OpenAPI())
+		r.Get("/blobs/*", s.BlobServe())
 		r.Group(func(unauthorizedOnlyRouter chi.Router) {
 			unauthorizedOnlyRouter.Use(s.UnauthorizedGuard)
 			unauthorizedOnlyRouter.Post("/auth/login", s.AuthLogin())
 		})
 		r.Group(func(authorizedOnlyRouter chi.Router) {
 			authorizedOnlyRouter.Use(s.AuthorizedGuard)
+			authorizedOnlyRouter.Use(s.Idempotent)
 
 			authorizedOnlyRouter.Get("/users", s.UserList())
 			authorizedOnlyRouter.Post("/users", s.UserCreate())
+			authorizedOnlyRouter.Post("/users/bulk", s.UserBulkCreate())
+			authorizedOnlyRouter.Get("/users/search", s.UserSearch())
 
 			authorizedOnlyRouter.Get("/users/{userID}", s.UserRetrieve())
 			authorizedOnlyRouter.Delete("/users/{userID}", s.UserDelete())
 			authorizedOnlyRouter.Post("/users/{userID}/password", s.UserUpdatePassword())
+			authorizedOnlyRouter.Put("/users/{userID}/avatar", s.UserAvatarUpload())
+			authorizedOnlyRouter.Delete("/users/{userID}/avatar", s.UserAvatarDelete())
+			authorizedOnlyRouter.Get("/users/{userID}/oinks", s.UserOinkList())
+			authorizedOnlyRouter.Get("/users/{userID}/export", s.UserExport())
+			authorizedOnlyRouter.Get("/users/{userID}/export/{exportID}", s.UserExportDownload())
 
 			authorizedOnlyRouter.Get("/oinks", s.OinkList())
 			authorizedOnlyRouter.Post("/oinks", s.OinkInsert())
+			authorizedOnlyRouter.Get("/oinks/search", s.OinkSearch())
 			authorizedOnlyRouter.Get("/oinks/{oinkName}", s.OinkRetrieve())
+			authorizedOnlyRouter.Patch("/oinks/{oinkName}", s.OinkUpdate())
 			authorizedOnlyRouter.Delete("/oinks/{oinkName}", s.OinkDelete())
+			authorizedOnlyRouter.Post("/oinks/{oinkName}/archive", s.OinkArchive())
+			authorizedOnlyRouter.Delete("/oinks/{oinkName}/archive", s.OinkUnarchive())
+			authorizedOnlyRouter.Get("/oinks/{oinkName}/stats", s.OinkStats())
+			authorizedOnlyRouter.Put("/oinks/{oinkName}/avatar", s.OinkAvatarUpload())
+			authorizedOnlyRouter.Delete("/oinks/{oinkName}/avatar", s.OinkAvatarDelete())
+			authorizedOnlyRouter.Put("/oinks/{oinkName}/banner", s.OinkBannerUpload())
+			authorizedOnlyRouter.Delete("/oinks/{oinkName}/banner", s.OinkBannerDelete())
+			authorizedOnlyRouter.Get("/oinks/{oinkName}/members", s.OinkMemberList())
+			authorizedOnlyRouter.Post("/oinks/{oinkName}/members/me", s.OinkMemberJoin())
+			authorizedOnlyRouter.Delete("/oinks/{oinkName}/members/me", s.OinkMemberLeave())
+			authorizedOnlyRouter.Get("/oinks/{oinkName}/invitations", s.OinkInvitationList())
+			authorizedOnlyRouter.Post("/oinks/{oinkName}/invitations", s.OinkInvite())
+			authorizedOnlyRouter.Delete("/oinks/{oinkName}/invitations/{userID}", s.OinkInvitationDelete())
+			authorizedOnlyRouter.Post("/oinks/{oinkName}/invitations/{userID}/approve", s.OinkRequestApprove())
+			authorizedOnlyRouter.Put("/oinks/{oinkName}/moderators/{userID}", s.OinkModeratorGrant())
+			authorizedOnlyRouter.Delete("/oinks/{oinkName}/moderators/{userID}", s.OinkModeratorRevoke())
+			authorizedOnlyRouter.Post("/oinks/{oinkName}/transfer", s.OinkTransferOffer())
+			authorizedOnlyRouter.Delete("/oinks/{oinkName}/transfer", s.OinkTransferCancel())
+			authorizedOnlyRouter.Post("/oinks/{oinkName}/transfer/accept", s.OinkTransferAccept())
+			authorizedOnlyRouter.Get("/oinks/{oinkName}/posts", s.PostList())
+			authorizedOnlyRouter.Post("/oinks/{oinkName}/posts", s.PostInsert())
+			authorizedOnlyRouter.Get("/oinks/{oinkName}/posts/{postID}", s.PostRetrieve())
+			authorizedOnlyRouter.Patch("/oinks/{oinkName}/posts/{postID}", s.PostUpdate())
+			authorizedOnlyRouter.Delete("/oinks/{oinkName}/posts/{postID}", s.PostDelete())
+			authorizedOnlyRouter.Get("/tags", s.TagList())
 			authorizedOnlyRouter.Get("/auth/me", s.AuthMe())
 		})
 	})
@@ -8,32 +8,97 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"path/filepath"
 	"sync"
 	"syscall"
 	"time"
 
 	"github.com/alexliesenfeld/health"
+	"github.com/mrityunjaygr8/go-oink/internal/blob"
 	"github.com/rs/zerolog"
 )
 
 type Server struct {
-	db     *sql.DB
-	l      zerolog.Logger
-	config ServerConf
-	wg     sync.WaitGroup
-	health health.Checker
+	db      *sql.DB
+	l       zerolog.Logger
+	config  ServerConf
+	wg      sync.WaitGroup
+	health  health.Checker
+	exports *exportStore
+	stats   *statsCache
 }
 
 type ServerConf struct {
 	Addr string
 	Port int
+
+	// SearchLanguage is the text search language used when a search does not
+	// ask for one.
+	SearchLanguage string
+
+	// Blobs stores uploaded images. When nil, they are kept in data/blobs
+	// under the working directory and served by the API.
+	Blobs blob.Store
+	// ExportDir is where personal data exports are written, data/exports
+	// under the working directory when empty.
+	ExportDir string
+	// UploadMaxBytes bounds the size of a single upload.
+	UploadMaxBytes int64
+
+	// PageDefaultLimit is how many rows cursor paginated listings return when
+	// the client does not ask for a limit, PageMaxLimit the most they return.
+	PageDefaultLimit int
+	PageMaxLimit     int
+
+	// IdempotencyTTL is how long the response to a request made with an
+	// Idempotency-Key is kept for retries.
+	IdempotencyTTL time.Duration
 }
 
+const (
+	defaultUploadMaxBytes   = 5 << 20
+	defaultPageDefaultLimit = 50
+	defaultPageMaxLimit     = 200
+	defaultIdempotencyTTL   = 24 * time.Hour
+)
+
+// The directories uploads and exports are kept in when none are configured.
+// They are relative to the working directory, so they outlive restarts,
+// unlike the system's temporary directory.
+var (
+	defaultBlobDir   = filepath.Join("data", "blobs")
+	defaultExportDir = filepath.Join("data", "exports")
+)
+
 func NewServer(logger zerolog.Logger, db *sql.DB, srvConf ServerConf) *Server {
+	if srvConf.Blobs == nil {
+		srvConf.Blobs = blob.NewLocalStore(defaultBlobDir, "/api/v1/blobs")
+	}
+	if srvConf.ExportDir == "" {
+		srvConf.ExportDir = defaultExportDir
+	}
+	if srvConf.UploadMaxBytes <= 0 {
+		srvConf.UploadMaxBytes = defaultUploadMaxBytes
+	}
+	if srvConf.PageMaxLimit <= 0 {
+		srvConf.PageMaxLimit = defaultPageMaxLimit
+	}
+	if srvConf.PageDefaultLimit <= 0 {
+		srvConf.PageDefaultLimit = defaultPageDefaultLimit
+	}
+	if srvConf.PageDefaultLimit > srvConf.PageMaxLimit {
+		srvConf.PageDefaultLimit = srvConf.PageMaxLimit
+	}
+	if srvConf.IdempotencyTTL <= 0 {
+		srvConf.IdempotencyTTL = defaultIdempotencyTTL
+	}
+
 	a := &Server{
-		l:      logger,
-		db:     db,
-		config: srvConf,
+		l:       logger,
+		db:      db,
+		config:  srvConf,
+		exports: newExportStore(srvConf.ExportDir),
+		stats:   newStatsCache(),
 	}
 
 	return a
@@ -87,6 +152,10 @@ func (s *Server) Serve() error {
 		// ErrorLog:     log.New(a.logger, "", 0),
 	}
 
+	sweepCtx, stopSweeping := context.WithCancel(context.Background())
+	defer stopSweeping()
+	s.background(func() { s.sweepExports(sweepCtx) })
+
 	go func() {
 		quit := make(chan os.Signal, 1)
 
@@ -106,6 +175,7 @@ func (s *Server) Serve() error {
 
 		s.l.Info().Str("addr", srv.Addr).Msg("completing background tasks")
 
+		stopSweeping()
 		s.wg.Wait()
 		shutdownError <- nil
 
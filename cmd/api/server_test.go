@@ -1,7 +1,69 @@
 package main
 
-// import "testing"
-//
-// func TestServer(t testing.T) {
-//
-// }
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-chi/chi/v5"
+	"github.com/rs/zerolog"
+)
+
+var errDB = errors.New("connection reset by peer")
+
+func newTestServer(t *testing.T) (*Server, sqlmock.Sqlmock) {
+	t.Helper()
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("sqlmock.New: %v", err)
+	}
+	t.Cleanup(func() {
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Errorf("unmet sql expectations: %v", err)
+		}
+		db.Close()
+	})
+
+	return NewServer(zerolog.Nop(), db, ServerConf{}), mock
+}
+
+// serve routes a single request to handler mounted at pattern and returns
+// the recorded response.
+func serve(method, pattern, target string, handler http.HandlerFunc) *httptest.ResponseRecorder {
+	return serveRequest(pattern, httptest.NewRequest(method, target, nil), handler)
+}
+
+// serveRequest is serve for requests that need more than a method and a
+// target, such as headers.
+func serveRequest(pattern string, req *http.Request, handler http.HandlerFunc) *httptest.ResponseRecorder {
+	r := chi.NewRouter()
+	r.Method(req.Method, pattern, handler)
+
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, req)
+
+	return rec
+}
+
+func TestCheckWritableDir(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "data", "blobs")
+	if err := checkWritableDir(dir, 0o755); err != nil {
+		t.Fatalf("checkWritableDir of a missing directory: %v", err)
+	}
+	if entries, err := os.ReadDir(dir); err != nil || len(entries) != 0 {
+		t.Fatalf("checkWritableDir left %v behind (%v)", entries, err)
+	}
+
+	file := filepath.Join(t.TempDir(), "file")
+	if err := os.WriteFile(file, nil, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := checkWritableDir(filepath.Join(file, "blobs"), 0o755); err == nil {
+		t.Fatal("checkWritableDir succeeded below a file")
+	}
+}
@@ -0,0 +1,225 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog/hlog"
+)
+
+// The media types listings are offered in.
+const (
+	contentTypeJSON   = "application/json"
+	contentTypeNDJSON = "application/x-ndjson"
+	contentTypeCSV    = "text/csv"
+)
+
+const (
+	// streamFlushRows is how many rows of a streamed listing are written
+	// between two flushes.
+	streamFlushRows = 100
+	// streamWriteTimeout bounds the time between two flushes. It stands in
+	// for the server's write timeout, which would cut long exports off.
+	streamWriteTimeout = 30 * time.Second
+)
+
+// negotiate returns the one of offers the Accept header of r ranks highest.
+// Offers are listed in order of preference, which breaks ties, and the first
+// one is returned when the client accepts none of them.
+func negotiate(r *http.Request, offers ...string) string {
+	type mediaRange struct {
+		mediaType string
+		q         float64
+	}
+
+	var ranges []mediaRange
+	for _, part := range strings.Split(r.Header.Get("Accept"), ",") {
+		mediaType, params, err := mime.ParseMediaType(strings.TrimSpace(part))
+		if err != nil {
+			continue
+		}
+
+		q := 1.0
+		if value, ok := params["q"]; ok {
+			if q, err = strconv.ParseFloat(value, 64); err != nil {
+				continue
+			}
+		}
+		ranges = append(ranges, mediaRange{mediaType: mediaType, q: q})
+	}
+
+	best, bestQ := offers[0], 0.0
+	for _, offer := range offers {
+		// the most specific range matching the offer decides its quality
+		q, specificity := 0.0, -1
+		for _, rng := range ranges {
+			matched := -1
+			switch {
+			case rng.mediaType == offer:
+				matched = 2
+			case strings.HasSuffix(rng.mediaType, "/*") && strings.HasPrefix(offer, strings.TrimSuffix(rng.mediaType, "*")):
+				matched = 1
+			case rng.mediaType == "*/*":
+				matched = 0
+			}
+			if matched > specificity {
+				q, specificity = rng.q, matched
+			}
+		}
+
+		if q > bestQ {
+			best, bestQ = offer, q
+		}
+	}
+
+	return best
+}
+
+// rowWriter writes the rows of a streamed listing as newline delimited JSON
+// or as CSV, flushing them to the client every streamFlushRows rows.
+type rowWriter struct {
+	rc      *http.ResponseController
+	out     *sentWriter
+	json    *json.Encoder
+	csv     *csv.Writer
+	pending int
+}
+
+// sentWriter records whether anything was written through it.
+type sentWriter struct {
+	w    io.Writer
+	sent bool
+}
+
+func (sw *sentWriter) Write(p []byte) (int, error) {
+	sw.sent = true
+	return sw.w.Write(p)
+}
+
+// newRowWriter starts a streamed response of contentType. header names the
+// columns of CSV records and is written as their first line.
+func newRowWriter(w http.ResponseWriter, contentType string, header []string) (*rowWriter, error) {
+	rw := &rowWriter{rc: http.NewResponseController(w), out: &sentWriter{w: w}}
+
+	switch contentType {
+	case contentTypeCSV:
+		w.Header().Set("Content-Type", contentTypeCSV+"; charset=utf-8")
+		rw.csv = csv.NewWriter(rw.out)
+		if err := rw.csv.Write(header); err != nil {
+			return nil, err
+		}
+	default:
+		w.Header().Set("Content-Type", contentTypeNDJSON)
+		rw.json = json.NewEncoder(rw.out)
+	}
+
+	if err := rw.extendDeadline(); err != nil {
+		return nil, err
+	}
+
+	return rw, nil
+}
+
+// Write adds a row, given both as the object it is encoded as in JSON and as
+// the fields of its CSV record.
+func (rw *rowWriter) Write(object interface{}, record []string) error {
+	var err error
+	if rw.csv != nil {
+		err = rw.csv.Write(record)
+	} else {
+		err = rw.json.Encode(object)
+	}
+	if err != nil {
+		return err
+	}
+
+	rw.pending++
+	if rw.pending < streamFlushRows {
+		return nil
+	}
+	return rw.Flush()
+}
+
+// Flush sends the rows written so far to the client.
+func (rw *rowWriter) Flush() error {
+	if rw.csv != nil {
+		rw.csv.Flush()
+		if err := rw.csv.Error(); err != nil {
+			return err
+		}
+	}
+
+	if err := rw.rc.Flush(); err != nil && !errors.Is(err, http.ErrNotSupported) {
+		return err
+	}
+
+	rw.pending = 0
+	return rw.extendDeadline()
+}
+
+func (rw *rowWriter) extendDeadline() error {
+	err := rw.rc.SetWriteDeadline(time.Now().Add(streamWriteTimeout))
+	if err != nil && !errors.Is(err, http.ErrNotSupported) {
+		return err
+	}
+	return nil
+}
+
+// streamRows answers r with a listing streamed as contentType. stream reads
+// the rows and hands each of them to write, which stops it with an error when
+// the client is gone.
+//
+// An error that comes up before anything was sent is returned, for the
+// handler to answer as usual. Later ones cannot be answered with a status, so
+// the connection is aborted instead, which leaves the client with a response
+// it can tell is incomplete.
+func (s *Server) streamRows(w http.ResponseWriter, r *http.Request, contentType string, header []string, stream func(write func(object interface{}, record []string) error) error) error {
+	logger := hlog.FromRequest(r)
+
+	rw, err := newRowWriter(w, contentType, header)
+	if err != nil {
+		return err
+	}
+
+	err = stream(rw.Write)
+	if err == nil {
+		err = rw.Flush()
+	}
+	if err == nil {
+		return nil
+	}
+
+	if r.Context().Err() != nil {
+		logger.Info().Err(err).Msg("api-streamRows-clientGone")
+		return nil
+	}
+	if !rw.out.sent {
+		return err
+	}
+
+	logger.Error().Err(err).Msg("api-streamRows-stream")
+	panic(http.ErrAbortHandler)
+}
+
+// csvTime formats t for a CSV record, leaving zero times empty.
+func csvTime(t *time.Time) string {
+	if t == nil || t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339Nano)
+}
+
+// csvImageURL returns the URL of image for a CSV record.
+func csvImageURL(image *imageURLs) string {
+	if image == nil {
+		return ""
+	}
+	return image.URL
+}
@@ -0,0 +1,141 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/mrityunjaygr8/go-oink/internal/repository"
+)
+
+func TestNegotiate(t *testing.T) {
+	offers := []string{contentTypeJSON, contentTypeNDJSON, contentTypeCSV}
+	tests := map[string]string{
+		"":                                 contentTypeJSON,
+		"*/*":                              contentTypeJSON,
+		"text/csv":                         contentTypeCSV,
+		"text/*":                           contentTypeCSV,
+		"application/x-ndjson":             contentTypeNDJSON,
+		"text/csv;q=0.5, application/*":    contentTypeJSON,
+		"text/csv, application/json;q=0.9": contentTypeCSV,
+		"*/*, application/json;q=0":        contentTypeNDJSON,
+		"image/png":                        contentTypeJSON,
+		"text/csv;q=nope":                  contentTypeJSON,
+	}
+	for accept, want := range tests {
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+		r.Header.Set("Accept", accept)
+		if got := negotiate(r, offers...); got != want {
+			t.Errorf("negotiate(%q) = %q, want %q", accept, got, want)
+		}
+	}
+}
+
+func TestUserListStreams(t *testing.T) {
+	created := time.Date(2023, 5, 1, 10, 0, 0, 0, time.UTC)
+	userRows := func() *sqlmock.Rows {
+		return sqlmock.NewRows([]string{"id", "email", "username", "created_at", "updated_at"}).
+			AddRow("id-1", "im@oink.in", "im", created, created).
+			AddRow("id-2", "oink@oink.in", "oink", created, created)
+	}
+	admin := &repository.User{ID: "admin-id", IsAdmin: true}
+	listAs := func(s *Server, accept string, requester *repository.User) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(http.MethodGet, "/users", nil)
+		r.Header.Set("Accept", accept)
+		r = r.WithContext(context.WithValue(r.Context(), "user", requester))
+		return serveRequest("/users", r, s.UserList())
+	}
+	list := func(s *Server, accept string) *httptest.ResponseRecorder {
+		return listAs(s, accept, admin)
+	}
+
+	t.Run("not-admin", func(t *testing.T) {
+		s, _ := newTestServer(t)
+		for _, accept := range []string{contentTypeNDJSON, contentTypeCSV} {
+			rec := listAs(s, accept, &repository.User{ID: "id-1"})
+			if rec.Code != http.StatusForbidden {
+				t.Fatalf("%s: got status %d, want %d", accept, rec.Code, http.StatusForbidden)
+			}
+		}
+	})
+
+	t.Run("ndjson", func(t *testing.T) {
+		s, mock := newTestServer(t)
+		mock.ExpectQuery(`(?i)from "users"`).WillReturnRows(userRows())
+
+		rec := list(s, contentTypeNDJSON)
+		if got := rec.Header().Get("Content-Type"); got != contentTypeNDJSON {
+			t.Fatalf("got content type %q, want %q", got, contentTypeNDJSON)
+		}
+		lines := strings.Split(strings.TrimSpace(rec.Body.String()), "\n")
+		if len(lines) != 2 || !strings.Contains(lines[1], `"username":"oink"`) {
+			t.Fatalf("got %q, want one user per line", rec.Body.String())
+		}
+	})
+
+	t.Run("csv", func(t *testing.T) {
+		s, mock := newTestServer(t)
+		mock.ExpectQuery(`(?i)from "users"`).WillReturnRows(userRows())
+
+		rec := list(s, "text/csv")
+		want := "id,email,username,avatar_url,created_at,updated_at\n" +
+			"id-1,im@oink.in,im,,2023-05-01T10:00:00Z,2023-05-01T10:00:00Z\n" +
+			"id-2,oink@oink.in,oink,,2023-05-01T10:00:00Z,2023-05-01T10:00:00Z\n"
+		if rec.Body.String() != want {
+			t.Fatalf("got %q, want %q", rec.Body.String(), want)
+		}
+	})
+
+	t.Run("db-error-before-rows", func(t *testing.T) {
+		s, mock := newTestServer(t)
+		mock.ExpectQuery(`(?i)from "users"`).WillReturnError(errDB)
+
+		rec := list(s, contentTypeNDJSON)
+		if rec.Code != http.StatusInternalServerError {
+			t.Fatalf("got status %d, want %d", rec.Code, http.StatusInternalServerError)
+		}
+	})
+
+	t.Run("db-error-after-rows", func(t *testing.T) {
+		s, mock := newTestServer(t)
+		mock.ExpectQuery(`(?i)from "users"`).WillReturnRows(userRows().RowError(1, errDB))
+
+		defer func() {
+			if got := recover(); got != http.ErrAbortHandler {
+				t.Fatalf("got panic %v, want the response to be aborted", got)
+			}
+		}()
+		list(s, contentTypeNDJSON)
+	})
+}
+
+// cancellingWriter stands in for a client that goes away once the first
+// bytes reach it.
+type cancellingWriter struct {
+	*httptest.ResponseRecorder
+	cancel context.CancelFunc
+}
+
+func (cw cancellingWriter) Write(p []byte) (int, error) {
+	cw.cancel()
+	return cw.ResponseRecorder.Write(p)
+}
+
+func TestUserListStreamClientGone(t *testing.T) {
+	s, mock := newTestServer(t)
+	mock.ExpectQuery(`(?i)from "users"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("id-1").AddRow("id-2").RowError(1, context.Canceled))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ctx = context.WithValue(ctx, "user", &repository.User{ID: "admin-id", IsAdmin: true})
+	r := httptest.NewRequest(http.MethodGet, "/users", nil).WithContext(ctx)
+	r.Header.Set("Accept", contentTypeNDJSON)
+
+	// with the client gone the handler ends quietly instead of aborting
+	s.UserList()(cancellingWriter{ResponseRecorder: httptest.NewRecorder(), cancel: cancel}, r)
+}
@@ -0,0 +1,34 @@
+package main
+
+import (
+	"net/http"
+
+	"github.com/mrityunjaygr8/go-oink/internal/repository"
+	"github.com/rs/zerolog/hlog"
+)
+
+func (s *Server) TagList() http.HandlerFunc {
+	type Tag struct {
+		Name      string `json:"name"`
+		OinkCount int64  `json:"oink_count"`
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := hlog.FromRequest(r)
+		repo := repository.New(s.db, *logger)
+
+		t, err := repo.TagRepository.TagList(r.Context())
+		if err != nil {
+			logger.Error().Err(err).Msg("api-TagList-TagList")
+			s.writeJSON(w, http.StatusInternalServerError, envelope{"error": http.StatusText(http.StatusInternalServerError)}, nil)
+			return
+		}
+
+		tags := make([]Tag, 0)
+		for _, tag := range *t {
+			tags = append(tags, Tag{Name: tag.Name, OinkCount: tag.OinkCount})
+		}
+
+		s.writeJSON(w, http.StatusOK, envelope{"tags": tags}, nil)
+	}
+}
@@ -2,31 +2,72 @@ package main
 
 import (
 	"errors"
+	"fmt"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/mrityunjaygr8/go-oink/internal/repository"
+	"github.com/mrityunjaygr8/go-oink/internal/validator"
 	"github.com/rs/zerolog/hlog"
 )
 
+// UserList returns a page of users. Clients accepting application/x-ndjson
+// or text/csv are streamed every matching user from the cursor on instead;
+// as that dumps every email address, only admins may ask for it.
 func (s *Server) UserList() http.HandlerFunc {
 	type User struct {
-		Email     string    `json:"email"`
-		Password  string    `json:"-"`
-		Username  string    `json:"username"`
-		ID        string    `json:"id"`
-		CreatedAt time.Time `json:"created_at"`
-		UpdatedAt time.Time `json:"updated_at,omitempty"`
+		Email     string     `json:"email"`
+		Password  string     `json:"-"`
+		Username  string     `json:"username"`
+		ID        string     `json:"id"`
+		Avatar    *imageURLs `json:"avatar"`
+		CreatedAt time.Time  `json:"created_at"`
+		UpdatedAt time.Time  `json:"updated_at,omitempty"`
 	}
 	type response struct {
 		Users []User `json:"users"`
 	}
 	return func(w http.ResponseWriter, r *http.Request) {
 		logger := hlog.FromRequest(r)
+
+		q, limit, err := s.readListQuery(r.URL.Query(), repository.UserListSpec)
+		if err != nil {
+			s.writeJSON(w, http.StatusBadRequest, envelope{"error": err.Error()}, nil)
+			return
+		}
+
 		repo := repository.New(s.db, *logger)
-		u, err := repo.UserRepository.UsersList(r.Context())
+		w.Header().Add("Vary", "Accept")
+		if contentType := negotiate(r, contentTypeJSON, contentTypeNDJSON, contentTypeCSV); contentType != contentTypeJSON {
+			if requester, ok := r.Context().Value("user").(*repository.User); !ok || !requester.IsAdmin {
+				s.writeJSON(w, http.StatusForbidden, envelope{"error": http.StatusText(http.StatusForbidden)}, nil)
+				return
+			}
+
+			header := []string{"id", "email", "username", "avatar_url", "created_at", "updated_at"}
+			err := s.streamRows(w, r, contentType, header, func(write func(interface{}, []string) error) error {
+				return repo.UserRepository.UsersStream(r.Context(), q, func(user *repository.User) error {
+					avatar := s.imageURLs(user.AvatarKey)
+					return write(User{
+						Email:     user.Email,
+						Username:  user.Username,
+						ID:        user.ID,
+						Avatar:    avatar,
+						CreatedAt: user.CreatedAt,
+						UpdatedAt: user.UpdatedAt,
+					}, []string{user.ID, user.Email, user.Username, csvImageURL(avatar), csvTime(&user.CreatedAt), csvTime(&user.UpdatedAt)})
+				})
+			})
+			if err != nil {
+				logger.Error().Err(err).Msg("api-UserList-Stream")
+				s.writeJSON(w, http.StatusInternalServerError, envelope{"error": http.StatusText(http.StatusInternalServerError)}, nil)
+			}
+			return
+		}
 
+		u, next, err := repo.UserRepository.UsersList(r.Context(), q, limit)
 		if err != nil {
 			logger.Error().Err(err).Msg("api-UserList-List")
 			s.writeJSON(w, http.StatusInternalServerError, envelope{"error": http.StatusText(http.StatusInternalServerError)}, nil)
@@ -39,11 +80,82 @@ func (s *Server) UserList() http.HandlerFunc {
 				Email:     user.Email,
 				Username:  user.Username,
 				ID:        user.ID,
+				Avatar:    s.imageURLs(user.AvatarKey),
 				CreatedAt: user.CreatedAt,
 				UpdatedAt: user.UpdatedAt,
 			})
 		}
 
+		s.setNextLink(w, r, next)
+		s.writeJSON(w, http.StatusOK, envelope{"users": users, "metadata": cursorMetadata{Limit: limit, NextCursor: next}}, nil)
+	}
+}
+
+func (s *Server) UserSearch() http.HandlerFunc {
+	const (
+		defaultLimit = 10
+		maxLimit     = 50
+	)
+
+	type User struct {
+		Email     string     `json:"email,omitempty"`
+		Username  string     `json:"username"`
+		ID        string     `json:"id"`
+		Avatar    *imageURLs `json:"avatar"`
+		CreatedAt time.Time  `json:"created_at"`
+		UpdatedAt time.Time  `json:"updated_at,omitempty"`
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := hlog.FromRequest(r)
+
+		qs := r.URL.Query()
+		query := strings.TrimSpace(qs.Get("q"))
+		if query == "" {
+			s.writeJSON(w, http.StatusBadRequest, envelope{"error": "q must be provided"}, nil)
+			return
+		}
+
+		limit, err := s.readInt(qs, "limit", defaultLimit)
+		if err != nil {
+			s.writeJSON(w, http.StatusBadRequest, envelope{"error": err.Error()}, nil)
+			return
+		}
+		if limit < 1 || limit > maxLimit {
+			s.writeJSON(w, http.StatusBadRequest, envelope{"error": fmt.Sprintf("limit must be between 1 and %d", maxLimit)}, nil)
+			return
+		}
+
+		requester, ok := r.Context().Value("user").(*repository.User)
+		if !ok {
+			logger.Error().Any("User", r.Context().Value("user")).Msg("api-UserSearch-userTypeAssertion")
+			s.writeJSON(w, http.StatusInternalServerError, envelope{"error": http.StatusText(http.StatusInternalServerError)}, nil)
+			return
+		}
+
+		repo := repository.New(s.db, *logger)
+		u, err := repo.UserRepository.UsersSearch(r.Context(), query, limit)
+		if err != nil {
+			logger.Error().Err(err).Msg("api-UserSearch-UsersSearch")
+			s.writeJSON(w, http.StatusInternalServerError, envelope{"error": http.StatusText(http.StatusInternalServerError)}, nil)
+			return
+		}
+
+		users := make([]User, 0)
+		for _, user := range *u {
+			res := User{
+				Username:  user.Username,
+				ID:        user.ID,
+				Avatar:    s.imageURLs(user.AvatarKey),
+				CreatedAt: user.CreatedAt,
+				UpdatedAt: user.UpdatedAt,
+			}
+			if requester.IsAdmin {
+				res.Email = user.Email
+			}
+			users = append(users, res)
+		}
+
 		s.writeJSON(w, http.StatusOK, envelope{"users": users}, nil)
 	}
 }
@@ -56,11 +168,12 @@ func (s *Server) UserCreate() http.HandlerFunc {
 	}
 
 	type response struct {
-		Email     string    `json:"email"`
-		Username  string    `json:"username"`
-		ID        string    `json:"id"`
-		CreatedAt time.Time `json:"created_at"`
-		UpdatedAt time.Time `json:"updated_at"`
+		Email     string     `json:"email"`
+		Username  string     `json:"username"`
+		ID        string     `json:"id"`
+		Avatar    *imageURLs `json:"avatar"`
+		CreatedAt time.Time  `json:"created_at"`
+		UpdatedAt time.Time  `json:"updated_at"`
 	}
 
 	return func(w http.ResponseWriter, r *http.Request) {
@@ -73,6 +186,15 @@ func (s *Server) UserCreate() http.HandlerFunc {
 			return
 		}
 
+		v := validator.New()
+		validator.ValidateEmail(v, "email", req.Email)
+		validator.ValidateUsername(v, "username", req.Username)
+		validator.ValidatePassword(v, "password", req.Password)
+		if !v.Valid() {
+			s.failedValidationResponse(w, v.Errors)
+			return
+		}
+
 		tx, err := s.db.Begin()
 		if err != nil {
 			logger.Error().Err(err).Msg("error creating transaction")
@@ -113,6 +235,7 @@ func (s *Server) UserCreate() http.HandlerFunc {
 			Email:     user.Email,
 			Username:  user.Username,
 			ID:        user.ID,
+			Avatar:    s.imageURLs(user.AvatarKey),
 			CreatedAt: user.CreatedAt,
 			UpdatedAt: user.UpdatedAt,
 		}
@@ -123,11 +246,12 @@ func (s *Server) UserCreate() http.HandlerFunc {
 
 func (s *Server) UserRetrieve() http.HandlerFunc {
 	type response struct {
-		Email     string    `json:"email"`
-		Username  string    `json:"username"`
-		ID        string    `json:"id"`
-		CreatedAt time.Time `json:"created_at"`
-		UpdatedAt time.Time `json:"updated_at"`
+		Email     string     `json:"email"`
+		Username  string     `json:"username"`
+		ID        string     `json:"id"`
+		Avatar    *imageURLs `json:"avatar"`
+		CreatedAt time.Time  `json:"created_at"`
+		UpdatedAt time.Time  `json:"updated_at"`
 	}
 
 	return func(w http.ResponseWriter, r *http.Request) {
@@ -136,8 +260,16 @@ func (s *Server) UserRetrieve() http.HandlerFunc {
 		repo := repository.New(s.db, *logger)
 		user, err := repo.UserRepository.UserRetrieve(r.Context(), userID)
 		if err != nil {
+			if errors.Is(err, repository.ErrUserNotFound) {
+				s.writeJSON(w, http.StatusNotFound, envelope{"error": err.Error()}, nil)
+				return
+			}
 			logger.Error().Err(err).Msg("api-UserRetrieve-UserRetrieve")
-			s.writeJSON(w, http.StatusNotFound, envelope{"error": err.Error()}, nil)
+			s.writeJSON(w, http.StatusInternalServerError, envelope{"error": http.StatusText(http.StatusInternalServerError)}, nil)
+			return
+		}
+
+		if s.notModified(w, r, userETag(user)) {
 			return
 		}
 
@@ -145,6 +277,7 @@ func (s *Server) UserRetrieve() http.HandlerFunc {
 			Email:     user.Email,
 			Username:  user.Username,
 			ID:        user.ID,
+			Avatar:    s.imageURLs(user.AvatarKey),
 			CreatedAt: user.CreatedAt,
 			UpdatedAt: user.UpdatedAt,
 		}
@@ -153,6 +286,84 @@ func (s *Server) UserRetrieve() http.HandlerFunc {
 	}
 }
 
+func (s *Server) UserOinkList() http.HandlerFunc {
+	type Oink struct {
+		Name        string     `json:"name"`
+		Description string     `json:"description"`
+		ID          string     `json:"id"`
+		CreatorID   string     `json:"creator_id"`
+		Visibility  string     `json:"visibility"`
+		Tags        []string   `json:"tags"`
+		Avatar      *imageURLs `json:"avatar"`
+		Banner      *imageURLs `json:"banner"`
+		MemberCount int64      `json:"member_count"`
+		CreatedAt   time.Time  `json:"created_at"`
+		UpdatedAt   time.Time  `json:"updated_at,omitempty"`
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		userID := chi.URLParam(r, "userID")
+		logger := hlog.FromRequest(r)
+		repo := repository.New(s.db, *logger)
+
+		_, err := repo.UserRepository.UserRetrieve(r.Context(), userID)
+		if err != nil {
+			if errors.Is(err, repository.ErrUserNotFound) {
+				s.writeJSON(w, http.StatusNotFound, envelope{"error": err.Error()}, nil)
+				return
+			}
+			logger.Error().Err(err).Msg("api-UserOinkList-UserRetrieve")
+			s.writeJSON(w, http.StatusInternalServerError, envelope{"error": http.StatusText(http.StatusInternalServerError)}, nil)
+			return
+		}
+
+		o, err := repo.OinkRepository.OinkListByMember(r.Context(), userID)
+		if err != nil {
+			logger.Error().Err(err).Msg("api-UserOinkList-OinkListByMember")
+			s.writeJSON(w, http.StatusInternalServerError, envelope{"error": http.StatusText(http.StatusInternalServerError)}, nil)
+			return
+		}
+
+		// private oinks are only shown to those who are members themselves
+		requester, _ := r.Context().Value("user").(*repository.User)
+		seesAll := requester != nil && (requester.IsAdmin || requester.ID == userID)
+		shared := make(map[string]bool)
+		if !seesAll && requester != nil {
+			own, err := repo.OinkRepository.OinkListByMember(r.Context(), requester.ID)
+			if err != nil {
+				logger.Error().Err(err).Msg("api-UserOinkList-OinkListByMember-requester")
+				s.writeJSON(w, http.StatusInternalServerError, envelope{"error": http.StatusText(http.StatusInternalServerError)}, nil)
+				return
+			}
+			for _, oink := range *own {
+				shared[oink.ID] = true
+			}
+		}
+
+		oinks := make([]Oink, 0)
+		for _, oink := range *o {
+			if oink.Visibility == repository.OinkVisibilityPrivate && !seesAll && !shared[oink.ID] {
+				continue
+			}
+			oinks = append(oinks, Oink{
+				Name:        oink.Name,
+				Description: oink.Description,
+				ID:          oink.ID,
+				CreatorID:   oink.CreatorID,
+				Visibility:  oink.Visibility,
+				Tags:        oink.Tags,
+				Avatar:      s.imageURLs(oink.AvatarKey),
+				Banner:      s.imageURLs(oink.BannerKey),
+				MemberCount: oink.MemberCount,
+				CreatedAt:   oink.CreatedAt,
+				UpdatedAt:   oink.UpdatedAt,
+			})
+		}
+
+		s.writeJSON(w, http.StatusOK, envelope{"oinks": oinks}, nil)
+	}
+}
+
 func (s *Server) UserUpdatePassword() http.HandlerFunc {
 	type request struct {
 		Password string `json:"password"`
@@ -165,7 +376,17 @@ func (s *Server) UserUpdatePassword() http.HandlerFunc {
 		err := s.readJSON(w, r, &req)
 		if err != nil {
 			logger.Error().Err(err).Msg("api-UserUpdatePassword-readJson")
+			s.writeJSON(w, http.StatusBadRequest, envelope{"error": err.Error()}, nil)
+			return
+		}
+
+		v := validator.New()
+		validator.ValidatePassword(v, "password", req.Password)
+		if !v.Valid() {
+			s.failedValidationResponse(w, v.Errors)
+			return
 		}
+
 		userID := chi.URLParam(r, "userID")
 		repo := repository.New(s.db, *logger)
 
@@ -175,6 +396,10 @@ func (s *Server) UserUpdatePassword() http.HandlerFunc {
 				s.writeJSON(w, http.StatusNotFound, envelope{"error": err.Error()}, nil)
 				return
 			}
+			if errors.Is(err, repository.ErrUserGhost) {
+				s.writeJSON(w, http.StatusBadRequest, envelope{"error": err.Error()}, nil)
+				return
+			}
 			logger.Error().Err(err).Msg("api-UserUpdatePassword-UserUpdatePassword")
 			s.writeJSON(w, http.StatusInternalServerError, envelope{"error": http.StatusText(http.StatusInternalServerError)}, nil)
 			return
@@ -185,18 +410,57 @@ func (s *Server) UserUpdatePassword() http.HandlerFunc {
 	}
 }
 
-// TODO!: on delete handler is needed for the user and token relationship
-// and maybe other future relations as well
+// UserDelete deletes a user. The strategy query parameter decides what
+// happens to the oinks they created or own: reassign them to the user named by
+// If-Match, when sent, must carry the ETag of the user.
+// If-Match must carry the ETag of the user.
 func (s *Server) UserDelete() http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		logger := hlog.FromRequest(r)
 		userID := chi.URLParam(r, "userID")
-		repo := repository.New(s.db, *logger)
+		qs := r.URL.Query()
+
+		strategy := repository.UserDeleteStrategy(qs.Get("strategy"))
+		if strategy == "" {
+			strategy = repository.UserDeleteGhost
+		}
+
+		if !canAccessUserData(r, userID) {
+			s.writeJSON(w, http.StatusForbidden, envelope{"error": http.StatusText(http.StatusForbidden)}, nil)
+			return
+		}
 
-		err := repo.UserRepository.UserDelete(r.Context(), userID)
+		tx, err := s.db.Begin()
+		if err != nil {
+			logger.Error().Err(err).Msg("error creating transaction")
+			s.writeJSON(w, http.StatusInternalServerError, envelope{"error": http.StatusText(http.StatusInternalServerError)}, nil)
+			return
+		}
+
+		repo := repository.New(tx, *logger)
+		user, err := repo.UserRepository.UserLock(r.Context(), userID)
+		if err == nil && !s.ifMatch(w, r, userETag(user)) {
+			if rollback := tx.Rollback(); rollback != nil {
+				logger.Error().Err(rollback).Msg("api-UserDelete-ifMatch-RollbackError")
+			}
+			return
+		}
+		if err == nil {
+			err = repo.UserRepository.UserDelete(r.Context(), userID, strategy, qs.Get("reassign_to"))
+		}
 		if err != nil {
+			if rollback := tx.Rollback(); rollback != nil {
+				logger.Error().Err(err).Msg("api-UserDelete-UserDelete-RollbackError")
+				s.writeJSON(w, http.StatusInternalServerError, envelope{"error": http.StatusText(http.StatusInternalServerError)}, nil)
+				return
+			}
 			if errors.Is(err, repository.ErrUserNotFound) {
-				s.writeJSON(w, http.StatusNotFound, envelope{"error": http.StatusText(http.StatusNotFound)}, nil)
+				s.writeJSON(w, http.StatusNotFound, envelope{"error": err.Error()}, nil)
+				return
+			}
+			if errors.Is(err, repository.ErrUserDeleteStrategyInvalid) || errors.Is(err, repository.ErrUserReassignTargetInvalid) ||
+				errors.Is(err, repository.ErrUserGhost) {
+				s.writeJSON(w, http.StatusBadRequest, envelope{"error": err.Error()}, nil)
 				return
 			}
 
@@ -205,6 +469,13 @@ func (s *Server) UserDelete() http.HandlerFunc {
 			return
 		}
 
+		if err := tx.Commit(); err != nil {
+			logger.Error().Err(err).Msg("api-UserDelete-UserDelete-CommitError")
+			s.writeJSON(w, http.StatusInternalServerError, envelope{"error": http.StatusText(http.StatusInternalServerError)}, nil)
+			return
+		}
+		s.exports.remove(userID)
+
 		s.writeJSON(w, http.StatusOK, envelope{"status": "User deleted successfully"}, nil)
 
 	}
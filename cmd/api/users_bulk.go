@@ -0,0 +1,447 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/mrityunjaygr8/go-oink/internal/repository"
+	"github.com/mrityunjaygr8/go-oink/internal/validator"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/hlog"
+)
+
+const (
+	// bulkUsersMax caps the rows of a bulk import. Every row costs a
+	// password hash, which is slow on purpose.
+	bulkUsersMax = 200
+	// bulkUsersUploadField is the form field CSV files are uploaded in.
+	bulkUsersUploadField = "file"
+	// temporaryPasswordBytes is how much randomness goes into a generated
+	// password, which comes out as 16 characters.
+	temporaryPasswordBytes = 12
+)
+
+// The modes a bulk import runs in. Atomic imports create every user or none
+// of them; per row imports create whichever users they can.
+const (
+	bulkModeAtomic = "atomic"
+	bulkModePerRow = "per_row"
+)
+
+// The outcomes of the rows of a bulk import.
+const (
+	bulkStatusCreated = "created"
+	bulkStatusInvalid = "invalid"
+	bulkStatusFailed  = "failed"
+	bulkStatusSkipped = "skipped"
+)
+
+type bulkUser struct {
+	Email    string `json:"email"`
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
+
+// bulkUserResult reports what became of a row of a bulk import. Rows are
+// numbered from 1, not counting the header of CSV files.
+type bulkUserResult struct {
+	Row               int                 `json:"row"`
+	Email             string              `json:"email"`
+	Username          string              `json:"username"`
+	Status            string              `json:"status"`
+	ID                string              `json:"id,omitempty"`
+	TemporaryPassword string              `json:"temporary_password,omitempty"`
+	Error             string              `json:"error,omitempty"`
+	Errors            map[string][]string `json:"errors,omitempty"`
+}
+
+type bulkUserMetadata struct {
+	Mode    string `json:"mode"`
+	Created int    `json:"created"`
+	Failed  int    `json:"failed"`
+}
+
+// UserBulkCreate creates the users listed in a JSON array or a CSV file, sent
+// as the body or uploaded in the file field of a multipart form. CSV files
+// start with a header naming the email, username and, optionally, password
+// columns. Only admins may import users.
+//
+// The mode query parameter is atomic (the default) or per_row. With
+// generate_passwords=true, rows without a password get a temporary one, which
+// is reported once along with the user: replays of the request made with an
+// Idempotency-Key leave the temporary passwords out, as they are not stored.
+// The response reports the outcome of every row.
+func (s *Server) UserBulkCreate() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		logger := hlog.FromRequest(r)
+		qs := r.URL.Query()
+
+		if u, ok := r.Context().Value("user").(*repository.User); !ok || !u.IsAdmin {
+			s.writeJSON(w, http.StatusForbidden, envelope{"error": http.StatusText(http.StatusForbidden)}, nil)
+			return
+		}
+
+		mode := qs.Get("mode")
+		if mode == "" {
+			mode = bulkModeAtomic
+		}
+		if mode != bulkModeAtomic && mode != bulkModePerRow {
+			s.writeJSON(w, http.StatusBadRequest, envelope{"error": "mode must be one of atomic or per_row"}, nil)
+			return
+		}
+
+		generatePasswords := false
+		if value := qs.Get("generate_passwords"); value != "" {
+			var err error
+			generatePasswords, err = strconv.ParseBool(value)
+			if err != nil {
+				s.writeJSON(w, http.StatusBadRequest, envelope{"error": "generate_passwords must be true or false"}, nil)
+				return
+			}
+		}
+
+		users, ok := s.readBulkUsers(w, r)
+		if !ok {
+			return
+		}
+
+		results, err := checkBulkUsers(users, generatePasswords)
+		if err != nil {
+			logger.Error().Err(err).Msg("api-UserBulkCreate-checkBulkUsers")
+			s.writeJSON(w, http.StatusInternalServerError, envelope{"error": http.StatusText(http.StatusInternalServerError)}, nil)
+			return
+		}
+
+		status := http.StatusOK
+		if mode == bulkModeAtomic {
+			status, err = s.bulkCreateAtomic(r.Context(), users, results)
+		} else {
+			s.bulkCreatePerRow(r.Context(), users, results)
+		}
+		if err != nil {
+			logger.Error().Err(err).Msg("api-UserBulkCreate-create")
+			s.writeJSON(w, http.StatusInternalServerError, envelope{"error": http.StatusText(http.StatusInternalServerError)}, nil)
+			return
+		}
+
+		metadata := bulkUserMetadata{Mode: mode}
+		for i := range results {
+			if results[i].Status == bulkStatusCreated {
+				metadata.Created++
+				continue
+			}
+			// passwords of users that were not created are of no use
+			results[i].TemporaryPassword = ""
+			if results[i].Status != bulkStatusSkipped {
+				metadata.Failed++
+			}
+		}
+
+		if generatePasswords {
+			storeForReplay(r, bulkUsersReplay(results, metadata))
+		}
+		s.writeJSON(w, status, envelope{"results": results, "metadata": metadata}, nil)
+	}
+}
+
+// bulkUsersReplay returns the report of a bulk import as replays of it get
+// it, without temporary passwords.
+func bulkUsersReplay(results []bulkUserResult, metadata bulkUserMetadata) []byte {
+	redacted := make([]bulkUserResult, len(results))
+	for i, result := range results {
+		result.TemporaryPassword = ""
+		redacted[i] = result
+	}
+
+	js, _ := json.MarshalIndent(envelope{"results": redacted, "metadata": metadata}, "", "\t")
+	return append(js, '\n')
+}
+
+// readBulkUsers reads the rows of a bulk import. When they cannot be read,
+// the response is written and ok is false.
+func (s *Server) readBulkUsers(w http.ResponseWriter, r *http.Request) ([]bulkUser, bool) {
+	var users []bulkUser
+	var err error
+
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	switch mediaType {
+	case "", contentTypeJSON:
+		err = s.readJSON(w, r, &users)
+	case contentTypeCSV:
+		r.Body = http.MaxBytesReader(w, r.Body, s.config.UploadMaxBytes)
+		users, err = readBulkUsersCSV(r.Body)
+	case "multipart/form-data":
+		r.Body = http.MaxBytesReader(w, r.Body, s.config.UploadMaxBytes)
+		if err = r.ParseMultipartForm(1 << 20); err != nil {
+			break
+		}
+		defer r.MultipartForm.RemoveAll()
+
+		file, _, fileErr := r.FormFile(bulkUsersUploadField)
+		if fileErr != nil {
+			s.writeJSON(w, http.StatusBadRequest, envelope{"error": fmt.Sprintf("%s must be provided", bulkUsersUploadField)}, nil)
+			return nil, false
+		}
+		defer file.Close()
+		users, err = readBulkUsersCSV(file)
+	default:
+		s.writeJSON(w, http.StatusUnsupportedMediaType, envelope{"error": "body must be JSON, CSV or a multipart form"}, nil)
+		return nil, false
+	}
+	if err != nil {
+		var maxBytesError *http.MaxBytesError
+		if errors.As(err, &maxBytesError) {
+			s.writeJSON(w, http.StatusRequestEntityTooLarge, envelope{"error": fmt.Sprintf("body must not be larger than %d bytes", maxBytesError.Limit)}, nil)
+			return nil, false
+		}
+		s.writeJSON(w, http.StatusBadRequest, envelope{"error": err.Error()}, nil)
+		return nil, false
+	}
+
+	if len(users) == 0 {
+		s.writeJSON(w, http.StatusBadRequest, envelope{"error": "at least one user must be provided"}, nil)
+		return nil, false
+	}
+	if len(users) > bulkUsersMax {
+		s.writeJSON(w, http.StatusBadRequest, envelope{"error": fmt.Sprintf("no more than %d users may be imported at once", bulkUsersMax)}, nil)
+		return nil, false
+	}
+
+	return users, true
+}
+
+// readBulkUsersCSV reads the rows of a CSV file whose header names their
+// columns.
+func readBulkUsersCSV(r io.Reader) ([]bulkUser, error) {
+	reader := csv.NewReader(r)
+
+	header, err := reader.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, errors.New("body must not be empty")
+		}
+		return nil, fmt.Errorf("body contains malformed CSV: %w", err)
+	}
+
+	columns := map[string]int{}
+	for i, name := range header {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name != "email" && name != "username" && name != "password" {
+			return nil, fmt.Errorf("CSV header contains unknown column %q", name)
+		}
+		if _, ok := columns[name]; ok {
+			return nil, fmt.Errorf("CSV header names column %q twice", name)
+		}
+		columns[name] = i
+	}
+	for _, required := range []string{"email", "username"} {
+		if _, ok := columns[required]; !ok {
+			return nil, fmt.Errorf("CSV header must name the %s column", required)
+		}
+	}
+
+	field := func(record []string, name string) string {
+		i, ok := columns[name]
+		if !ok {
+			return ""
+		}
+		return record[i]
+	}
+
+	users := make([]bulkUser, 0)
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("body contains malformed CSV: %w", err)
+		}
+		if len(users) == bulkUsersMax {
+			return nil, fmt.Errorf("no more than %d users may be imported at once", bulkUsersMax)
+		}
+
+		// passwords are taken as they are, spaces and all
+		users = append(users, bulkUser{
+			Email:    strings.TrimSpace(field(record, "email")),
+			Username: strings.TrimSpace(field(record, "username")),
+			Password: field(record, "password"),
+		})
+	}
+
+	return users, nil
+}
+
+// checkBulkUsers validates every row, filling in temporary passwords where
+// they are asked for, and flags rows repeating the email or username of an
+// earlier one. Rows that pass are left without a status.
+func checkBulkUsers(users []bulkUser, generatePasswords bool) ([]bulkUserResult, error) {
+	results := make([]bulkUserResult, len(users))
+	emails := map[string]int{}
+	usernames := map[string]int{}
+
+	for i := range users {
+		user := &users[i]
+		result := &results[i]
+		result.Row, result.Email, result.Username = i+1, user.Email, user.Username
+
+		if user.Password == "" && generatePasswords {
+			password, err := temporaryPassword()
+			if err != nil {
+				return nil, err
+			}
+			user.Password, result.TemporaryPassword = password, password
+		}
+
+		v := validator.New()
+		validator.ValidateEmail(v, "email", user.Email)
+		validator.ValidateUsername(v, "username", user.Username)
+		validator.ValidatePassword(v, "password", user.Password)
+		if !v.Valid() {
+			result.Status, result.Errors = bulkStatusInvalid, v.Errors
+			continue
+		}
+
+		if row, ok := emails[user.Email]; ok {
+			result.Status, result.Error = bulkStatusFailed, fmt.Sprintf("email is already used by row %d", row)
+			continue
+		}
+		if row, ok := usernames[user.Username]; ok {
+			result.Status, result.Error = bulkStatusFailed, fmt.Sprintf("username is already used by row %d", row)
+			continue
+		}
+		emails[user.Email], usernames[user.Username] = result.Row, result.Row
+	}
+
+	return results, nil
+}
+
+// bulkCreateAtomic creates the users of the rows that passed the checks in a
+// single transaction, which is rolled back when any row fails. It returns the
+// status to answer with; errors other than those of single rows are returned
+// as such.
+func (s *Server) bulkCreateAtomic(ctx context.Context, users []bulkUser, results []bulkUserResult) (int, error) {
+	logger := zerolog.Ctx(ctx)
+
+	skipPending := func() {
+		for i := range results {
+			if results[i].Status == "" || results[i].Status == bulkStatusCreated {
+				results[i].Status, results[i].ID = bulkStatusSkipped, ""
+			}
+		}
+	}
+
+	failed := false
+	for _, result := range results {
+		failed = failed || result.Status == bulkStatusInvalid || result.Status == bulkStatusFailed
+	}
+	if failed {
+		skipPending()
+		return http.StatusUnprocessableEntity, nil
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	repo := repository.New(tx, *logger)
+	for i, user := range users {
+		created, err := repo.UserRepository.UserCreate(ctx, user.Email, user.Password, user.Username)
+		if err != nil {
+			if errors.Is(err, repository.ErrUserExists) {
+				results[i].Status, results[i].Error = bulkStatusFailed, err.Error()
+				failed = true
+				continue
+			}
+			if rollback := tx.Rollback(); rollback != nil {
+				logger.Error().Err(rollback).Msg("api-bulkCreateAtomic-UserCreate-RollbackError")
+			}
+			return 0, err
+		}
+		results[i].Status, results[i].ID = bulkStatusCreated, created.ID
+	}
+
+	if failed {
+		if err := tx.Rollback(); err != nil {
+			return 0, err
+		}
+		skipPending()
+		return http.StatusUnprocessableEntity, nil
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+
+	return http.StatusCreated, nil
+}
+
+// bulkCreatePerRow creates the user of every row that passed the checks in a
+// transaction of its own, recording how each of them went.
+func (s *Server) bulkCreatePerRow(ctx context.Context, users []bulkUser, results []bulkUserResult) {
+	logger := zerolog.Ctx(ctx)
+
+	for i, user := range users {
+		if results[i].Status != "" {
+			continue
+		}
+
+		created, err := s.createUserTx(ctx, user)
+		if err != nil {
+			results[i].Status = bulkStatusFailed
+			if errors.Is(err, repository.ErrUserExists) {
+				results[i].Error = err.Error()
+				continue
+			}
+			logger.Error().Err(err).Int("row", results[i].Row).Msg("api-bulkCreatePerRow-createUserTx")
+			results[i].Error = http.StatusText(http.StatusInternalServerError)
+			continue
+		}
+		results[i].Status, results[i].ID = bulkStatusCreated, created.ID
+	}
+}
+
+func (s *Server) createUserTx(ctx context.Context, user bulkUser) (*repository.User, error) {
+	logger := zerolog.Ctx(ctx)
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	repo := repository.New(tx, *logger)
+	created, err := repo.UserRepository.UserCreate(ctx, user.Email, user.Password, user.Username)
+	if err != nil {
+		if rollback := tx.Rollback(); rollback != nil {
+			logger.Error().Err(rollback).Msg("api-createUserTx-RollbackError")
+		}
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+// temporaryPassword generates a random password for a user to replace.
+func temporaryPassword() (string, error) {
+	b := make([]byte, temporaryPasswordBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
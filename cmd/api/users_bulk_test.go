@@ -0,0 +1,180 @@
+package main
+
+import (
+	"context"
+	"database/sql/driver"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/mrityunjaygr8/go-oink/internal/repository"
+)
+
+func TestReadBulkUsersCSV(t *testing.T) {
+	users, err := readBulkUsersCSV(strings.NewReader("Username,email\npiggy, im@oink.in\n"))
+	if err != nil {
+		t.Fatalf("readBulkUsersCSV: %v", err)
+	}
+	if len(users) != 1 || users[0] != (bulkUser{Email: "im@oink.in", Username: "piggy"}) {
+		t.Fatalf("got %+v", users)
+	}
+
+	for name, body := range map[string]string{
+		"empty":           "",
+		"unknown-column":  "email,username,role\n",
+		"missing-column":  "email\n",
+		"repeated-column": "email,username,email\n",
+		"short-record":    "email,username\nim@oink.in\n",
+	} {
+		if _, err := readBulkUsersCSV(strings.NewReader(body)); err == nil {
+			t.Errorf("%s: got no error", name)
+		}
+	}
+}
+
+func TestCheckBulkUsers(t *testing.T) {
+	users := []bulkUser{
+		{Email: "im@oink.in", Username: "piggy", Password: "password1"},
+		{Email: "not-an-email", Username: "oink", Password: "password1"},
+		{Email: "im@oink.in", Username: "piggy2", Password: "password1"},
+		{Email: "oink@oink.in", Username: "oink"},
+	}
+
+	results, err := checkBulkUsers(users, true)
+	if err != nil {
+		t.Fatalf("checkBulkUsers: %v", err)
+	}
+
+	statuses := []string{"", bulkStatusInvalid, bulkStatusFailed, ""}
+	for i, want := range statuses {
+		if results[i].Status != want {
+			t.Errorf("row %d: got status %q, want %q", i+1, results[i].Status, want)
+		}
+	}
+	if results[3].TemporaryPassword == "" || users[3].Password != results[3].TemporaryPassword {
+		t.Errorf("row 4: got no temporary password")
+	}
+	if results[0].TemporaryPassword != "" {
+		t.Errorf("row 1: got a temporary password despite having one")
+	}
+}
+
+func TestUserBulkCreate(t *testing.T) {
+	create := func(s *Server, target string, body string, requester *repository.User) (*httptest.ResponseRecorder, []bulkUserResult) {
+		r := httptest.NewRequest(http.MethodPost, target, strings.NewReader(body))
+		r.Header.Set("Content-Type", "text/csv")
+		r = r.WithContext(context.WithValue(r.Context(), "user", requester))
+		rec := httptest.NewRecorder()
+		s.UserBulkCreate()(rec, r)
+
+		var res struct {
+			Results []bulkUserResult `json:"results"`
+		}
+		json.Unmarshal(rec.Body.Bytes(), &res)
+		return rec, res.Results
+	}
+	count := func(n int) *sqlmock.Rows {
+		return sqlmock.NewRows([]string{"count"}).AddRow(n)
+	}
+	inserted := func() *sqlmock.Rows {
+		return sqlmock.NewRows([]string{"is_admin", "avatar_key"}).AddRow(false, "")
+	}
+	admin := &repository.User{ID: "admin-id", IsAdmin: true}
+	const body = "email,username,password\nim@oink.in,piggy,password1\noink@oink.in,oink,password1\n"
+
+	t.Run("not-admin", func(t *testing.T) {
+		s, _ := newTestServer(t)
+		rec, _ := create(s, "/users/bulk", body, &repository.User{ID: "user-id"})
+		if rec.Code != http.StatusForbidden {
+			t.Fatalf("got status %d, want %d", rec.Code, http.StatusForbidden)
+		}
+	})
+
+	t.Run("atomic/invalid-row", func(t *testing.T) {
+		s, _ := newTestServer(t)
+		rec, results := create(s, "/users/bulk", body+"bad,x,short\n", admin)
+		if rec.Code != http.StatusUnprocessableEntity {
+			t.Fatalf("got status %d, want %d", rec.Code, http.StatusUnprocessableEntity)
+		}
+		if len(results) != 3 || results[0].Status != bulkStatusSkipped || results[2].Status != bulkStatusInvalid {
+			t.Fatalf("got %+v", results)
+		}
+	})
+
+	t.Run("atomic/existing-user", func(t *testing.T) {
+		s, mock := newTestServer(t)
+		mock.ExpectBegin()
+		mock.ExpectQuery(`(?i)select count`).WillReturnRows(count(0))
+		mock.ExpectQuery(`(?i)select count`).WillReturnRows(count(0))
+		mock.ExpectQuery(`(?i)insert into "users"`).WillReturnRows(inserted())
+		mock.ExpectQuery(`(?i)select count`).WillReturnRows(count(1))
+		mock.ExpectRollback()
+
+		rec, results := create(s, "/users/bulk", body, admin)
+		if rec.Code != http.StatusUnprocessableEntity {
+			t.Fatalf("got status %d, want %d", rec.Code, http.StatusUnprocessableEntity)
+		}
+		if results[0].Status != bulkStatusSkipped || results[0].ID != "" || results[1].Status != bulkStatusFailed {
+			t.Fatalf("got %+v", results)
+		}
+	})
+
+	t.Run("per-row/existing-user", func(t *testing.T) {
+		s, mock := newTestServer(t)
+		mock.ExpectBegin()
+		mock.ExpectQuery(`(?i)select count`).WillReturnRows(count(1))
+		mock.ExpectRollback()
+		mock.ExpectBegin()
+		mock.ExpectQuery(`(?i)select count`).WillReturnRows(count(0))
+		mock.ExpectQuery(`(?i)select count`).WillReturnRows(count(0))
+		mock.ExpectQuery(`(?i)insert into "users"`).WillReturnRows(inserted())
+		mock.ExpectCommit()
+
+		rec, results := create(s, "/users/bulk?mode=per_row", body, admin)
+		if rec.Code != http.StatusOK {
+			t.Fatalf("got status %d, want %d", rec.Code, http.StatusOK)
+		}
+		if results[0].Status != bulkStatusFailed || results[1].Status != bulkStatusCreated || results[1].ID == "" {
+			t.Fatalf("got %+v", results)
+		}
+	})
+
+	t.Run("temporary-passwords-not-stored", func(t *testing.T) {
+		s, mock := newTestServer(t)
+		mock.ExpectExec(`(?i)delete from "idempotency_keys"`).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec(`(?i)insert into "idempotency_keys"`).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectBegin()
+		mock.ExpectQuery(`(?i)select count`).WillReturnRows(count(0))
+		mock.ExpectQuery(`(?i)select count`).WillReturnRows(count(0))
+		mock.ExpectQuery(`(?i)insert into "users"`).WillReturnRows(inserted())
+		mock.ExpectCommit()
+		mock.ExpectQuery(`(?i)from "idempotency_keys"`).
+			WillReturnRows(sqlmock.NewRows([]string{"user", "key", "status"}).AddRow("admin-id", "key", 0))
+		mock.ExpectExec(`(?i)update "idempotency_keys"`).
+			WithArgs(http.StatusCreated, sqlmock.AnyArg(), withoutTemporaryPasswords{}, "admin-id", "key").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		r := httptest.NewRequest(http.MethodPost, "/users/bulk?generate_passwords=true", strings.NewReader("email,username\nim@oink.in,piggy\n"))
+		r.Header.Set("Content-Type", "text/csv")
+		r.Header.Set(idempotencyKeyHeader, "key")
+		r = r.WithContext(context.WithValue(r.Context(), "user", admin))
+		rec := httptest.NewRecorder()
+		s.Idempotent(s.UserBulkCreate()).ServeHTTP(rec, r)
+
+		if rec.Code != http.StatusCreated || !strings.Contains(rec.Body.String(), "temporary_password") {
+			t.Fatalf("got %d %s, want the temporary password", rec.Code, rec.Body.String())
+		}
+	})
+}
+
+// withoutTemporaryPasswords matches a stored bulk import report that holds
+// no temporary password.
+type withoutTemporaryPasswords struct{}
+
+func (withoutTemporaryPasswords) Match(v driver.Value) bool {
+	b, ok := v.([]byte)
+	return ok && strings.Contains(string(b), `"results"`) && !strings.Contains(string(b), "temporary_password")
+}
@@ -0,0 +1,190 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/mrityunjaygr8/go-oink/internal/repository"
+)
+
+func TestUserLookupStatus(t *testing.T) {
+	tests := []struct {
+		name    string
+		method  string
+		handler func(*Server) http.HandlerFunc
+		// tx is set for handlers that look the user up inside a transaction
+		tx bool
+	}{
+		{"UserRetrieve", http.MethodGet, (*Server).UserRetrieve, false},
+		{"UserDelete", http.MethodDelete, (*Server).UserDelete, true},
+	}
+
+	admin := &repository.User{ID: "admin-id", IsAdmin: true}
+
+	for _, tt := range tests {
+		t.Run(tt.name+"/missing", func(t *testing.T) {
+			s, mock := newTestServer(t)
+			if tt.tx {
+				mock.ExpectBegin()
+			}
+			mock.ExpectQuery(`(?i)from "users"`).WillReturnRows(sqlmock.NewRows([]string{"id"}))
+			if tt.tx {
+				mock.ExpectRollback()
+			}
+
+			req := httptest.NewRequest(tt.method, "/users/id", nil)
+			req.Header.Set("If-Match", "*")
+			req = req.WithContext(context.WithValue(req.Context(), "user", admin))
+			rec := serveRequest("/users/{userID}", req, tt.handler(s))
+			if rec.Code != http.StatusNotFound {
+				t.Fatalf("got status %d, want %d", rec.Code, http.StatusNotFound)
+			}
+		})
+
+		t.Run(tt.name+"/db-error", func(t *testing.T) {
+			s, mock := newTestServer(t)
+			if tt.tx {
+				mock.ExpectBegin()
+			}
+			mock.ExpectQuery(`(?i)from "users"`).WillReturnError(errDB)
+			if tt.tx {
+				mock.ExpectRollback()
+			}
+
+			req := httptest.NewRequest(tt.method, "/users/id", nil)
+			req.Header.Set("If-Match", "*")
+			req = req.WithContext(context.WithValue(req.Context(), "user", admin))
+			rec := serveRequest("/users/{userID}", req, tt.handler(s))
+			if rec.Code != http.StatusInternalServerError {
+				t.Fatalf("got status %d, want %d", rec.Code, http.StatusInternalServerError)
+			}
+		})
+	}
+}
+
+func TestUserSearch(t *testing.T) {
+	search := func(s *Server, target string, requester *repository.User) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(http.MethodGet, target, nil)
+		r = r.WithContext(context.WithValue(r.Context(), "user", requester))
+		rec := httptest.NewRecorder()
+		s.UserSearch()(rec, r)
+		return rec
+	}
+	rows := func() *sqlmock.Rows {
+		return sqlmock.NewRows([]string{"email", "id", "username"}).AddRow("im@oink.in", "id", "im")
+	}
+
+	for _, target := range []string{"/users/search", "/users/search?q=im&limit=0", "/users/search?q=im&limit=x"} {
+		t.Run("bad-request"+target, func(t *testing.T) {
+			s, _ := newTestServer(t)
+			rec := search(s, target, &repository.User{})
+			if rec.Code != http.StatusBadRequest {
+				t.Fatalf("got status %d, want %d", rec.Code, http.StatusBadRequest)
+			}
+		})
+	}
+
+	t.Run("email-hidden", func(t *testing.T) {
+		s, mock := newTestServer(t)
+		mock.ExpectQuery(`(?i)from "users"`).WillReturnRows(rows())
+
+		rec := search(s, "/users/search?q=im", &repository.User{})
+		if rec.Code != http.StatusOK {
+			t.Fatalf("got status %d, want %d", rec.Code, http.StatusOK)
+		}
+		if strings.Contains(rec.Body.String(), "im@oink.in") {
+			t.Fatalf("email exposed to non-admin: %s", rec.Body.String())
+		}
+	})
+
+	t.Run("email-visible-to-admin", func(t *testing.T) {
+		s, mock := newTestServer(t)
+		mock.ExpectQuery(`(?i)from "users"`).WillReturnRows(rows())
+
+		rec := search(s, "/users/search?q=im", &repository.User{IsAdmin: true})
+		if !strings.Contains(rec.Body.String(), "im@oink.in") {
+			t.Fatalf("email missing for admin: %s", rec.Body.String())
+		}
+	})
+}
+
+func TestUserCreateValidation(t *testing.T) {
+	s, _ := newTestServer(t)
+
+	body := strings.NewReader(`{"email": "im.oink.in", "username": "Im Parham", "password": "pass"}`)
+	rec := httptest.NewRecorder()
+	s.UserCreate()(rec, httptest.NewRequest(http.MethodPost, "/users", body))
+
+	if rec.Code != http.StatusUnprocessableEntity {
+		t.Fatalf("got status %d, want %d", rec.Code, http.StatusUnprocessableEntity)
+	}
+
+	var resp struct {
+		Error map[string][]string `json:"error"`
+	}
+	if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+		t.Fatalf("decoding response: %v", err)
+	}
+	for _, field := range []string{"email", "username", "password"} {
+		if len(resp.Error[field]) == 0 {
+			t.Errorf("no error reported for %s: %v", field, resp.Error)
+		}
+	}
+}
+
+func TestUserListPagination(t *testing.T) {
+	t.Run("limit-out-of-range", func(t *testing.T) {
+		s, _ := newTestServer(t)
+		target := fmt.Sprintf("/users?limit=%d", s.config.PageMaxLimit+1)
+		rec := serve(http.MethodGet, "/users", target, s.UserList())
+		if rec.Code != http.StatusBadRequest {
+			t.Fatalf("got status %d, want %d", rec.Code, http.StatusBadRequest)
+		}
+	})
+
+	t.Run("invalid-cursor", func(t *testing.T) {
+		s, _ := newTestServer(t)
+		rec := serve(http.MethodGet, "/users", "/users?cursor=oink", s.UserList())
+		if rec.Code != http.StatusBadRequest {
+			t.Fatalf("got status %d, want %d", rec.Code, http.StatusBadRequest)
+		}
+	})
+
+	t.Run("next-link", func(t *testing.T) {
+		s, mock := newTestServer(t)
+		created := time.Date(2023, 5, 1, 12, 0, 0, 0, time.UTC)
+		mock.ExpectQuery(`(?i)from "users".*limit 2`).WillReturnRows(
+			sqlmock.NewRows([]string{"id", "username", "created_at"}).
+				AddRow("b", "bob", created.Add(time.Second)).
+				AddRow("a", "alice", created))
+
+		rec := serve(http.MethodGet, "/users", "/users?limit=1", s.UserList())
+		if rec.Code != http.StatusOK {
+			t.Fatalf("got status %d, want %d", rec.Code, http.StatusOK)
+		}
+
+		var resp struct {
+			Users    []struct{ ID string } `json:"users"`
+			Metadata cursorMetadata        `json:"metadata"`
+		}
+		if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+			t.Fatalf("decoding response: %v", err)
+		}
+		if len(resp.Users) != 1 || resp.Metadata.NextCursor == "" {
+			t.Fatalf("got %+v, want one user and a next cursor", resp)
+		}
+
+		link := rec.Header().Get("Link")
+		want := fmt.Sprintf(`</users?cursor=%s&limit=1>; rel="next"`, resp.Metadata.NextCursor)
+		if link != want {
+			t.Fatalf("got Link %q, want %q", link, want)
+		}
+	})
+}
@@ -0,0 +1,48 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/mrityunjaygr8/go-oink/internal/repository"
+	"github.com/mrityunjaygr8/go-oink/internal/validator"
+)
+
+// Limits on user input shared by the request validators.
+const (
+	oinkNameMinChars    = 3
+	oinkNameMaxChars    = 50
+	descriptionMaxChars = 500
+	postBodyMaxChars    = 10_000
+)
+
+// failedValidationResponse answers a request whose input broke rules with
+// the messages for every offending field.
+func (s *Server) failedValidationResponse(w http.ResponseWriter, errors map[string][]string) {
+	s.writeJSON(w, http.StatusUnprocessableEntity, envelope{"error": errors}, nil)
+}
+
+func validateOinkName(v *validator.Validator, field string, name string) {
+	v.Check(validator.NotBlank(name), field, "must be provided")
+	v.Check(validator.MinChars(name, oinkNameMinChars), field, fmt.Sprintf("must be at least %d characters long", oinkNameMinChars))
+	v.Check(validator.MaxChars(name, oinkNameMaxChars), field, fmt.Sprintf("must not be more than %d characters long", oinkNameMaxChars))
+	v.Check(validator.Matches(name, validator.SlugRX), field, "must only contain lowercase letters and digits, separated by single hyphens or underscores")
+}
+
+func validateDescription(v *validator.Validator, field string, description string) {
+	v.Check(validator.MaxChars(description, descriptionMaxChars), field, fmt.Sprintf("must not be more than %d characters long", descriptionMaxChars))
+}
+
+func validateOinkVisibility(v *validator.Validator, field string, visibility string) {
+	v.Check(validator.PermittedValue(visibility, repository.OinkVisibilityPublic, repository.OinkVisibilityUnlisted, repository.OinkVisibilityPrivate),
+		field, "must be one of public, unlisted or private")
+}
+
+func validateOinkLanguage(v *validator.Validator, field string, language string) {
+	v.Check(repository.ValidOinkLanguage(language), field, "must be a supported text search language")
+}
+
+func validatePostBody(v *validator.Validator, field string, body string) {
+	v.Check(validator.NotBlank(body), field, "must be provided")
+	v.Check(validator.MaxChars(body, postBodyMaxChars), field, fmt.Sprintf("must not be more than %d characters long", postBodyMaxChars))
+}
@@ -55,6 +55,13 @@ func main() {
 	u, err := repo.UserRepository.UserCreate(context.Background(), user.Email, user.Password, user.Username)
 	if err != nil {
 		logger.Info().Err(err).Msg("UserCreate")
+		return
+	}
+
+	err = repo.UserRepository.UserSetAdmin(context.Background(), u.ID, true)
+	if err != nil {
+		logger.Info().Err(err).Msg("UserSetAdmin")
+		return
 	}
 
 	logger.Info().Any("user", u).Msg("user created successfully")
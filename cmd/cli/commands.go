@@ -0,0 +1,384 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/mrityunjaygr8/go-oink/internal/output"
+	"github.com/mrityunjaygr8/go-oink/pkg/client"
+	"golang.org/x/term"
+)
+
+// prompt returns the value of a flag, asking for it on stdin when it was not
+// given.
+func (c *cli) prompt(label string, value string) (string, error) {
+	if value != "" {
+		return value, nil
+	}
+
+	if c.in == nil {
+		c.in = bufio.NewReader(c.stdin)
+	}
+
+	fmt.Fprintf(c.stderr, "%s: ", label)
+	line, err := c.in.ReadString('\n')
+	line = strings.TrimRight(line, "\r\n")
+	if line == "" {
+		if err != nil {
+			return "", usageErrorf("%s must be given", strings.ToLower(label))
+		}
+		return "", usageErrorf("%s must not be empty", strings.ToLower(label))
+	}
+	return line, nil
+}
+
+// promptPassword is prompt for passwords, which are read without being
+// echoed when stdin is a terminal and like any other value otherwise.
+func (c *cli) promptPassword(value string) (string, error) {
+	if value != "" {
+		return value, nil
+	}
+
+	f, ok := c.stdin.(*os.File)
+	if !ok || !term.IsTerminal(int(f.Fd())) {
+		return c.prompt("Password", value)
+	}
+
+	fmt.Fprint(c.stderr, "Password: ")
+	password, err := term.ReadPassword(int(f.Fd()))
+	// the newline typed was not echoed either
+	fmt.Fprintln(c.stderr)
+	if err != nil {
+		return "", err
+	}
+	if len(password) == 0 {
+		return "", usageErrorf("password must not be empty")
+	}
+	return string(password), nil
+}
+
+func (c *cli) login(ctx context.Context, args []string) error {
+	fs := c.flags("login")
+	email := fs.String("email", "", "")
+	password := fs.String("password", os.Getenv("OINK_PASSWORD"), "")
+	if positional, err := parse(fs, args); err != nil {
+		return err
+	} else if len(positional) > 0 {
+		return usageErrorf("login takes no arguments")
+	}
+	if err := c.setup(); err != nil {
+		return err
+	}
+
+	var err error
+	if *email, err = c.prompt("Email", *email); err != nil {
+		return err
+	}
+	if *password, err = c.promptPassword(*password); err != nil {
+		return err
+	}
+
+	api, err := c.client(false)
+	if err != nil {
+		return err
+	}
+	token, err := api.Login(ctx, *email, *password)
+	if err != nil {
+		return err
+	}
+
+	c.config.Server = c.server
+	c.config.Token = token.Token
+	if err := c.config.save(c.configPath); err != nil {
+		return err
+	}
+
+	user, err := api.Me(ctx)
+	if err != nil {
+		return err
+	}
+	return c.printUser(user)
+}
+
+// logout forgets the stored token. Tokens cannot be revoked through the API,
+// so it stays valid on the server until it expires.
+func (c *cli) logout(args []string) error {
+	fs := c.flags("logout")
+	if positional, err := parse(fs, args); err != nil {
+		return err
+	} else if len(positional) > 0 {
+		return usageErrorf("logout takes no arguments")
+	}
+	if err := c.setup(); err != nil {
+		return err
+	}
+
+	c.config.Token = ""
+	return c.config.save(c.configPath)
+}
+
+func (c *cli) whoami(ctx context.Context, args []string) error {
+	fs := c.flags("whoami")
+	if positional, err := parse(fs, args); err != nil {
+		return err
+	} else if len(positional) > 0 {
+		return usageErrorf("whoami takes no arguments")
+	}
+	if err := c.setup(); err != nil {
+		return err
+	}
+
+	api, err := c.client(true)
+	if err != nil {
+		return err
+	}
+	user, err := api.Me(ctx)
+	if err != nil {
+		return err
+	}
+	return c.printUser(user)
+}
+
+func (c *cli) usersList(ctx context.Context, args []string) error {
+	var opts client.UserListOptions
+	fs := c.flags("users list")
+	fs.StringVar(&opts.UsernamePrefix, "prefix", "", "")
+	fs.StringVar(&opts.Sort, "sort", "", "")
+	fs.StringVar(&opts.Cursor, "cursor", "", "")
+	fs.IntVar(&opts.Limit, "limit", 0, "")
+	if positional, err := parse(fs, args); err != nil {
+		return err
+	} else if len(positional) > 0 {
+		return usageErrorf("users list takes no arguments")
+	}
+	if err := c.setup(); err != nil {
+		return err
+	}
+
+	api, err := c.client(true)
+	if err != nil {
+		return err
+	}
+	page, err := api.ListUsers(ctx, opts)
+	if err != nil {
+		return err
+	}
+
+	rows := make([][]string, 0, len(page.Users))
+	for _, user := range page.Users {
+		rows = append(rows, userRow(user))
+	}
+	if err := c.print(struct {
+		Users      []client.User `json:"users"`
+		NextCursor string        `json:"next_cursor,omitempty"`
+	}{page.Users, page.NextCursor}, userHeader, rows); err != nil {
+		return err
+	}
+
+	if page.NextCursor != "" && c.output == output.Table {
+		fmt.Fprintf(c.stderr, "more users follow, list them with --cursor %s\n", page.NextCursor)
+	}
+	return nil
+}
+
+func (c *cli) usersCreate(ctx context.Context, args []string) error {
+	var user client.UserCreate
+	fs := c.flags("users create")
+	fs.StringVar(&user.Email, "email", "", "")
+	fs.StringVar(&user.Username, "username", "", "")
+	fs.StringVar(&user.Password, "password", "", "")
+	if positional, err := parse(fs, args); err != nil {
+		return err
+	} else if len(positional) > 0 {
+		return usageErrorf("users create takes no arguments")
+	}
+	if user.Email == "" || user.Username == "" {
+		return usageErrorf("--email and --username must be given")
+	}
+	if err := c.setup(); err != nil {
+		return err
+	}
+
+	var err error
+	if user.Password, err = c.promptPassword(user.Password); err != nil {
+		return err
+	}
+
+	api, err := c.client(true)
+	if err != nil {
+		return err
+	}
+	created, err := api.CreateUser(ctx, user)
+	if err != nil {
+		return err
+	}
+	return c.printUser(created)
+}
+
+func (c *cli) usersDelete(ctx context.Context, args []string) error {
+	fs := c.flags("users delete")
+	strategy := fs.String("strategy", "", "")
+	reassignTo := fs.String("reassign-to", "", "")
+	positional, err := parse(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 1 {
+		return usageErrorf("users delete takes the ID of a user")
+	}
+	if err := c.setup(); err != nil {
+		return err
+	}
+
+	api, err := c.client(true)
+	if err != nil {
+		return err
+	}
+	user, err := api.GetUser(ctx, positional[0])
+	if err != nil {
+		return err
+	}
+	if err := api.DeleteUser(ctx, user.ID, user.ETag, *strategy, *reassignTo); err != nil {
+		return err
+	}
+
+	return c.printUser(user)
+}
+
+func (c *cli) oinksList(ctx context.Context, args []string) error {
+	var opts client.OinkListOptions
+	var tags string
+	fs := c.flags("oinks list")
+	fs.StringVar(&opts.Creator, "creator", "", "")
+	fs.StringVar(&opts.NamePrefix, "prefix", "", "")
+	fs.StringVar(&tags, "tag", "", "")
+	fs.BoolVar(&opts.IncludeArchived, "archived", false, "")
+	fs.StringVar(&opts.Sort, "sort", "", "")
+	fs.StringVar(&opts.Cursor, "cursor", "", "")
+	fs.IntVar(&opts.Limit, "limit", 0, "")
+	if positional, err := parse(fs, args); err != nil {
+		return err
+	} else if len(positional) > 0 {
+		return usageErrorf("oinks list takes no arguments")
+	}
+	if tags != "" {
+		opts.Tags = strings.Split(tags, ",")
+	}
+	if err := c.setup(); err != nil {
+		return err
+	}
+
+	api, err := c.client(true)
+	if err != nil {
+		return err
+	}
+	page, err := api.ListOinks(ctx, opts)
+	if err != nil {
+		return err
+	}
+
+	rows := make([][]string, 0, len(page.Oinks))
+	for _, oink := range page.Oinks {
+		rows = append(rows, oinkRow(oink))
+	}
+	if err := c.print(struct {
+		Oinks      []client.Oink `json:"oinks"`
+		NextCursor string        `json:"next_cursor,omitempty"`
+	}{page.Oinks, page.NextCursor}, oinkHeader, rows); err != nil {
+		return err
+	}
+
+	if page.NextCursor != "" && c.output == output.Table {
+		fmt.Fprintf(c.stderr, "more oinks follow, list them with --cursor %s\n", page.NextCursor)
+	}
+	return nil
+}
+
+func (c *cli) oinksGet(ctx context.Context, args []string) error {
+	fs := c.flags("oinks get")
+	positional, err := parse(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 1 {
+		return usageErrorf("oinks get takes the name of an oink")
+	}
+	if err := c.setup(); err != nil {
+		return err
+	}
+
+	api, err := c.client(true)
+	if err != nil {
+		return err
+	}
+	oink, err := api.GetOink(ctx, positional[0])
+	if err != nil {
+		return err
+	}
+	return c.printOink(oink)
+}
+
+func (c *cli) oinksCreate(ctx context.Context, args []string) error {
+	var oink client.OinkCreate
+	var tags string
+	fs := c.flags("oinks create")
+	fs.StringVar(&oink.Description, "description", "", "")
+	fs.StringVar(&oink.Visibility, "visibility", "", "")
+	fs.StringVar(&oink.Language, "language", "", "")
+	fs.StringVar(&tags, "tags", "", "")
+	positional, err := parse(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 1 {
+		return usageErrorf("oinks create takes the name of the oink")
+	}
+	oink.Name = positional[0]
+	if tags != "" {
+		oink.Tags = strings.Split(tags, ",")
+	}
+	if err := c.setup(); err != nil {
+		return err
+	}
+
+	api, err := c.client(true)
+	if err != nil {
+		return err
+	}
+	created, err := api.CreateOink(ctx, oink)
+	if err != nil {
+		return err
+	}
+	return c.printOink(created)
+}
+
+func (c *cli) oinksDelete(ctx context.Context, args []string) error {
+	fs := c.flags("oinks delete")
+	positional, err := parse(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 1 {
+		return usageErrorf("oinks delete takes the name of an oink")
+	}
+	if err := c.setup(); err != nil {
+		return err
+	}
+
+	api, err := c.client(true)
+	if err != nil {
+		return err
+	}
+	oink, err := api.GetOink(ctx, positional[0])
+	if err != nil {
+		return err
+	}
+	if err := api.DeleteOink(ctx, oink.Name, oink.ETag); err != nil {
+		return err
+	}
+
+	return c.printOink(oink)
+}
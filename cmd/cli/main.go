@@ -1,105 +1,286 @@
 package main
 
 import (
+	"bufio"
 	"context"
-	"crypto/rand"
-	"database/sql"
+	"errors"
+	"flag"
 	"fmt"
-	"math/big"
+	"io"
+	"net/http"
 	"os"
+	"os/signal"
+	"strings"
 
-	_ "github.com/lib/pq"
-	"github.com/mrityunjaygr8/go-oink/internal/config"
-	"github.com/mrityunjaygr8/go-oink/internal/repository"
-	"github.com/mrityunjaygr8/go-oink/internal/services"
-	"github.com/rs/zerolog"
+	"github.com/mrityunjaygr8/go-oink/internal/output"
+	"github.com/mrityunjaygr8/go-oink/pkg/client"
 )
 
+// The exit codes of the CLI.
+const (
+	exitOK = 0
+	// exitError is any failure without a code of its own, such as the server
+	// being unreachable.
+	exitError = 1
+	// exitUsage is a command line that could not be parsed.
+	exitUsage = 2
+	// exitAuth is a missing or rejected token, or a request the user may not
+	// make.
+	exitAuth = 3
+	// exitNotFound is a user or oink that does not exist.
+	exitNotFound = 4
+	// exitConflict is a user or oink that already exists, or one that changed
+	// while the command ran.
+	exitConflict = 5
+	// exitInvalid is input the server rejected.
+	exitInvalid = 6
+)
+
+const defaultServer = "http://localhost:8080"
+
+const usage = `Usage: oink [flags] <command> [arguments]
+
+Exit codes: 0 success, 1 failure, 2 bad command line, 3 not logged in or
+not allowed, 4 not found, 5 already exists or changed meanwhile, 6 invalid
+input.
+
+Commands:
+  login                       log in and store the token
+      --email, --password     asked for when not given; the password is
+                              also read from $OINK_PASSWORD
+  logout                      forget the stored token
+  whoami                      show the logged in user
+  users list                  list users
+      --prefix, --sort, --cursor, --limit
+  users create                create a user
+      --email, --username, --password
+  users delete <id>           delete a user
+      --strategy reassign|ghost|cascade, --reassign-to <id>
+  oinks list                  list oinks
+      --creator, --prefix, --tag a,b, --archived, --sort, --cursor, --limit
+  oinks get <name>            show an oink
+  oinks create <name>         create an oink
+      --description, --visibility, --language, --tags a,b
+  oinks delete <name>         delete an oink
+
+Flags, accepted before or after the command:
+  --server URL                server to talk to (default $OINK_SERVER, the
+                              server logged in to, or ` + defaultServer + `)
+  --output json|table         output format (default table)
+  --config PATH               config file (default $OINK_CONFIG or
+                              <user config dir>/go-oink/cli.json)
+`
+
+// errUsage marks errors in the command line.
+var errUsage = errors.New("usage")
+
+func usageErrorf(format string, args ...interface{}) error {
+	return fmt.Errorf("%w: "+format, append([]interface{}{errUsage}, args...)...)
+}
+
+// errHelp is returned when help was asked for with -h or --help.
+var errHelp = errors.New("help requested")
+
+// errNotLoggedIn is returned by commands that need a token when none is
+// stored for the server.
+var errNotLoggedIn = errors.New("not logged in")
+
+// cli holds what the commands share: the streams they use and the flags
+// every one of them accepts.
+type cli struct {
+	stdin  io.Reader
+	stdout io.Writer
+	stderr io.Writer
+	// in buffers stdin for prompts.
+	in *bufio.Reader
+
+	server     string
+	output     string
+	configPath string
+	config     *cliConfig
+}
+
 func main() {
-	logger := zerolog.New(os.Stderr).With().Timestamp().Logger()
-	path, err := os.Getwd()
-	if err != nil {
-		logger.Fatal().Err(err)
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	c := &cli{stdin: os.Stdin, stdout: os.Stdout, stderr: os.Stderr}
+	os.Exit(c.run(ctx, os.Args[1:]))
+}
+
+// run runs the command line args and returns the exit code.
+func (c *cli) run(ctx context.Context, args []string) int {
+	err := c.dispatch(ctx, args)
+	if err == nil {
+		return exitOK
 	}
-	c, err := config.GetConfig(path, logger)
-	if err != nil {
-		logger.Fatal().Err(err)
+	if errors.Is(err, errHelp) {
+		fmt.Fprint(c.stdout, usage)
+		return exitOK
 	}
 
-	if c.Env == config.EnvDevelopment {
-		logger = logger.Output(zerolog.ConsoleWriter{Out: os.Stderr})
+	if errors.Is(err, errUsage) {
+		fmt.Fprintf(c.stderr, "oink: %s\n\n%s", strings.TrimPrefix(err.Error(), errUsage.Error()+": "), usage)
+	} else {
+		fmt.Fprintf(c.stderr, "oink: %s\n", err)
 	}
+	return exitCode(err)
+}
 
-	logger.Info().Any("config", c).Msg("")
-	if c.DbDsn == "" {
-		c.DbDsn = fmt.Sprintf("postgres://%s:%s@%s:%d/%s?sslmode=%s", c.DbUser, c.DbPass, c.DbHost, c.DbPort, c.DbName, c.DbSSL)
+// exitCode maps err to the exit code the CLI ends with.
+func exitCode(err error) int {
+	switch {
+	case err == nil:
+		return exitOK
+	case errors.Is(err, errUsage):
+		return exitUsage
+	case errors.Is(err, errNotLoggedIn), errors.Is(err, client.ErrUserCredsInvalid), errors.Is(err, client.ErrForbidden):
+		return exitAuth
+	case errors.Is(err, client.ErrNotFound), errors.Is(err, client.ErrUserNotFound), errors.Is(err, client.ErrOinkNotFound):
+		return exitNotFound
+	case errors.Is(err, client.ErrUserExists), errors.Is(err, client.ErrOinkExists), errors.Is(err, client.ErrConflict), errors.Is(err, client.ErrPreconditionFailed):
+		return exitConflict
+	case errors.Is(err, client.ErrInvalid):
+		return exitInvalid
 	}
-	logger.Info().Msg(c.DbDsn)
-	db, err := sql.Open("postgres", c.DbDsn)
-	if err != nil {
-		// logger.WithFields(c.toFields()).Fatal(err)
-		logger.Fatal().Any("config", c).Err(err)
+
+	var apiErr *client.Error
+	if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusBadRequest {
+		return exitInvalid
 	}
+	return exitError
+}
 
-	err = db.Ping()
-	if err != nil {
-		// logger.WithFields(c.toFields()).Fatal(err)
-		logger.Fatal().Any("config", c).Err(err)
+// flags returns a flag set for command carrying the flags every command
+// accepts.
+func (c *cli) flags(command string) *flag.FlagSet {
+	fs := flag.NewFlagSet(command, flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	fs.StringVar(&c.server, "server", c.server, "")
+	fs.StringVar(&c.output, "output", c.output, "")
+	fs.StringVar(&c.configPath, "config", c.configPath, "")
+	return fs
+}
+
+// flagError turns an error parsing flags into the one the CLI ends with.
+func flagError(err error) error {
+	if errors.Is(err, flag.ErrHelp) {
+		return errHelp
 	}
+	return usageErrorf("%s", err)
+}
 
-	repo := repository.New(db, logger)
-	nRand, err := rand.Int(rand.Reader, big.NewInt(10000))
-	if err != nil {
-		logger.Error().Err(err).Msg("error creating random suffix")
+// parse parses args with fs, letting flags and positional arguments mix, and
+// returns the positional ones.
+func parse(fs *flag.FlagSet, args []string) ([]string, error) {
+	var positional []string
+	for {
+		if err := fs.Parse(args); err != nil {
+			return nil, flagError(err)
+		}
+		if fs.NArg() == 0 {
+			return positional, nil
+		}
+		positional = append(positional, fs.Arg(0))
+		args = fs.Args()[1:]
 	}
+}
 
-	user := repository.User{}
-	user.Email = fmt.Sprintf("im_%s@parham.in", nRand)
-	user.Password = "password"
-	user.Username = fmt.Sprintf("im_%s@parham", nRand)
+func (c *cli) dispatch(ctx context.Context, args []string) error {
+	fs := c.flags("oink")
+	// the command and its arguments are parsed by the command itself, so
+	// only the flags in front of it are parsed here
+	if err := fs.Parse(args); err != nil {
+		return flagError(err)
+	}
+	args = fs.Args()
+	if len(args) == 0 {
+		return usageErrorf("a command must be given")
+	}
 
-	u, err := repo.UserRepository.UserCreate(context.Background(), user.Email, user.Password, user.Username)
-	if err != nil {
-		logger.Fatal().Err(err).Msg("user indert error")
+	command, args := args[0], args[1:]
+	switch command {
+	case "login":
+		return c.login(ctx, args)
+	case "logout":
+		return c.logout(args)
+	case "whoami":
+		return c.whoami(ctx, args)
+	case "users", "oinks":
+		if len(args) == 0 {
+			return usageErrorf("%s needs a subcommand", command)
+		}
+		subcommand, args := args[0], args[1:]
+		switch command + " " + subcommand {
+		case "users list":
+			return c.usersList(ctx, args)
+		case "users create":
+			return c.usersCreate(ctx, args)
+		case "users delete":
+			return c.usersDelete(ctx, args)
+		case "oinks list":
+			return c.oinksList(ctx, args)
+		case "oinks get":
+			return c.oinksGet(ctx, args)
+		case "oinks create":
+			return c.oinksCreate(ctx, args)
+		case "oinks delete":
+			return c.oinksDelete(ctx, args)
+		}
+		return usageErrorf("unknown command %q", command+" "+subcommand)
+	case "help":
+		return errHelp
 	}
-	logger.Info().Any("user", u).Msg("user insert")
 
-	service := services.New(db, logger)
+	return usageErrorf("unknown command %q", command)
+}
 
-	oink := &services.Oink{
-		Name:        fmt.Sprintf("chelsea_%s", nRand),
-		Description: "the official oink for chelsea FC",
-		Creator:     u.ID,
+// setup checks the flags every command accepts and loads the config file.
+func (c *cli) setup() error {
+	switch c.output {
+	case "":
+		c.output = output.Table
+	case output.Table, output.JSON:
+	default:
+		return usageErrorf("--output must be json or table")
 	}
 
-	err = service.OinkService.Insert(context.Background(), oink)
-	if err != nil {
-		logger.Fatal().Err(err).Msg("user indert error")
+	if c.configPath == "" {
+		path, err := defaultConfigPath()
+		if err != nil {
+			return err
+		}
+		c.configPath = path
 	}
-	logger.Info().Any("oink", oink).Msg("oink insert")
 
-	// oinks, err := service.OinkService.List(context.Background())
-	// if err != nil {
-	// 	logger.Fatal().Err(err).Msg("user list error")
-	// }
-	// for _, o := range *oinks {
-	// 	logger.Info().Any("o", o).Msg("oink list")
-	// }
-
-	logger.Info().Any("oinkID", oink.ID).Msg("oink ID")
-	oo, err := service.OinkService.Retrieve(context.Background(), oink.ID)
+	config, err := loadConfig(c.configPath)
 	if err != nil {
-		logger.Fatal().Err(err).Msg("user retrieve error")
+		return err
 	}
-	logger.Info().Any("oo", oo).Msg("oink retrieve")
+	c.config = config
 
-	err = service.OinkService.Delete(context.Background(), oo.ID)
-	if err != nil {
-		logger.Fatal().Err(err).Msg("user delete error")
+	if c.server == "" {
+		c.server = os.Getenv("OINK_SERVER")
 	}
-	oo1, err := service.OinkService.Retrieve(context.Background(), oo.ID)
-	if err != nil {
-		logger.Fatal().Err(err).Msg("oink retrieve after delete error")
+	if c.server == "" {
+		c.server = c.config.Server
+	}
+	if c.server == "" {
+		c.server = defaultServer
+	}
+	return nil
+}
+
+// client returns a client of the server, logged in with the stored token
+// when authenticated is set. The token is only sent to the server it was
+// issued by.
+func (c *cli) client(authenticated bool) (*client.Client, error) {
+	config := client.Config{BaseURL: c.server}
+	if authenticated {
+		if c.config.Token == "" || c.config.Server != c.server {
+			return nil, fmt.Errorf("%w to %s, run oink login first", errNotLoggedIn, c.server)
+		}
+		config.Token = c.config.Token
 	}
-	logger.Info().Any("oo1", oo1).Msg("testing oink retrieve after delete")
+	return client.New(config), nil
 }
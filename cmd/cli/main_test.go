@@ -0,0 +1,153 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// fakeAPI answers the requests the CLI makes the way the server does.
+func fakeAPI(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	writeJSON := func(w http.ResponseWriter, status int, v interface{}) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		json.NewEncoder(w).Encode(v)
+	}
+	authorized := func(w http.ResponseWriter, r *http.Request) bool {
+		if r.Header.Get("Authorization") != "Bearer token" {
+			writeJSON(w, http.StatusForbidden, map[string]string{"error": "Forbidden"})
+			return false
+		}
+		return true
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/auth/login", func(w http.ResponseWriter, r *http.Request) {
+		var creds map[string]string
+		json.NewDecoder(r.Body).Decode(&creds)
+		if creds["password"] != "password" {
+			writeJSON(w, http.StatusForbidden, map[string]string{"error": "Invalid email/password"})
+			return
+		}
+		writeJSON(w, http.StatusOK, map[string]interface{}{"token": map[string]string{"token": "token"}})
+	})
+	mux.HandleFunc("/api/v1/auth/me", func(w http.ResponseWriter, r *http.Request) {
+		if authorized(w, r) {
+			writeJSON(w, http.StatusOK, map[string]interface{}{"user": map[string]string{"id": "u1", "username": "piggy", "email": "piggy@example.com"}})
+		}
+	})
+	mux.HandleFunc("/api/v1/oinks/", func(w http.ResponseWriter, r *http.Request) {
+		if !authorized(w, r) {
+			return
+		}
+		if strings.TrimPrefix(r.URL.Path, "/api/v1/oinks/") != "pigs" {
+			writeJSON(w, http.StatusNotFound, map[string]string{"error": "Oink does not exist"})
+			return
+		}
+
+		switch r.Method {
+		case http.MethodGet:
+			w.Header().Set("ETag", `"v1"`)
+			writeJSON(w, http.StatusOK, map[string]interface{}{"oink": map[string]interface{}{"name": "pigs", "visibility": "public", "tags": []string{"farm"}}})
+		case http.MethodDelete:
+			if r.Header.Get("If-Match") != `"v1"` {
+				writeJSON(w, http.StatusPreconditionFailed, map[string]string{"error": "Resource has changed since it was retrieved"})
+				return
+			}
+			w.WriteHeader(http.StatusNoContent)
+		}
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server
+}
+
+// runCLI runs the CLI with args against server, keeping its config in dir.
+func runCLI(t *testing.T, server *httptest.Server, dir string, stdin string, args ...string) (int, string, string) {
+	t.Helper()
+
+	var stdout, stderr bytes.Buffer
+	c := &cli{stdin: strings.NewReader(stdin), stdout: &stdout, stderr: &stderr}
+	args = append([]string{"--server", server.URL, "--config", filepath.Join(dir, "cli.json")}, args...)
+	code := c.run(context.Background(), args)
+
+	return code, stdout.String(), stderr.String()
+}
+
+func TestCLI(t *testing.T) {
+	server := fakeAPI(t)
+	dir := t.TempDir()
+
+	if code, _, stderr := runCLI(t, server, dir, "", "whoami"); code != exitAuth {
+		t.Fatalf("whoami before login exited with %d (%s), want %d", code, stderr, exitAuth)
+	}
+
+	if code, _, _ := runCLI(t, server, dir, "", "login", "--email", "piggy@example.com", "--password", "wrong"); code != exitAuth {
+		t.Fatalf("login with a wrong password exited with %d, want %d", code, exitAuth)
+	}
+
+	code, stdout, stderr := runCLI(t, server, dir, "piggy@example.com\npassword\n", "login")
+	if code != exitOK {
+		t.Fatalf("login exited with %d: %s", code, stderr)
+	}
+	if !strings.Contains(stdout, "piggy") {
+		t.Errorf("login printed %q, want the user", stdout)
+	}
+
+	info, err := os.Stat(filepath.Join(dir, "cli.json"))
+	if err != nil {
+		t.Fatalf("config file: %v", err)
+	}
+	if perm := info.Mode().Perm(); perm != 0o600 {
+		t.Errorf("config file mode = %o, want 600", perm)
+	}
+
+	code, stdout, _ = runCLI(t, server, dir, "", "whoami", "--output", "json")
+	if code != exitOK {
+		t.Fatalf("whoami exited with %d", code)
+	}
+	var user map[string]interface{}
+	if err := json.Unmarshal([]byte(stdout), &user); err != nil || user["username"] != "piggy" {
+		t.Errorf("whoami printed %q, want the user as JSON", stdout)
+	}
+
+	code, stdout, _ = runCLI(t, server, dir, "", "oinks", "get", "pigs")
+	if code != exitOK {
+		t.Fatalf("oinks get exited with %d", code)
+	}
+	if lines := strings.Split(strings.TrimSpace(stdout), "\n"); len(lines) != 2 || !strings.HasPrefix(lines[0], "NAME") || !strings.HasPrefix(lines[1], "pigs") {
+		t.Errorf("oinks get printed %q, want a table of the oink", stdout)
+	}
+
+	if code, _, _ := runCLI(t, server, dir, "", "oinks", "get", "cows"); code != exitNotFound {
+		t.Errorf("oinks get of a missing oink exited with %d, want %d", code, exitNotFound)
+	}
+	if code, _, stderr := runCLI(t, server, dir, "", "oinks", "delete", "pigs"); code != exitOK {
+		t.Errorf("oinks delete exited with %d: %s", code, stderr)
+	}
+	if code, _, _ := runCLI(t, server, dir, "", "oinks", "get"); code != exitUsage {
+		t.Errorf("oinks get without a name exited with %d, want %d", code, exitUsage)
+	}
+	if code, _, _ := runCLI(t, server, dir, "", "pigs"); code != exitUsage {
+		t.Errorf("an unknown command exited with %d, want %d", code, exitUsage)
+	}
+	if code, _, _ := runCLI(t, server, dir, "", "whoami", "--output", "yaml"); code != exitUsage {
+		t.Errorf("an unknown output format exited with %d, want %d", code, exitUsage)
+	}
+
+	if code, _, _ := runCLI(t, server, dir, "", "logout"); code != exitOK {
+		t.Fatalf("logout exited with %d", code)
+	}
+	if code, _, _ := runCLI(t, server, dir, "", "whoami"); code != exitAuth {
+		t.Errorf("whoami after logout exited with %d, want %d", code, exitAuth)
+	}
+}
@@ -0,0 +1,43 @@
+package main
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mrityunjaygr8/go-oink/internal/output"
+	"github.com/mrityunjaygr8/go-oink/pkg/client"
+)
+
+// print writes the result of a command in the format asked for with
+// --output.
+func (c *cli) print(v interface{}, header []string, rows [][]string) error {
+	return output.Print(c.stdout, c.output, v, header, rows)
+}
+
+var userHeader = []string{"ID", "USERNAME", "EMAIL", "CREATED"}
+
+func userRow(user client.User) []string {
+	return []string{user.ID, user.Username, user.Email, user.CreatedAt.Format(time.RFC3339)}
+}
+
+func (c *cli) printUser(user *client.User) error {
+	return c.print(user, userHeader, [][]string{userRow(*user)})
+}
+
+var oinkHeader = []string{"NAME", "VISIBILITY", "MEMBERS", "TAGS", "CREATED", "DESCRIPTION"}
+
+func oinkRow(oink client.Oink) []string {
+	return []string{
+		oink.Name,
+		oink.Visibility,
+		strconv.FormatInt(oink.MemberCount, 10),
+		strings.Join(oink.Tags, ","),
+		oink.CreatedAt.Format(time.RFC3339),
+		oink.Description,
+	}
+}
+
+func (c *cli) printOink(oink *client.Oink) error {
+	return c.print(oink, oinkHeader, [][]string{oinkRow(*oink)})
+}
@@ -0,0 +1,292 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/mrityunjaygr8/go-oink/internal/repository"
+	"github.com/mrityunjaygr8/go-oink/internal/validator"
+)
+
+// errInvalid marks input that breaks the rules the API enforces on it.
+var errInvalid = errors.New("invalid input")
+
+// validationError returns the failed checks of v as one error, or nil when
+// every check passed.
+func validationError(v *validator.Validator) error {
+	if v.Valid() {
+		return nil
+	}
+
+	var problems []string
+	for field, messages := range v.Errors {
+		for _, message := range messages {
+			problems = append(problems, field+" "+message)
+		}
+	}
+	sort.Strings(problems)
+	return fmt.Errorf("%w: %s", errInvalid, strings.Join(problems, ", "))
+}
+
+// lookupUser retrieves the user ref names, by email when it holds an @ and by
+// ID otherwise.
+func lookupUser(ctx context.Context, repo *repository.Repository, ref string) (*repository.User, error) {
+	if strings.Contains(ref, "@") {
+		return repo.UserRepository.UserRetrieveByEmail(ctx, ref)
+	}
+	if _, err := uuid.Parse(ref); err != nil {
+		return nil, usageErrorf("%q is neither a user ID nor an email", ref)
+	}
+	return repo.UserRepository.UserRetrieve(ctx, ref)
+}
+
+func (c *ctl) usersCreate(ctx context.Context, args []string) error {
+	fs := c.flags("users create")
+	email := fs.String("email", "", "")
+	username := fs.String("username", "", "")
+	password := fs.String("password", os.Getenv("OINK_PASSWORD"), "")
+	admin := fs.Bool("admin", false, "")
+	if positional, err := parse(fs, args); err != nil {
+		return err
+	} else if len(positional) > 0 {
+		return usageErrorf("users create takes no arguments")
+	}
+
+	v := validator.New()
+	validator.ValidateEmail(v, "email", *email)
+	validator.ValidateUsername(v, "username", *username)
+	validator.ValidatePassword(v, "password", *password)
+	if err := validationError(v); err != nil {
+		return err
+	}
+	if err := c.setup(ctx); err != nil {
+		return err
+	}
+
+	var created user
+	err := c.transact(ctx, func(repo *repository.Repository) error {
+		u, err := repo.UserRepository.UserCreate(ctx, *email, *password, *username)
+		if err != nil {
+			return err
+		}
+		if *admin {
+			if err := repo.UserRepository.UserSetAdmin(ctx, u.ID, true); err != nil {
+				return err
+			}
+			u.IsAdmin = true
+		}
+		created = newUser(u)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	return c.printUser(created)
+}
+
+func (c *ctl) usersResetPassword(ctx context.Context, args []string) error {
+	fs := c.flags("users reset-password")
+	password := fs.String("password", os.Getenv("OINK_PASSWORD"), "")
+	positional, err := parse(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 1 {
+		return usageErrorf("users reset-password takes the ID or email of a user")
+	}
+
+	v := validator.New()
+	validator.ValidatePassword(v, "password", *password)
+	if err := validationError(v); err != nil {
+		return err
+	}
+	if err := c.setup(ctx); err != nil {
+		return err
+	}
+
+	var updated user
+	err = c.transact(ctx, func(repo *repository.Repository) error {
+		u, err := lookupUser(ctx, repo, positional[0])
+		if err != nil {
+			return err
+		}
+		if err := repo.UserRepository.UserUpdatePassword(ctx, u.ID, *password); err != nil {
+			return err
+		}
+		updated = newUser(u)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	return c.printUser(updated)
+}
+
+func (c *ctl) tokensList(ctx context.Context, args []string) error {
+	fs := c.flags("tokens list")
+	positional, err := parse(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 1 {
+		return usageErrorf("tokens list takes the ID or email of a user")
+	}
+	if err := c.setup(ctx); err != nil {
+		return err
+	}
+
+	repo := repository.New(c.db, c.l)
+	u, err := lookupUser(ctx, repo, positional[0])
+	if err != nil {
+		return err
+	}
+	tokens, err := repo.TokenRepository.TokenListUser(ctx, u.ID)
+	if err != nil {
+		return err
+	}
+	return c.printTokens(newTokens(*tokens))
+}
+
+func (c *ctl) tokensRevoke(ctx context.Context, args []string) error {
+	fs := c.flags("tokens revoke")
+	prefix := fs.String("token", "", "")
+	all := fs.Bool("all", false, "")
+	positional, err := parse(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 1 {
+		return usageErrorf("tokens revoke takes the ID or email of a user")
+	}
+	if (*prefix == "") == !*all {
+		return usageErrorf("either --token or --all must be given")
+	}
+	if *prefix != "" && len(*prefix) < tokenPrefixChars {
+		return usageErrorf("--token must hold at least the %d characters tokens list prints", tokenPrefixChars)
+	}
+	if err := c.setup(ctx); err != nil {
+		return err
+	}
+
+	var revoked []token
+	err = c.transact(ctx, func(repo *repository.Repository) error {
+		u, err := lookupUser(ctx, repo, positional[0])
+		if err != nil {
+			return err
+		}
+
+		login, err := repo.TokenRepository.TokenListUserType(ctx, u.ID, repository.TokenTypeLogin)
+		if err != nil {
+			return err
+		}
+		tokens := *login
+		if !*all {
+			if tokens, err = matchToken(tokens, *prefix); err != nil {
+				return err
+			}
+		}
+
+		for _, t := range tokens {
+			if err := repo.TokenRepository.TokenLoginDelete(ctx, t.Token, u.ID); err != nil {
+				return err
+			}
+		}
+		revoked = newTokens(tokens)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	return c.printTokens(revoked)
+}
+
+// matchToken returns the one token of tokens that starts with prefix, which
+// may also be the whole token.
+func matchToken(tokens []repository.Token, prefix string) ([]repository.Token, error) {
+	var matched []repository.Token
+	for _, t := range tokens {
+		if strings.HasPrefix(t.Token, prefix) {
+			matched = append(matched, t)
+		}
+	}
+
+	switch len(matched) {
+	case 0:
+		return nil, repository.ErrTokenNotFound
+	case 1:
+		return matched, nil
+	}
+	return nil, usageErrorf("--token %s matches %d tokens, give more of it", prefix, len(matched))
+}
+
+func (c *ctl) oinksDelete(ctx context.Context, args []string) error {
+	fs := c.flags("oinks delete")
+	positional, err := parse(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 1 {
+		return usageErrorf("oinks delete takes the name of an oink")
+	}
+	if err := c.setup(ctx); err != nil {
+		return err
+	}
+
+	var deleted oink
+	err = c.transact(ctx, func(repo *repository.Repository) error {
+		o, err := repo.OinkRepository.OinkLock(ctx, positional[0])
+		if err != nil {
+			return err
+		}
+		if err := repo.OinkRepository.OinkDelete(ctx, o.Name); err != nil {
+			return err
+		}
+		deleted = newOink(o)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	return c.printOink(deleted)
+}
+
+func (c *ctl) oinksTransfer(ctx context.Context, args []string) error {
+	fs := c.flags("oinks transfer")
+	to := fs.String("to", "", "")
+	positional, err := parse(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 1 {
+		return usageErrorf("oinks transfer takes the name of an oink")
+	}
+	if *to == "" {
+		return usageErrorf("--to must be given")
+	}
+	if err := c.setup(ctx); err != nil {
+		return err
+	}
+
+	var owner member
+	err = c.transact(ctx, func(repo *repository.Repository) error {
+		u, err := lookupUser(ctx, repo, *to)
+		if err != nil {
+			return err
+		}
+		m, err := repo.OinkTransferRepository.OinkTransferAssign(ctx, positional[0], u.ID)
+		if err != nil {
+			return err
+		}
+		owner = member{Oink: positional[0], UserID: m.UserID, Username: u.Username, Role: m.Role}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	return c.printMember(owner)
+}
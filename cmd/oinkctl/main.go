@@ -0,0 +1,294 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+
+	_ "github.com/lib/pq"
+
+	"github.com/mrityunjaygr8/go-oink/internal/config"
+	"github.com/mrityunjaygr8/go-oink/internal/output"
+	"github.com/mrityunjaygr8/go-oink/internal/repository"
+	"github.com/rs/zerolog"
+)
+
+// The exit codes of oinkctl, the same as those of the oink CLI where they
+// overlap.
+const (
+	exitOK = 0
+	// exitError is any failure without a code of its own, such as the
+	// database being unreachable.
+	exitError = 1
+	// exitUsage is a command line that could not be parsed.
+	exitUsage = 2
+	// exitNotFound is a user, token or oink that does not exist.
+	exitNotFound = 4
+	// exitConflict is a user that already exists, or a change that is already
+	// in place.
+	exitConflict = 5
+	// exitInvalid is input that breaks the rules the API enforces.
+	exitInvalid = 6
+)
+
+const usage = `Usage: oinkctl [flags] <command> [arguments]
+
+Works on the database directly, for when the API is down or no admin can log
+in. Users are given by ID or email.
+
+Exit codes: 0 success, 1 failure, 2 bad command line, 4 not found, 5 already
+exists or already done, 6 invalid input.
+
+Commands:
+  users create                create a user
+      --email, --username, --password, --admin
+                              the password is also read from $OINK_PASSWORD
+  users reset-password <user> set the password of a user
+      --password              also read from $OINK_PASSWORD
+  tokens list <user>          list the tokens of a user, by their prefixes
+  tokens revoke <user>        revoke login tokens of a user
+      --token <prefix> | --all
+                              the prefix tokens list prints, or the whole
+                              token
+  oinks delete <name>         delete an oink
+  oinks transfer <name>       make a user the owner of an oink right away;
+      --to <user>             the previous owner becomes a moderator
+  migrations status           compare the applied migrations with the ones
+                              this binary was built with
+
+Flags, accepted before or after the command:
+  --config DIR                directory holding the .env file (default the
+                              working directory)
+  --dry-run                   run the command, then roll back its changes
+  --output json|table         output format (default table)
+`
+
+// errUsage marks errors in the command line.
+var errUsage = errors.New("usage")
+
+func usageErrorf(format string, args ...interface{}) error {
+	return fmt.Errorf("%w: "+format, append([]interface{}{errUsage}, args...)...)
+}
+
+// errHelp is returned when help was asked for with -h or --help.
+var errHelp = errors.New("help requested")
+
+// ctl holds what the commands share: the streams they use, the flags every
+// one of them accepts and the database.
+type ctl struct {
+	stdout io.Writer
+	stderr io.Writer
+	l      zerolog.Logger
+
+	configDir string
+	dryRun    bool
+	output    string
+
+	// db is opened from the config on first use unless it is set already.
+	db *sql.DB
+}
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	c := &ctl{
+		stdout: os.Stdout,
+		stderr: os.Stderr,
+		l:      zerolog.New(os.Stderr).Level(zerolog.ErrorLevel).With().Timestamp().Logger(),
+	}
+	code := c.run(ctx, os.Args[1:])
+	if c.db != nil {
+		c.db.Close()
+	}
+	os.Exit(code)
+}
+
+// run runs the command line args and returns the exit code.
+func (c *ctl) run(ctx context.Context, args []string) int {
+	err := c.dispatch(ctx, args)
+	if err == nil {
+		return exitOK
+	}
+	if errors.Is(err, errHelp) {
+		fmt.Fprint(c.stdout, usage)
+		return exitOK
+	}
+
+	if errors.Is(err, errUsage) {
+		fmt.Fprintf(c.stderr, "oinkctl: %s\n\n%s", strings.TrimPrefix(err.Error(), errUsage.Error()+": "), usage)
+	} else {
+		fmt.Fprintf(c.stderr, "oinkctl: %s\n", err)
+	}
+	return exitCode(err)
+}
+
+// exitCode maps err to the exit code oinkctl ends with.
+func exitCode(err error) int {
+	switch {
+	case err == nil:
+		return exitOK
+	case errors.Is(err, errUsage):
+		return exitUsage
+	case errors.Is(err, repository.ErrUserNotFound), errors.Is(err, repository.ErrTokenNotFound), errors.Is(err, repository.ErrOinkNotFound):
+		return exitNotFound
+	case errors.Is(err, repository.ErrUserExists), errors.Is(err, repository.ErrOinkTransferToOwner), errors.Is(err, repository.ErrOinkArchived):
+		return exitConflict
+	case errors.Is(err, errInvalid):
+		return exitInvalid
+	}
+	return exitError
+}
+
+// flags returns a flag set for command carrying the flags every command
+// accepts.
+func (c *ctl) flags(command string) *flag.FlagSet {
+	fs := flag.NewFlagSet(command, flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	fs.StringVar(&c.configDir, "config", c.configDir, "")
+	fs.BoolVar(&c.dryRun, "dry-run", c.dryRun, "")
+	fs.StringVar(&c.output, "output", c.output, "")
+	return fs
+}
+
+// flagError turns an error parsing flags into the one oinkctl ends with.
+func flagError(err error) error {
+	if errors.Is(err, flag.ErrHelp) {
+		return errHelp
+	}
+	return usageErrorf("%s", err)
+}
+
+// parse parses args with fs, letting flags and positional arguments mix, and
+// returns the positional ones.
+func parse(fs *flag.FlagSet, args []string) ([]string, error) {
+	var positional []string
+	for {
+		if err := fs.Parse(args); err != nil {
+			return nil, flagError(err)
+		}
+		if fs.NArg() == 0 {
+			return positional, nil
+		}
+		positional = append(positional, fs.Arg(0))
+		args = fs.Args()[1:]
+	}
+}
+
+func (c *ctl) dispatch(ctx context.Context, args []string) error {
+	fs := c.flags("oinkctl")
+	// the command and its arguments are parsed by the command itself, so
+	// only the flags in front of it are parsed here
+	if err := fs.Parse(args); err != nil {
+		return flagError(err)
+	}
+	args = fs.Args()
+	if len(args) == 0 {
+		return usageErrorf("a command must be given")
+	}
+
+	command, args := args[0], args[1:]
+	switch command {
+	case "users", "tokens", "oinks", "migrations":
+		if len(args) == 0 {
+			return usageErrorf("%s needs a subcommand", command)
+		}
+		subcommand, args := args[0], args[1:]
+		switch command + " " + subcommand {
+		case "users create":
+			return c.usersCreate(ctx, args)
+		case "users reset-password":
+			return c.usersResetPassword(ctx, args)
+		case "tokens list":
+			return c.tokensList(ctx, args)
+		case "tokens revoke":
+			return c.tokensRevoke(ctx, args)
+		case "oinks delete":
+			return c.oinksDelete(ctx, args)
+		case "oinks transfer":
+			return c.oinksTransfer(ctx, args)
+		case "migrations status":
+			return c.migrationsStatus(ctx, args)
+		}
+		return usageErrorf("unknown command %q", command+" "+subcommand)
+	case "help":
+		return errHelp
+	}
+
+	return usageErrorf("unknown command %q", command)
+}
+
+// setup checks the flags every command accepts and connects to the database
+// named by the config.
+func (c *ctl) setup(ctx context.Context) error {
+	switch c.output {
+	case "":
+		c.output = output.Table
+	case output.Table, output.JSON:
+	default:
+		return usageErrorf("--output must be json or table")
+	}
+
+	if c.db != nil {
+		return nil
+	}
+
+	dir := c.configDir
+	if dir == "" {
+		var err error
+		if dir, err = os.Getwd(); err != nil {
+			return err
+		}
+	}
+	conf, err := config.GetConfig(dir, c.l)
+	if err != nil {
+		return err
+	}
+	if conf.DbDsn == "" && conf.DbHost == "" {
+		return errors.New("DB configuration not found. Either specify the DSN or the individual components")
+	}
+	if conf.DbDsn == "" {
+		conf.DbDsn = fmt.Sprintf("postgres://%s:%s@%s:%d/%s?sslmode=%s", conf.DbUser, conf.DbPass, conf.DbHost, conf.DbPort, conf.DbName, conf.DbSSL)
+	}
+
+	db, err := sql.Open("postgres", conf.DbDsn)
+	if err != nil {
+		return err
+	}
+	if err := db.PingContext(ctx); err != nil {
+		db.Close()
+		return fmt.Errorf("connecting to the database: %w", err)
+	}
+	c.db = db
+	return nil
+}
+
+// transact runs fn with a repository bound to a transaction, which is
+// committed when fn succeeds, unless --dry-run is set, and rolled back
+// otherwise.
+func (c *ctl) transact(ctx context.Context, fn func(repo *repository.Repository) error) error {
+	tx, err := c.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := fn(repository.New(tx, c.l)); err != nil {
+		return err
+	}
+
+	if c.dryRun {
+		if err := tx.Rollback(); err != nil {
+			return err
+		}
+		fmt.Fprintln(c.stderr, "dry run, nothing was changed")
+		return nil
+	}
+	return tx.Commit()
+}
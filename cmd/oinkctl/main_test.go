@@ -0,0 +1,171 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/rs/zerolog"
+)
+
+// newCtl returns oinkctl working on a mock database, whose expectations must
+// all be met by the end of the test.
+func newCtl(t *testing.T) (*ctl, sqlmock.Sqlmock, *bytes.Buffer, *bytes.Buffer) {
+	t.Helper()
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("sqlmock.New: %v", err)
+	}
+	t.Cleanup(func() {
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Errorf("unmet sql expectations: %v", err)
+		}
+		db.Close()
+	})
+
+	var stdout, stderr bytes.Buffer
+	return &ctl{stdout: &stdout, stderr: &stderr, l: zerolog.Nop(), db: db}, mock, &stdout, &stderr
+}
+
+const userID = "0b6f1a1e-6c1b-4c52-9c3e-2f7a3a0d4b11"
+
+func TestResetPasswordDryRun(t *testing.T) {
+	c, mock, stdout, stderr := newCtl(t)
+	mock.ExpectBegin()
+	mock.ExpectQuery(`(?i)from "users"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "email", "username"}).AddRow(userID, "im@oink.in", "im"))
+	mock.ExpectQuery(`(?i)from "users"`).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	mock.ExpectQuery(`(?i)from "users"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "email", "username"}).AddRow(userID, "im@oink.in", "im"))
+	mock.ExpectExec(`(?i)update "users"`).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectRollback()
+
+	code := c.run(context.Background(), []string{"--dry-run", "users", "reset-password", "im@oink.in", "--password", "new-password"})
+	if code != exitOK {
+		t.Fatalf("reset-password exited with %d: %s", code, stderr)
+	}
+	if !strings.Contains(stdout.String(), "im@oink.in") {
+		t.Errorf("reset-password printed %q, want the user", stdout)
+	}
+	if !strings.Contains(stderr.String(), "dry run") {
+		t.Errorf("reset-password --dry-run did not say nothing was changed: %q", stderr)
+	}
+}
+
+func TestTokensListUserNotFound(t *testing.T) {
+	c, mock, _, _ := newCtl(t)
+	mock.ExpectQuery(`(?i)from "users"`).WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	if code := c.run(context.Background(), []string{"tokens", "list", userID}); code != exitNotFound {
+		t.Errorf("tokens list of a missing user exited with %d, want %d", code, exitNotFound)
+	}
+}
+
+func TestMigrationsStatus(t *testing.T) {
+	c, mock, stdout, _ := newCtl(t)
+	mock.ExpectQuery(`(?i)from schema_migrations`).
+		WillReturnRows(sqlmock.NewRows([]string{"version", "dirty"}).AddRow(14, false))
+
+	if code := c.run(context.Background(), []string{"migrations", "status", "--output", "json"}); code != exitOK {
+		t.Fatalf("migrations status exited with %d", code)
+	}
+
+	var status migrationStatus
+	if err := json.Unmarshal(stdout.Bytes(), &status); err != nil {
+		t.Fatalf("migrations status printed %q: %v", stdout, err)
+	}
+	if status.Version != 14 || status.Latest < 16 || !reflect.DeepEqual(status.Pending[:2], []int64{15, 16}) {
+		t.Errorf("migrations status = %+v, want version 14 with 15 and 16 pending", status)
+	}
+}
+
+func TestCommandLineErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+		want int
+	}{
+		{"no command", nil, exitUsage},
+		{"unknown command", []string{"pigs"}, exitUsage},
+		{"unknown subcommand", []string{"users", "delete"}, exitUsage},
+		{"transfer without --to", []string{"oinks", "transfer", "chelsea"}, exitUsage},
+		{"revoke without a token", []string{"tokens", "revoke", userID}, exitUsage},
+		{"revoke with both", []string{"tokens", "revoke", userID, "--all", "--token", "t"}, exitUsage},
+		{"bad user", []string{"tokens", "list", "im"}, exitUsage},
+		{"bad output", []string{"migrations", "status", "--output", "yaml"}, exitUsage},
+		{"long email", []string{"users", "create", "--email", strings.Repeat("i", 250) + "@oink.in", "--username", "im-pig", "--password", "password1"}, exitInvalid},
+		{"short password", []string{"users", "create", "--email", "im@oink.in", "--username", "im-pig", "--password", "oink"}, exitInvalid},
+		{"help", []string{"--help"}, exitOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, _, _, stderr := newCtl(t)
+			if code := c.run(context.Background(), tt.args); code != tt.want {
+				t.Errorf("exited with %d (%s), want %d", code, stderr, tt.want)
+			}
+		})
+	}
+}
+
+func TestTokens(t *testing.T) {
+	const (
+		first  = "3f2a9c1e-8d4b-4f6a-9e21-7b5c0d3a1f88"
+		second = "3f2a9c1e-1a2b-4c3d-8e4f-5a6b7c8d9e0f"
+		third  = "a81d44e0-6b2c-4e9f-b3a7-0c5d8e1f2a3b"
+	)
+	expectTokens := func(mock sqlmock.Sqlmock) {
+		mock.ExpectQuery(`(?i)from "users"`).WillReturnRows(sqlmock.NewRows([]string{"id", "email", "username"}).AddRow(userID, "im@oink.in", "im"))
+		rows := sqlmock.NewRows([]string{"token", "user", "type"})
+		for _, token := range []string{first, second, third} {
+			rows.AddRow(token, userID, "login")
+		}
+		mock.ExpectQuery(`(?i)from "tokens"`).WillReturnRows(rows)
+		mock.ExpectQuery(`(?i)from "users"`).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(userID))
+	}
+
+	t.Run("list-prints-prefixes", func(t *testing.T) {
+		c, mock, stdout, stderr := newCtl(t)
+		expectTokens(mock)
+
+		if code := c.run(context.Background(), []string{"tokens", "list", userID}); code != exitOK {
+			t.Fatalf("tokens list exited with %d: %s", code, stderr)
+		}
+		if !strings.Contains(stdout.String(), third[:tokenPrefixChars]) || strings.Contains(stdout.String(), third) {
+			t.Errorf("tokens list printed %q, want only the prefixes of the tokens", stdout)
+		}
+	})
+
+	t.Run("revoke-by-prefix", func(t *testing.T) {
+		c, mock, stdout, stderr := newCtl(t)
+		mock.ExpectBegin()
+		expectTokens(mock)
+		mock.ExpectQuery(`(?i)select count`).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+		mock.ExpectQuery(`(?i)from "tokens"`).WithArgs(third).WillReturnRows(sqlmock.NewRows([]string{"token", "user", "type"}).AddRow(third, userID, "login"))
+		mock.ExpectExec(`(?i)delete from "tokens"`).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		if code := c.run(context.Background(), []string{"tokens", "revoke", userID, "--token", third[:tokenPrefixChars]}); code != exitOK {
+			t.Fatalf("tokens revoke exited with %d: %s", code, stderr)
+		}
+		if !strings.Contains(stdout.String(), third[:tokenPrefixChars]) {
+			t.Errorf("tokens revoke printed %q, want the revoked token", stdout)
+		}
+	})
+
+	t.Run("revoke-ambiguous-prefix", func(t *testing.T) {
+		c, mock, _, _ := newCtl(t)
+		mock.ExpectBegin()
+		expectTokens(mock)
+		mock.ExpectRollback()
+
+		if code := c.run(context.Background(), []string{"tokens", "revoke", userID, "--token", first[:tokenPrefixChars]}); code != exitUsage {
+			t.Errorf("tokens revoke with a prefix of two tokens exited with %d, want %d", code, exitUsage)
+		}
+	})
+}
@@ -0,0 +1,95 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"io/fs"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/lib/pq"
+	"github.com/mrityunjaygr8/go-oink/internal/db/migrations"
+)
+
+// undefinedTable is the Postgres error code for a table that does not exist.
+const undefinedTable = "42P01"
+
+// migrationStatus compares the version golang-migrate recorded in the
+// database with the migrations oinkctl was built with. Version is 0 when no
+// migration was applied.
+type migrationStatus struct {
+	Version int64   `json:"version"`
+	Dirty   bool    `json:"dirty"`
+	Latest  int64   `json:"latest"`
+	Pending []int64 `json:"pending"`
+}
+
+// migrationVersions returns the versions of the migrations in fsys, in order.
+func migrationVersions(fsys fs.FS) ([]int64, error) {
+	names, err := fs.Glob(fsys, "*.up.sql")
+	if err != nil {
+		return nil, err
+	}
+
+	versions := make([]int64, 0, len(names))
+	for _, name := range names {
+		prefix, _, _ := strings.Cut(name, "_")
+		version, err := strconv.ParseInt(prefix, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		versions = append(versions, version)
+	}
+	sort.Slice(versions, func(i, j int) bool { return versions[i] < versions[j] })
+	return versions, nil
+}
+
+func (c *ctl) migrationsStatus(ctx context.Context, args []string) error {
+	fs := c.flags("migrations status")
+	if positional, err := parse(fs, args); err != nil {
+		return err
+	} else if len(positional) > 0 {
+		return usageErrorf("migrations status takes no arguments")
+	}
+	if err := c.setup(ctx); err != nil {
+		return err
+	}
+
+	versions, err := migrationVersions(migrations.FS)
+	if err != nil {
+		return err
+	}
+
+	var status migrationStatus
+	err = c.db.QueryRowContext(ctx, "SELECT version, dirty FROM schema_migrations LIMIT 1").Scan(&status.Version, &status.Dirty)
+	var pqErr *pq.Error
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+	case errors.As(err, &pqErr) && pqErr.Code == undefinedTable:
+	case err != nil:
+		return err
+	}
+
+	status.Pending = []int64{}
+	for _, version := range versions {
+		if version > status.Version {
+			status.Pending = append(status.Pending, version)
+		}
+	}
+	if len(versions) > 0 {
+		status.Latest = versions[len(versions)-1]
+	}
+
+	pending := make([]string, 0, len(status.Pending))
+	for _, version := range status.Pending {
+		pending = append(pending, strconv.FormatInt(version, 10))
+	}
+	return c.print(status, []string{"VERSION", "DIRTY", "LATEST", "PENDING"}, [][]string{{
+		strconv.FormatInt(status.Version, 10),
+		strconv.FormatBool(status.Dirty),
+		strconv.FormatInt(status.Latest, 10),
+		strings.Join(pending, ","),
+	}})
+}
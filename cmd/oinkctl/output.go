@@ -0,0 +1,104 @@
+package main
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/mrityunjaygr8/go-oink/internal/output"
+	"github.com/mrityunjaygr8/go-oink/internal/repository"
+)
+
+// print writes the result of a command in the format asked for with
+// --output.
+func (c *ctl) print(v interface{}, header []string, rows [][]string) error {
+	return output.Print(c.stdout, c.output, v, header, rows)
+}
+
+// user is what is printed of a user, which leaves out the password hash.
+type user struct {
+	ID        string    `json:"id"`
+	Username  string    `json:"username"`
+	Email     string    `json:"email"`
+	IsAdmin   bool      `json:"is_admin"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func newUser(u *repository.User) user {
+	return user{ID: u.ID, Username: u.Username, Email: u.Email, IsAdmin: u.IsAdmin, CreatedAt: u.CreatedAt}
+}
+
+var userHeader = []string{"ID", "USERNAME", "EMAIL", "ADMIN", "CREATED"}
+
+func (c *ctl) printUser(u user) error {
+	row := []string{u.ID, u.Username, u.Email, strconv.FormatBool(u.IsAdmin), u.CreatedAt.Format(time.RFC3339)}
+	return c.print(u, userHeader, [][]string{row})
+}
+
+// tokenPrefixChars is how much of a token is printed. Tokens are bearer
+// credentials, so only enough of them to tell them apart is shown.
+const tokenPrefixChars = 8
+
+// token is what is printed of a token, which leaves out all but its prefix.
+type token struct {
+	Prefix    string    `json:"prefix"`
+	Type      string    `json:"type"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+func newTokens(t []repository.Token) []token {
+	tokens := make([]token, 0, len(t))
+	for _, tok := range t {
+		prefix := tok.Token
+		if len(prefix) > tokenPrefixChars {
+			prefix = prefix[:tokenPrefixChars]
+		}
+		tokens = append(tokens, token{Prefix: prefix, Type: string(tok.Type), CreatedAt: tok.CreatedAt, UpdatedAt: tok.UpdatedAt})
+	}
+	return tokens
+}
+
+var tokenHeader = []string{"PREFIX", "TYPE", "CREATED", "UPDATED"}
+
+func (c *ctl) printTokens(tokens []token) error {
+	rows := make([][]string, 0, len(tokens))
+	for _, t := range tokens {
+		rows = append(rows, []string{t.Prefix, t.Type, t.CreatedAt.Format(time.RFC3339), t.UpdatedAt.Format(time.RFC3339)})
+	}
+	return c.print(struct {
+		Tokens []token `json:"tokens"`
+	}{tokens}, tokenHeader, rows)
+}
+
+type oink struct {
+	Name       string    `json:"name"`
+	ID         string    `json:"id"`
+	Creator    string    `json:"creator"`
+	Visibility string    `json:"visibility"`
+	Members    int64     `json:"member_count"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+func newOink(o *repository.Oink) oink {
+	return oink{Name: o.Name, ID: o.ID, Creator: o.CreatorID, Visibility: o.Visibility, Members: o.MemberCount, CreatedAt: o.CreatedAt}
+}
+
+var oinkHeader = []string{"NAME", "ID", "CREATOR", "VISIBILITY", "MEMBERS", "CREATED"}
+
+func (c *ctl) printOink(o oink) error {
+	row := []string{o.Name, o.ID, o.Creator, o.Visibility, strconv.FormatInt(o.Members, 10), o.CreatedAt.Format(time.RFC3339)}
+	return c.print(o, oinkHeader, [][]string{row})
+}
+
+type member struct {
+	Oink     string `json:"oink"`
+	UserID   string `json:"user_id"`
+	Username string `json:"username"`
+	Role     string `json:"role"`
+}
+
+var memberHeader = []string{"OINK", "USER", "USERNAME", "ROLE"}
+
+func (c *ctl) printMember(m member) error {
+	return c.print(m, memberHeader, [][]string{{m.Oink, m.UserID, m.Username, m.Role}})
+}
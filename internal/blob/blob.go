@@ -0,0 +1,54 @@
+// Package blob stores uploaded files, such as images, outside the database.
+package blob
+
+import (
+	"context"
+	"errors"
+	"io"
+	"path"
+	"strings"
+)
+
+var (
+	ErrNotFound   = errors.New("Blob not found")
+	ErrInvalidKey = errors.New("Blob key is invalid")
+)
+
+// Store keeps blobs under slash-separated keys such as
+// "oinks/<id>/avatar-<random>.png".
+type Store interface {
+	// Put stores the contents of r under key, replacing any previous blob.
+	Put(ctx context.Context, key string, r io.Reader, contentType string) error
+	// Get returns the contents of the blob under key and its content type.
+	// The caller closes the reader.
+	Get(ctx context.Context, key string) (io.ReadCloser, string, error)
+	// Delete removes the blob under key. Deleting a missing blob is not an
+	// error.
+	Delete(ctx context.Context, key string) error
+	// URL returns where clients can fetch the blob under key.
+	URL(key string) string
+}
+
+// validKey reports whether key is a clean, relative path that cannot escape
+// the root of a store.
+func validKey(key string) bool {
+	if key == "" || strings.HasPrefix(key, "/") || strings.Contains(key, "\\") {
+		return false
+	}
+
+	return path.Clean(key) == key && !strings.HasPrefix(key, "../") && key != ".."
+}
+
+// contentTypeByExt guesses the content type of key from its extension, for
+// stores that do not keep content types themselves.
+func contentTypeByExt(key string) string {
+	switch strings.ToLower(path.Ext(key)) {
+	case ".png":
+		return "image/png"
+	case ".jpg", ".jpeg":
+		return "image/jpeg"
+	case ".gif":
+		return "image/gif"
+	}
+	return "application/octet-stream"
+}
@@ -0,0 +1,53 @@
+package blob
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestLocalStore(t *testing.T) {
+	store := NewLocalStore(t.TempDir(), "/api/v1/blobs/")
+	ctx := context.Background()
+	key := "users/user-id/avatar.png"
+
+	if err := store.Put(ctx, key, strings.NewReader("png"), "image/png"); err != nil {
+		t.Fatalf("Put: %v", err)
+	}
+
+	r, contentType, err := store.Get(ctx, key)
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	got, _ := io.ReadAll(r)
+	r.Close()
+	if string(got) != "png" || contentType != "image/png" {
+		t.Fatalf("got %q (%s), want %q (image/png)", got, contentType, "png")
+	}
+
+	if err := store.Delete(ctx, key); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	if err := store.Delete(ctx, key); err != nil {
+		t.Fatalf("Delete of a missing blob: %v", err)
+	}
+	if _, _, err := store.Get(ctx, key); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("got %v, want %v", err, ErrNotFound)
+	}
+
+	if got := store.URL(key); got != "/api/v1/blobs/users/user-id/avatar.png" {
+		t.Fatalf("got URL %q", got)
+	}
+}
+
+func TestLocalStoreRejectsEscapingKeys(t *testing.T) {
+	store := NewLocalStore(t.TempDir(), "")
+
+	for _, key := range []string{"", "/etc/passwd", "../secret", "a/../../secret", "a//b", `a\b`} {
+		if err := store.Put(context.Background(), key, strings.NewReader(""), ""); !errors.Is(err, ErrInvalidKey) {
+			t.Errorf("Put(%q): got %v, want %v", key, err, ErrInvalidKey)
+		}
+	}
+}
@@ -1,6 +1,8 @@
 package config
 
 import (
+	"time"
+
 	"github.com/rs/zerolog"
 	// "github.com/sirupsen/logrus"
 	"github.com/spf13/viper"
@@ -24,8 +26,32 @@ type Config struct {
 	SrvAddr string `mapstructure:"SERVER_ADDR" json:"SERVER_ADDR"`
 	SrvPort int    `mapstructure:"SERVER_PORT" json:"SERVER_PORT"`
 	Env     string `mapstructure:"ENV" json:"ENV"`
+
+	SearchLanguage string `mapstructure:"SEARCH_LANGUAGE" json:"SEARCH_LANGUAGE"`
+
+	BlobBackend    string `mapstructure:"BLOB_BACKEND" json:"BLOB_BACKEND"`
+	BlobDir        string `mapstructure:"BLOB_DIR" json:"BLOB_DIR"`
+	ExportDir      string `mapstructure:"EXPORT_DIR" json:"EXPORT_DIR"`
+	BlobBaseURL    string `mapstructure:"BLOB_BASE_URL" json:"BLOB_BASE_URL"`
+	S3Endpoint     string `mapstructure:"S3_ENDPOINT" json:"S3_ENDPOINT"`
+	S3Region       string `mapstructure:"S3_REGION" json:"S3_REGION"`
+	S3Bucket       string `mapstructure:"S3_BUCKET" json:"S3_BUCKET"`
+	S3AccessKey    string `mapstructure:"S3_ACCESS_KEY" json:"S3_ACCESS_KEY"`
+	S3SecretKey    string `mapstructure:"S3_SECRET_KEY" json:"-"`
+	S3PublicURL    string `mapstructure:"S3_PUBLIC_URL" json:"S3_PUBLIC_URL"`
+	UploadMaxBytes int64  `mapstructure:"UPLOAD_MAX_BYTES" json:"UPLOAD_MAX_BYTES"`
+
+	PageDefaultLimit int `mapstructure:"PAGE_DEFAULT_LIMIT" json:"PAGE_DEFAULT_LIMIT"`
+	PageMaxLimit     int `mapstructure:"PAGE_MAX_LIMIT" json:"PAGE_MAX_LIMIT"`
+
+	IdempotencyTTL time.Duration `mapstructure:"IDEMPOTENCY_TTL" json:"IDEMPOTENCY_TTL"`
 }
 
+const (
+	BlobBackendLocal = "local"
+	BlobBackendS3    = "s3"
+)
+
 func GetConfig(path string, logger zerolog.Logger) (Config, error) {
 	var c Config
 
@@ -35,6 +61,16 @@ func GetConfig(path string, logger zerolog.Logger) (Config, error) {
 
 	viper.SetEnvPrefix("oink")
 	viper.SetDefault("ENV", EnvDevelopment)
+	viper.SetDefault("SEARCH_LANGUAGE", "english")
+	viper.SetDefault("BLOB_BACKEND", BlobBackendLocal)
+	viper.SetDefault("BLOB_DIR", "data/blobs")
+	viper.SetDefault("BLOB_BASE_URL", "/api/v1/blobs")
+	viper.SetDefault("EXPORT_DIR", "data/exports")
+	viper.SetDefault("S3_REGION", "us-east-1")
+	viper.SetDefault("UPLOAD_MAX_BYTES", 5<<20)
+	viper.SetDefault("PAGE_DEFAULT_LIMIT", 50)
+	viper.SetDefault("PAGE_MAX_LIMIT", 200)
+	viper.SetDefault("IDEMPOTENCY_TTL", "24h")
 
 	viper.BindEnv("SERVER_ADDR", "SERVER_ADDR")
 	viper.BindEnv("SERVER_PORT", "SERVER_PORT")
@@ -46,6 +82,21 @@ func GetConfig(path string, logger zerolog.Logger) (Config, error) {
 	viper.BindEnv("PSQL_DSN", "PSQL_DSN")
 	viper.BindEnv("PSQL_SSLMODE", "PSQL_SSLMODE")
 	viper.BindEnv("ENV", "ENV")
+	viper.BindEnv("SEARCH_LANGUAGE", "SEARCH_LANGUAGE")
+	viper.BindEnv("BLOB_BACKEND", "BLOB_BACKEND")
+	viper.BindEnv("BLOB_DIR", "BLOB_DIR")
+	viper.BindEnv("EXPORT_DIR", "EXPORT_DIR")
+	viper.BindEnv("BLOB_BASE_URL", "BLOB_BASE_URL")
+	viper.BindEnv("S3_ENDPOINT", "S3_ENDPOINT")
+	viper.BindEnv("S3_REGION", "S3_REGION")
+	viper.BindEnv("S3_BUCKET", "S3_BUCKET")
+	viper.BindEnv("S3_ACCESS_KEY", "S3_ACCESS_KEY")
+	viper.BindEnv("S3_SECRET_KEY", "S3_SECRET_KEY")
+	viper.BindEnv("S3_PUBLIC_URL", "S3_PUBLIC_URL")
+	viper.BindEnv("UPLOAD_MAX_BYTES", "UPLOAD_MAX_BYTES")
+	viper.BindEnv("PAGE_DEFAULT_LIMIT", "PAGE_DEFAULT_LIMIT")
+	viper.BindEnv("PAGE_MAX_LIMIT", "PAGE_MAX_LIMIT")
+	viper.BindEnv("IDEMPOTENCY_TTL", "IDEMPOTENCY_TTL")
 
 	err := viper.ReadInConfig()
 	if err != nil {
@@ -0,0 +1,4 @@
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+CREATE INDEX IF NOT EXISTS "idx_users_username_trgm" ON "users" USING gin ("username" gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS "idx_users_email_trgm" ON "users" USING gin ("email" gin_trgm_ops);
@@ -0,0 +1,7 @@
+CREATE TABLE IF NOT EXISTS "oink_aliases" (
+  "name" varchar PRIMARY KEY NOT NULL,
+  "oink" uuid NOT NULL,
+  "created_at" timestamptz NOT NULL
+);
+
+ALTER TABLE "oink_aliases" ADD CONSTRAINT "fk_oink_aliases_oink" FOREIGN KEY ("oink") REFERENCES "oinks" ("id") ON DELETE CASCADE;
@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS "oink_members" (
+  "oink" uuid NOT NULL,
+  "user" uuid NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  PRIMARY KEY ("oink", "user")
+);
+
+ALTER TABLE "oink_members" ADD CONSTRAINT "fk_oink_members_oink" FOREIGN KEY ("oink") REFERENCES "oinks" ("id") ON DELETE CASCADE;
+ALTER TABLE "oink_members" ADD CONSTRAINT "fk_oink_members_user" FOREIGN KEY ("user") REFERENCES "users" ("id") ON DELETE CASCADE;
+
+CREATE INDEX IF NOT EXISTS "idx_oink_members_user" ON "oink_members" ("user");
+
+INSERT INTO "oink_members" ("oink", "user", "created_at")
+SELECT "id", "creator", "created_at" FROM "oinks"
+ON CONFLICT DO NOTHING;
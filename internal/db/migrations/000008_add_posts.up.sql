@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS "posts" (
+  "id" uuid PRIMARY KEY NOT NULL,
+  "oink" uuid NOT NULL,
+  "author" uuid NOT NULL,
+  "body" text NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL
+);
+
+ALTER TABLE "posts" ADD CONSTRAINT "fk_posts_oink" FOREIGN KEY ("oink") REFERENCES "oinks" ("id") ON DELETE CASCADE;
+ALTER TABLE "posts" ADD CONSTRAINT "fk_posts_user" FOREIGN KEY ("author") REFERENCES "users" ("id") ON DELETE CASCADE;
+
+CREATE INDEX IF NOT EXISTS "idx_posts_oink_created_at" ON "posts" ("oink", "created_at" DESC, "id" DESC);
+CREATE INDEX IF NOT EXISTS "idx_posts_author" ON "posts" ("author");
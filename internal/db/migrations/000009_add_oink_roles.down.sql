@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS "oink_transfers";
+ALTER TABLE "oink_members" DROP COLUMN IF EXISTS "role";
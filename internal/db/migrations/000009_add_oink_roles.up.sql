@@ -0,0 +1,16 @@
+ALTER TABLE "oink_members" ADD COLUMN IF NOT EXISTS "role" varchar NOT NULL DEFAULT 'member';
+
+UPDATE "oink_members" SET "role" = 'owner'
+FROM "oinks"
+WHERE "oink_members"."oink" = "oinks"."id" AND "oink_members"."user" = "oinks"."creator";
+
+CREATE TABLE IF NOT EXISTS "oink_transfers" (
+  "oink" uuid PRIMARY KEY NOT NULL,
+  "from_user" uuid NOT NULL,
+  "to_user" uuid NOT NULL,
+  "created_at" timestamptz NOT NULL
+);
+
+ALTER TABLE "oink_transfers" ADD CONSTRAINT "fk_oink_transfers_oink" FOREIGN KEY ("oink") REFERENCES "oinks" ("id") ON DELETE CASCADE;
+ALTER TABLE "oink_transfers" ADD CONSTRAINT "fk_oink_transfers_from_user" FOREIGN KEY ("from_user") REFERENCES "users" ("id") ON DELETE CASCADE;
+ALTER TABLE "oink_transfers" ADD CONSTRAINT "fk_oink_transfers_to_user" FOREIGN KEY ("to_user") REFERENCES "users" ("id") ON DELETE CASCADE;
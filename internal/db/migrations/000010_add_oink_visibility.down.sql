@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS "oink_invitations";
+ALTER TABLE "oinks" DROP COLUMN IF EXISTS "visibility";
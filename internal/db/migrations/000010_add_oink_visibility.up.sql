@@ -0,0 +1,14 @@
+ALTER TABLE "oinks" ADD COLUMN IF NOT EXISTS "visibility" varchar NOT NULL DEFAULT 'public';
+
+CREATE TABLE IF NOT EXISTS "oink_invitations" (
+  "oink" uuid NOT NULL,
+  "user" uuid NOT NULL,
+  "kind" varchar NOT NULL,
+  "created_by" uuid NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  PRIMARY KEY ("oink", "user")
+);
+
+ALTER TABLE "oink_invitations" ADD CONSTRAINT "fk_oink_invitations_oink" FOREIGN KEY ("oink") REFERENCES "oinks" ("id") ON DELETE CASCADE;
+ALTER TABLE "oink_invitations" ADD CONSTRAINT "fk_oink_invitations_user" FOREIGN KEY ("user") REFERENCES "users" ("id") ON DELETE CASCADE;
+ALTER TABLE "oink_invitations" ADD CONSTRAINT "fk_oink_invitations_created_by" FOREIGN KEY ("created_by") REFERENCES "users" ("id") ON DELETE CASCADE;
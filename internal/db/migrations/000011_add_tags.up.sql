@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS "tags" (
+  "name" varchar PRIMARY KEY NOT NULL,
+  "created_at" timestamptz NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS "oink_tags" (
+  "oink" uuid NOT NULL,
+  "tag" varchar NOT NULL,
+  PRIMARY KEY ("oink", "tag")
+);
+
+ALTER TABLE "oink_tags" ADD CONSTRAINT "fk_oink_tags_oink" FOREIGN KEY ("oink") REFERENCES "oinks" ("id") ON DELETE CASCADE;
+ALTER TABLE "oink_tags" ADD CONSTRAINT "fk_oink_tags_tag" FOREIGN KEY ("tag") REFERENCES "tags" ("name") ON DELETE CASCADE;
+
+CREATE INDEX IF NOT EXISTS "idx_oink_tags_tag" ON "oink_tags" ("tag");
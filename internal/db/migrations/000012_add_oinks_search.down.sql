@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS "idx_oinks_search_vector";
+DROP TRIGGER IF EXISTS "oinks_search_vector_update" ON "oinks";
+DROP FUNCTION IF EXISTS "oinks_search_vector_update"();
+ALTER TABLE "oinks" DROP COLUMN IF EXISTS "search_vector";
+ALTER TABLE "oinks" DROP COLUMN IF EXISTS "language";
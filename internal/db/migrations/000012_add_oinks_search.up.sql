@@ -0,0 +1,22 @@
+ALTER TABLE "oinks" ADD COLUMN IF NOT EXISTS "language" varchar NOT NULL DEFAULT 'english';
+ALTER TABLE "oinks" ADD COLUMN IF NOT EXISTS "search_vector" tsvector;
+
+CREATE OR REPLACE FUNCTION "oinks_search_vector_update"() RETURNS trigger AS $$
+BEGIN
+  NEW."search_vector" :=
+    setweight(to_tsvector(NEW."language"::regconfig, coalesce(NEW."name", '')), 'A') ||
+    setweight(to_tsvector(NEW."language"::regconfig, coalesce(NEW."description", '')), 'B');
+  RETURN NEW;
+END
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS "oinks_search_vector_update" ON "oinks";
+CREATE TRIGGER "oinks_search_vector_update"
+  BEFORE INSERT OR UPDATE ON "oinks"
+  FOR EACH ROW EXECUTE FUNCTION "oinks_search_vector_update"();
+
+UPDATE "oinks" SET "search_vector" =
+  setweight(to_tsvector("language"::regconfig, coalesce("name", '')), 'A') ||
+  setweight(to_tsvector("language"::regconfig, coalesce("description", '')), 'B');
+
+CREATE INDEX IF NOT EXISTS "idx_oinks_search_vector" ON "oinks" USING GIN ("search_vector");
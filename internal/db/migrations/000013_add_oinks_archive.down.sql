@@ -0,0 +1,3 @@
+ALTER TABLE "oinks" DROP CONSTRAINT IF EXISTS "fk_oinks_archived_by";
+ALTER TABLE "oinks" DROP COLUMN IF EXISTS "archived_by";
+ALTER TABLE "oinks" DROP COLUMN IF EXISTS "archived_at";
@@ -0,0 +1,4 @@
+ALTER TABLE "oinks" ADD COLUMN IF NOT EXISTS "archived_at" timestamptz;
+ALTER TABLE "oinks" ADD COLUMN IF NOT EXISTS "archived_by" uuid;
+
+ALTER TABLE "oinks" ADD CONSTRAINT "fk_oinks_archived_by" FOREIGN KEY ("archived_by") REFERENCES "users" ("id") ON DELETE SET NULL;
@@ -0,0 +1,17 @@
+DELETE FROM "users"
+WHERE "id" = '00000000-0000-0000-0000-000000000000'
+  AND NOT EXISTS (SELECT 1 FROM "oinks" WHERE "creator" = '00000000-0000-0000-0000-000000000000')
+  AND NOT EXISTS (SELECT 1 FROM "posts" WHERE "author" = '00000000-0000-0000-0000-000000000000')
+  AND NOT EXISTS (SELECT 1 FROM "oink_invitations" WHERE "created_by" = '00000000-0000-0000-0000-000000000000');
+
+ALTER TABLE "oink_transfers" DROP CONSTRAINT IF EXISTS "fk_oink_transfers_to_user";
+ALTER TABLE "oink_transfers" ADD CONSTRAINT "fk_oink_transfers_to_user" FOREIGN KEY ("to_user") REFERENCES "users" ("id") ON DELETE CASCADE;
+ALTER TABLE "oink_transfers" DROP CONSTRAINT IF EXISTS "fk_oink_transfers_from_user";
+ALTER TABLE "oink_transfers" ADD CONSTRAINT "fk_oink_transfers_from_user" FOREIGN KEY ("from_user") REFERENCES "users" ("id") ON DELETE CASCADE;
+ALTER TABLE "oink_invitations" DROP CONSTRAINT IF EXISTS "fk_oink_invitations_created_by";
+ALTER TABLE "oink_invitations" ADD CONSTRAINT "fk_oink_invitations_created_by" FOREIGN KEY ("created_by") REFERENCES "users" ("id") ON DELETE CASCADE;
+ALTER TABLE "posts" DROP CONSTRAINT IF EXISTS "fk_posts_user";
+ALTER TABLE "posts" ADD CONSTRAINT "fk_posts_user" FOREIGN KEY ("author") REFERENCES "users" ("id") ON DELETE CASCADE;
+
+ALTER TABLE "oinks" DROP CONSTRAINT IF EXISTS "fk_oinks_user";
+ALTER TABLE "oinks" ADD CONSTRAINT "fk_oinks_user" FOREIGN KEY ("creator") REFERENCES "users" ("id") ON DELETE CASCADE;
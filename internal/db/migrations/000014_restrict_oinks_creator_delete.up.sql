@@ -0,0 +1,18 @@
+ALTER TABLE "oinks" DROP CONSTRAINT IF EXISTS "fk_oinks_user";
+ALTER TABLE "oinks" ADD CONSTRAINT "fk_oinks_user" FOREIGN KEY ("creator") REFERENCES "users" ("id") ON DELETE RESTRICT;
+
+-- what users wrote in other people's oinks is settled by UserDelete too
+ALTER TABLE "posts" DROP CONSTRAINT IF EXISTS "fk_posts_user";
+ALTER TABLE "posts" ADD CONSTRAINT "fk_posts_user" FOREIGN KEY ("author") REFERENCES "users" ("id") ON DELETE RESTRICT;
+ALTER TABLE "oink_invitations" DROP CONSTRAINT IF EXISTS "fk_oink_invitations_created_by";
+ALTER TABLE "oink_invitations" ADD CONSTRAINT "fk_oink_invitations_created_by" FOREIGN KEY ("created_by") REFERENCES "users" ("id") ON DELETE RESTRICT;
+ALTER TABLE "oink_transfers" DROP CONSTRAINT IF EXISTS "fk_oink_transfers_from_user";
+ALTER TABLE "oink_transfers" ADD CONSTRAINT "fk_oink_transfers_from_user" FOREIGN KEY ("from_user") REFERENCES "users" ("id") ON DELETE RESTRICT;
+ALTER TABLE "oink_transfers" DROP CONSTRAINT IF EXISTS "fk_oink_transfers_to_user";
+ALTER TABLE "oink_transfers" ADD CONSTRAINT "fk_oink_transfers_to_user" FOREIGN KEY ("to_user") REFERENCES "users" ("id") ON DELETE RESTRICT;
+
+-- the ghost account takes over the oinks of deleted users; its password is
+-- not a bcrypt hash, so nobody can log in as it
+INSERT INTO "users" ("email", "id", "password", "username", "is_admin", "created_at", "updated_at")
+VALUES ('ghost@oink.invalid', '00000000-0000-0000-0000-000000000000', '!', 'ghost', false, now(), now())
+ON CONFLICT DO NOTHING;
@@ -0,0 +1,3 @@
+ALTER TABLE "users" DROP COLUMN IF EXISTS "avatar_key";
+ALTER TABLE "oinks" DROP COLUMN IF EXISTS "banner_key";
+ALTER TABLE "oinks" DROP COLUMN IF EXISTS "avatar_key";
@@ -0,0 +1,3 @@
+ALTER TABLE "oinks" ADD COLUMN IF NOT EXISTS "avatar_key" varchar NOT NULL DEFAULT '';
+ALTER TABLE "oinks" ADD COLUMN IF NOT EXISTS "banner_key" varchar NOT NULL DEFAULT '';
+ALTER TABLE "users" ADD COLUMN IF NOT EXISTS "avatar_key" varchar NOT NULL DEFAULT '';
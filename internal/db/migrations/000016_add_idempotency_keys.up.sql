@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS "idempotency_keys" (
+  "user" uuid NOT NULL,
+  "key" varchar NOT NULL,
+  "fingerprint" varchar NOT NULL,
+  "status" integer NOT NULL DEFAULT 0,
+  "content_type" varchar NOT NULL DEFAULT '',
+  "body" bytea NOT NULL DEFAULT '',
+  "created_at" timestamptz NOT NULL,
+  "expires_at" timestamptz NOT NULL,
+  PRIMARY KEY ("user", "key")
+);
+
+ALTER TABLE "idempotency_keys" ADD CONSTRAINT "fk_idempotency_keys_user" FOREIGN KEY ("user") REFERENCES "users" ("id") ON DELETE CASCADE;
+
+CREATE INDEX IF NOT EXISTS "idx_idempotency_keys_expires_at" ON "idempotency_keys" ("expires_at");
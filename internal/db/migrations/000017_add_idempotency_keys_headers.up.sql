@@ -0,0 +1,2 @@
+ALTER TABLE "idempotency_keys" ADD COLUMN IF NOT EXISTS "headers" jsonb NOT NULL DEFAULT '{}';
+ALTER TABLE "idempotency_keys" ADD COLUMN IF NOT EXISTS "locked_until" timestamptz NOT NULL DEFAULT now();
@@ -0,0 +1,10 @@
+// Package migrations holds the schema migrations, applied with
+// golang-migrate, and embeds them so binaries can tell which ones exist.
+package migrations
+
+import "embed"
+
+// FS holds the up and down migration files.
+//
+//go:embed *.sql
+var FS embed.FS
@@ -12,87 +12,178 @@ import "testing"
 // It does NOT run each operation group in parallel.
 // Separating the tests thusly grants avoidance of Postgres deadlocks.
 func TestParent(t *testing.T) {
+	t.Run("IdempotencyKeys", testIdempotencyKeys)
+	t.Run("OinkAliases", testOinkAliases)
+	t.Run("OinkInvitations", testOinkInvitations)
+	t.Run("OinkMembers", testOinkMembers)
+	t.Run("OinkTransfers", testOinkTransfers)
 	t.Run("Oinks", testOinks)
+	t.Run("Posts", testPosts)
 	t.Run("SchemaMigrations", testSchemaMigrations)
+	t.Run("Tags", testTags)
 	t.Run("Tokens", testTokens)
 	t.Run("Users", testUsers)
 }
 
 func TestDelete(t *testing.T) {
+	t.Run("IdempotencyKeys", testIdempotencyKeysDelete)
+	t.Run("OinkAliases", testOinkAliasesDelete)
+	t.Run("OinkInvitations", testOinkInvitationsDelete)
+	t.Run("OinkMembers", testOinkMembersDelete)
+	t.Run("OinkTransfers", testOinkTransfersDelete)
 	t.Run("Oinks", testOinksDelete)
+	t.Run("Posts", testPostsDelete)
 	t.Run("SchemaMigrations", testSchemaMigrationsDelete)
+	t.Run("Tags", testTagsDelete)
 	t.Run("Tokens", testTokensDelete)
 	t.Run("Users", testUsersDelete)
 }
 
 func TestQueryDeleteAll(t *testing.T) {
+	t.Run("IdempotencyKeys", testIdempotencyKeysQueryDeleteAll)
+	t.Run("OinkAliases", testOinkAliasesQueryDeleteAll)
+	t.Run("OinkInvitations", testOinkInvitationsQueryDeleteAll)
+	t.Run("OinkMembers", testOinkMembersQueryDeleteAll)
+	t.Run("OinkTransfers", testOinkTransfersQueryDeleteAll)
 	t.Run("Oinks", testOinksQueryDeleteAll)
+	t.Run("Posts", testPostsQueryDeleteAll)
 	t.Run("SchemaMigrations", testSchemaMigrationsQueryDeleteAll)
+	t.Run("Tags", testTagsQueryDeleteAll)
 	t.Run("Tokens", testTokensQueryDeleteAll)
 	t.Run("Users", testUsersQueryDeleteAll)
 }
 
 func TestSliceDeleteAll(t *testing.T) {
+	t.Run("IdempotencyKeys", testIdempotencyKeysSliceDeleteAll)
+	t.Run("OinkAliases", testOinkAliasesSliceDeleteAll)
+	t.Run("OinkInvitations", testOinkInvitationsSliceDeleteAll)
+	t.Run("OinkMembers", testOinkMembersSliceDeleteAll)
+	t.Run("OinkTransfers", testOinkTransfersSliceDeleteAll)
 	t.Run("Oinks", testOinksSliceDeleteAll)
+	t.Run("Posts", testPostsSliceDeleteAll)
 	t.Run("SchemaMigrations", testSchemaMigrationsSliceDeleteAll)
+	t.Run("Tags", testTagsSliceDeleteAll)
 	t.Run("Tokens", testTokensSliceDeleteAll)
 	t.Run("Users", testUsersSliceDeleteAll)
 }
 
 func TestExists(t *testing.T) {
+	t.Run("IdempotencyKeys", testIdempotencyKeysExists)
+	t.Run("OinkAliases", testOinkAliasesExists)
+	t.Run("OinkInvitations", testOinkInvitationsExists)
+	t.Run("OinkMembers", testOinkMembersExists)
+	t.Run("OinkTransfers", testOinkTransfersExists)
 	t.Run("Oinks", testOinksExists)
+	t.Run("Posts", testPostsExists)
 	t.Run("SchemaMigrations", testSchemaMigrationsExists)
+	t.Run("Tags", testTagsExists)
 	t.Run("Tokens", testTokensExists)
 	t.Run("Users", testUsersExists)
 }
 
 func TestFind(t *testing.T) {
+	t.Run("IdempotencyKeys", testIdempotencyKeysFind)
+	t.Run("OinkAliases", testOinkAliasesFind)
+	t.Run("OinkInvitations", testOinkInvitationsFind)
+	t.Run("OinkMembers", testOinkMembersFind)
+	t.Run("OinkTransfers", testOinkTransfersFind)
 	t.Run("Oinks", testOinksFind)
+	t.Run("Posts", testPostsFind)
 	t.Run("SchemaMigrations", testSchemaMigrationsFind)
+	t.Run("Tags", testTagsFind)
 	t.Run("Tokens", testTokensFind)
 	t.Run("Users", testUsersFind)
 }
 
 func TestBind(t *testing.T) {
+	t.Run("IdempotencyKeys", testIdempotencyKeysBind)
+	t.Run("OinkAliases", testOinkAliasesBind)
+	t.Run("OinkInvitations", testOinkInvitationsBind)
+	t.Run("OinkMembers", testOinkMembersBind)
+	t.Run("OinkTransfers", testOinkTransfersBind)
 	t.Run("Oinks", testOinksBind)
+	t.Run("Posts", testPostsBind)
 	t.Run("SchemaMigrations", testSchemaMigrationsBind)
+	t.Run("Tags", testTagsBind)
 	t.Run("Tokens", testTokensBind)
 	t.Run("Users", testUsersBind)
 }
 
 func TestOne(t *testing.T) {
+	t.Run("IdempotencyKeys", testIdempotencyKeysOne)
+	t.Run("OinkAliases", testOinkAliasesOne)
+	t.Run("OinkInvitations", testOinkInvitationsOne)
+	t.Run("OinkMembers", testOinkMembersOne)
+	t.Run("OinkTransfers", testOinkTransfersOne)
 	t.Run("Oinks", testOinksOne)
+	t.Run("Posts", testPostsOne)
 	t.Run("SchemaMigrations", testSchemaMigrationsOne)
+	t.Run("Tags", testTagsOne)
 	t.Run("Tokens", testTokensOne)
 	t.Run("Users", testUsersOne)
 }
 
 func TestAll(t *testing.T) {
+	t.Run("IdempotencyKeys", testIdempotencyKeysAll)
+	t.Run("OinkAliases", testOinkAliasesAll)
+	t.Run("OinkInvitations", testOinkInvitationsAll)
+	t.Run("OinkMembers", testOinkMembersAll)
+	t.Run("OinkTransfers", testOinkTransfersAll)
 	t.Run("Oinks", testOinksAll)
+	t.Run("Posts", testPostsAll)
 	t.Run("SchemaMigrations", testSchemaMigrationsAll)
+	t.Run("Tags", testTagsAll)
 	t.Run("Tokens", testTokensAll)
 	t.Run("Users", testUsersAll)
 }
 
 func TestCount(t *testing.T) {
+	t.Run("IdempotencyKeys", testIdempotencyKeysCount)
+	t.Run("OinkAliases", testOinkAliasesCount)
+	t.Run("OinkInvitations", testOinkInvitationsCount)
+	t.Run("OinkMembers", testOinkMembersCount)
+	t.Run("OinkTransfers", testOinkTransfersCount)
 	t.Run("Oinks", testOinksCount)
+	t.Run("Posts", testPostsCount)
 	t.Run("SchemaMigrations", testSchemaMigrationsCount)
+	t.Run("Tags", testTagsCount)
 	t.Run("Tokens", testTokensCount)
 	t.Run("Users", testUsersCount)
 }
 
 func TestHooks(t *testing.T) {
+	t.Run("IdempotencyKeys", testIdempotencyKeysHooks)
+	t.Run("OinkAliases", testOinkAliasesHooks)
+	t.Run("OinkInvitations", testOinkInvitationsHooks)
+	t.Run("OinkMembers", testOinkMembersHooks)
+	t.Run("OinkTransfers", testOinkTransfersHooks)
 	t.Run("Oinks", testOinksHooks)
+	t.Run("Posts", testPostsHooks)
 	t.Run("SchemaMigrations", testSchemaMigrationsHooks)
+	t.Run("Tags", testTagsHooks)
 	t.Run("Tokens", testTokensHooks)
 	t.Run("Users", testUsersHooks)
 }
 
 func TestInsert(t *testing.T) {
+	t.Run("IdempotencyKeys", testIdempotencyKeysInsert)
+	t.Run("IdempotencyKeys", testIdempotencyKeysInsertWhitelist)
+	t.Run("OinkAliases", testOinkAliasesInsert)
+	t.Run("OinkAliases", testOinkAliasesInsertWhitelist)
+	t.Run("OinkInvitations", testOinkInvitationsInsert)
+	t.Run("OinkInvitations", testOinkInvitationsInsertWhitelist)
+	t.Run("OinkMembers", testOinkMembersInsert)
+	t.Run("OinkMembers", testOinkMembersInsertWhitelist)
+	t.Run("OinkTransfers", testOinkTransfersInsert)
+	t.Run("OinkTransfers", testOinkTransfersInsertWhitelist)
 	t.Run("Oinks", testOinksInsert)
 	t.Run("Oinks", testOinksInsertWhitelist)
+	t.Run("Posts", testPostsInsert)
+	t.Run("Posts", testPostsInsertWhitelist)
 	t.Run("SchemaMigrations", testSchemaMigrationsInsert)
 	t.Run("SchemaMigrations", testSchemaMigrationsInsertWhitelist)
+	t.Run("Tags", testTagsInsert)
+	t.Run("Tags", testTagsInsertWhitelist)
 	t.Run("Tokens", testTokensInsert)
 	t.Run("Tokens", testTokensInsertWhitelist)
 	t.Run("Users", testUsersInsert)
@@ -102,35 +193,81 @@ func TestInsert(t *testing.T) {
 // TestToOne tests cannot be run in parallel
 // or deadlocks can occur.
 func TestToOne(t *testing.T) {
+	t.Run("IdempotencyKeyToUserUsingIdempotencyKeyUser", testIdempotencyKeyToOneUserUsingIdempotencyKeyUser)
+	t.Run("OinkAliasToOinkUsingOinkAliasOink", testOinkAliasToOneOinkUsingOinkAliasOink)
+	t.Run("OinkInvitationToUserUsingCreatedByUser", testOinkInvitationToOneUserUsingCreatedByUser)
+	t.Run("OinkInvitationToOinkUsingOinkInvitationOink", testOinkInvitationToOneOinkUsingOinkInvitationOink)
+	t.Run("OinkInvitationToUserUsingOinkInvitationUser", testOinkInvitationToOneUserUsingOinkInvitationUser)
+	t.Run("OinkMemberToOinkUsingOinkMemberOink", testOinkMemberToOneOinkUsingOinkMemberOink)
+	t.Run("OinkMemberToUserUsingOinkMemberUser", testOinkMemberToOneUserUsingOinkMemberUser)
+	t.Run("OinkTransferToUserUsingFromUserUser", testOinkTransferToOneUserUsingFromUserUser)
+	t.Run("OinkTransferToOinkUsingOinkTransferOink", testOinkTransferToOneOinkUsingOinkTransferOink)
+	t.Run("OinkTransferToUserUsingToUserUser", testOinkTransferToOneUserUsingToUserUser)
+	t.Run("OinkToUserUsingArchivedByUser", testOinkToOneUserUsingArchivedByUser)
 	t.Run("OinkToUserUsingCreatorUser", testOinkToOneUserUsingCreatorUser)
+	t.Run("PostToOinkUsingPostOink", testPostToOneOinkUsingPostOink)
+	t.Run("PostToUserUsingAuthorUser", testPostToOneUserUsingAuthorUser)
 	t.Run("TokenToUserUsingTokenUser", testTokenToOneUserUsingTokenUser)
 }
 
 // TestOneToOne tests cannot be run in parallel
 // or deadlocks can occur.
-func TestOneToOne(t *testing.T) {}
+func TestOneToOne(t *testing.T) {
+	t.Run("OinkToOinkTransferUsingOinkTransfer", testOinkOneToOneOinkTransferUsingOinkTransfer)
+}
 
 // TestToMany tests cannot be run in parallel
 // or deadlocks can occur.
 func TestToMany(t *testing.T) {
+	t.Run("OinkToOinkAliases", testOinkToManyOinkAliases)
+	t.Run("OinkToOinkInvitations", testOinkToManyOinkInvitations)
+	t.Run("OinkToOinkMembers", testOinkToManyOinkMembers)
+	t.Run("OinkToTags", testOinkToManyTags)
+	t.Run("OinkToPosts", testOinkToManyPosts)
+	t.Run("TagToOinks", testTagToManyOinks)
+	t.Run("UserToIdempotencyKeys", testUserToManyIdempotencyKeys)
+	t.Run("UserToCreatedByOinkInvitations", testUserToManyCreatedByOinkInvitations)
+	t.Run("UserToOinkInvitations", testUserToManyOinkInvitations)
+	t.Run("UserToOinkMembers", testUserToManyOinkMembers)
+	t.Run("UserToFromUserOinkTransfers", testUserToManyFromUserOinkTransfers)
+	t.Run("UserToToUserOinkTransfers", testUserToManyToUserOinkTransfers)
+	t.Run("UserToArchivedByOinks", testUserToManyArchivedByOinks)
 	t.Run("UserToCreatorOinks", testUserToManyCreatorOinks)
+	t.Run("UserToAuthorPosts", testUserToManyAuthorPosts)
 	t.Run("UserToTokens", testUserToManyTokens)
 }
 
 // TestToOneSet tests cannot be run in parallel
 // or deadlocks can occur.
 func TestToOneSet(t *testing.T) {
+	t.Run("IdempotencyKeyToUserUsingIdempotencyKeys", testIdempotencyKeyToOneSetOpUserUsingIdempotencyKeyUser)
+	t.Run("OinkAliasToOinkUsingOinkAliases", testOinkAliasToOneSetOpOinkUsingOinkAliasOink)
+	t.Run("OinkInvitationToUserUsingCreatedByOinkInvitations", testOinkInvitationToOneSetOpUserUsingCreatedByUser)
+	t.Run("OinkInvitationToOinkUsingOinkInvitations", testOinkInvitationToOneSetOpOinkUsingOinkInvitationOink)
+	t.Run("OinkInvitationToUserUsingOinkInvitations", testOinkInvitationToOneSetOpUserUsingOinkInvitationUser)
+	t.Run("OinkMemberToOinkUsingOinkMembers", testOinkMemberToOneSetOpOinkUsingOinkMemberOink)
+	t.Run("OinkMemberToUserUsingOinkMembers", testOinkMemberToOneSetOpUserUsingOinkMemberUser)
+	t.Run("OinkTransferToUserUsingFromUserOinkTransfers", testOinkTransferToOneSetOpUserUsingFromUserUser)
+	t.Run("OinkTransferToOinkUsingOinkTransfer", testOinkTransferToOneSetOpOinkUsingOinkTransferOink)
+	t.Run("OinkTransferToUserUsingToUserOinkTransfers", testOinkTransferToOneSetOpUserUsingToUserUser)
+	t.Run("OinkToUserUsingArchivedByOinks", testOinkToOneSetOpUserUsingArchivedByUser)
 	t.Run("OinkToUserUsingCreatorOinks", testOinkToOneSetOpUserUsingCreatorUser)
+	t.Run("PostToOinkUsingPosts", testPostToOneSetOpOinkUsingPostOink)
+	t.Run("PostToUserUsingAuthorPosts", testPostToOneSetOpUserUsingAuthorUser)
 	t.Run("TokenToUserUsingTokens", testTokenToOneSetOpUserUsingTokenUser)
 }
 
 // TestToOneRemove tests cannot be run in parallel
 // or deadlocks can occur.
-func TestToOneRemove(t *testing.T) {}
+func TestToOneRemove(t *testing.T) {
+	t.Run("OinkToUserUsingArchivedByOinks", testOinkToOneRemoveOpUserUsingArchivedByUser)
+}
 
 // TestOneToOneSet tests cannot be run in parallel
 // or deadlocks can occur.
-func TestOneToOneSet(t *testing.T) {}
+func TestOneToOneSet(t *testing.T) {
+	t.Run("OinkToOinkTransferUsingOinkTransfer", testOinkOneToOneSetOpOinkTransferUsingOinkTransfer)
+}
 
 // TestOneToOneRemove tests cannot be run in parallel
 // or deadlocks can occur.
@@ -139,49 +276,106 @@ func TestOneToOneRemove(t *testing.T) {}
 // TestToManyAdd tests cannot be run in parallel
 // or deadlocks can occur.
 func TestToManyAdd(t *testing.T) {
+	t.Run("OinkToOinkAliases", testOinkToManyAddOpOinkAliases)
+	t.Run("OinkToOinkInvitations", testOinkToManyAddOpOinkInvitations)
+	t.Run("OinkToOinkMembers", testOinkToManyAddOpOinkMembers)
+	t.Run("OinkToTags", testOinkToManyAddOpTags)
+	t.Run("OinkToPosts", testOinkToManyAddOpPosts)
+	t.Run("TagToOinks", testTagToManyAddOpOinks)
+	t.Run("UserToIdempotencyKeys", testUserToManyAddOpIdempotencyKeys)
+	t.Run("UserToCreatedByOinkInvitations", testUserToManyAddOpCreatedByOinkInvitations)
+	t.Run("UserToOinkInvitations", testUserToManyAddOpOinkInvitations)
+	t.Run("UserToOinkMembers", testUserToManyAddOpOinkMembers)
+	t.Run("UserToFromUserOinkTransfers", testUserToManyAddOpFromUserOinkTransfers)
+	t.Run("UserToToUserOinkTransfers", testUserToManyAddOpToUserOinkTransfers)
+	t.Run("UserToArchivedByOinks", testUserToManyAddOpArchivedByOinks)
 	t.Run("UserToCreatorOinks", testUserToManyAddOpCreatorOinks)
+	t.Run("UserToAuthorPosts", testUserToManyAddOpAuthorPosts)
 	t.Run("UserToTokens", testUserToManyAddOpTokens)
 }
 
 // TestToManySet tests cannot be run in parallel
 // or deadlocks can occur.
-func TestToManySet(t *testing.T) {}
+func TestToManySet(t *testing.T) {
+	t.Run("OinkToTags", testOinkToManySetOpTags)
+	t.Run("TagToOinks", testTagToManySetOpOinks)
+	t.Run("UserToArchivedByOinks", testUserToManySetOpArchivedByOinks)
+}
 
 // TestToManyRemove tests cannot be run in parallel
 // or deadlocks can occur.
-func TestToManyRemove(t *testing.T) {}
+func TestToManyRemove(t *testing.T) {
+	t.Run("OinkToTags", testOinkToManyRemoveOpTags)
+	t.Run("TagToOinks", testTagToManyRemoveOpOinks)
+	t.Run("UserToArchivedByOinks", testUserToManyRemoveOpArchivedByOinks)
+}
 
 func TestReload(t *testing.T) {
+	t.Run("IdempotencyKeys", testIdempotencyKeysReload)
+	t.Run("OinkAliases", testOinkAliasesReload)
+	t.Run("OinkInvitations", testOinkInvitationsReload)
+	t.Run("OinkMembers", testOinkMembersReload)
+	t.Run("OinkTransfers", testOinkTransfersReload)
 	t.Run("Oinks", testOinksReload)
+	t.Run("Posts", testPostsReload)
 	t.Run("SchemaMigrations", testSchemaMigrationsReload)
+	t.Run("Tags", testTagsReload)
 	t.Run("Tokens", testTokensReload)
 	t.Run("Users", testUsersReload)
 }
 
 func TestReloadAll(t *testing.T) {
+	t.Run("IdempotencyKeys", testIdempotencyKeysReloadAll)
+	t.Run("OinkAliases", testOinkAliasesReloadAll)
+	t.Run("OinkInvitations", testOinkInvitationsReloadAll)
+	t.Run("OinkMembers", testOinkMembersReloadAll)
+	t.Run("OinkTransfers", testOinkTransfersReloadAll)
 	t.Run("Oinks", testOinksReloadAll)
+	t.Run("Posts", testPostsReloadAll)
 	t.Run("SchemaMigrations", testSchemaMigrationsReloadAll)
+	t.Run("Tags", testTagsReloadAll)
 	t.Run("Tokens", testTokensReloadAll)
 	t.Run("Users", testUsersReloadAll)
 }
 
 func TestSelect(t *testing.T) {
+	t.Run("IdempotencyKeys", testIdempotencyKeysSelect)
+	t.Run("OinkAliases", testOinkAliasesSelect)
+	t.Run("OinkInvitations", testOinkInvitationsSelect)
+	t.Run("OinkMembers", testOinkMembersSelect)
+	t.Run("OinkTransfers", testOinkTransfersSelect)
 	t.Run("Oinks", testOinksSelect)
+	t.Run("Posts", testPostsSelect)
 	t.Run("SchemaMigrations", testSchemaMigrationsSelect)
+	t.Run("Tags", testTagsSelect)
 	t.Run("Tokens", testTokensSelect)
 	t.Run("Users", testUsersSelect)
 }
 
 func TestUpdate(t *testing.T) {
+	t.Run("IdempotencyKeys", testIdempotencyKeysUpdate)
+	t.Run("OinkAliases", testOinkAliasesUpdate)
+	t.Run("OinkInvitations", testOinkInvitationsUpdate)
+	t.Run("OinkMembers", testOinkMembersUpdate)
+	t.Run("OinkTransfers", testOinkTransfersUpdate)
 	t.Run("Oinks", testOinksUpdate)
+	t.Run("Posts", testPostsUpdate)
 	t.Run("SchemaMigrations", testSchemaMigrationsUpdate)
+	t.Run("Tags", testTagsUpdate)
 	t.Run("Tokens", testTokensUpdate)
 	t.Run("Users", testUsersUpdate)
 }
 
 func TestSliceUpdateAll(t *testing.T) {
+	t.Run("IdempotencyKeys", testIdempotencyKeysSliceUpdateAll)
+	t.Run("OinkAliases", testOinkAliasesSliceUpdateAll)
+	t.Run("OinkInvitations", testOinkInvitationsSliceUpdateAll)
+	t.Run("OinkMembers", testOinkMembersSliceUpdateAll)
+	t.Run("OinkTransfers", testOinkTransfersSliceUpdateAll)
 	t.Run("Oinks", testOinksSliceUpdateAll)
+	t.Run("Posts", testPostsSliceUpdateAll)
 	t.Run("SchemaMigrations", testSchemaMigrationsSliceUpdateAll)
+	t.Run("Tags", testTagsSliceUpdateAll)
 	t.Run("Tokens", testTokensSliceUpdateAll)
 	t.Run("Users", testUsersSliceUpdateAll)
 }
@@ -4,13 +4,29 @@
 package dbmodels
 
 var TableNames = struct {
+	IdempotencyKeys  string
+	OinkAliases      string
+	OinkInvitations  string
+	OinkMembers      string
+	OinkTags         string
+	OinkTransfers    string
 	Oinks            string
+	Posts            string
 	SchemaMigrations string
+	Tags             string
 	Tokens           string
 	Users            string
 }{
+	IdempotencyKeys:  "idempotency_keys",
+	OinkAliases:      "oink_aliases",
+	OinkInvitations:  "oink_invitations",
+	OinkMembers:      "oink_members",
+	OinkTags:         "oink_tags",
+	OinkTransfers:    "oink_transfers",
 	Oinks:            "oinks",
+	Posts:            "posts",
 	SchemaMigrations: "schema_migrations",
+	Tags:             "tags",
 	Tokens:           "tokens",
 	Users:            "users",
 }
@@ -0,0 +1,1105 @@
+// Code generated by SQLBoiler 4.14.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dbmodels
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// OinkAlias is an object representing the database table.
+type OinkAlias struct {
+	Name      string    `boil:"name" json:"name" toml:"name" yaml:"name"`
+	Oink      string    `boil:"oink" json:"oink" toml:"oink" yaml:"oink"`
+	CreatedAt time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+
+	R *oinkAliasR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L oinkAliasL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var OinkAliasColumns = struct {
+	Name      string
+	Oink      string
+	CreatedAt string
+}{
+	Name:      "name",
+	Oink:      "oink",
+	CreatedAt: "created_at",
+}
+
+var OinkAliasTableColumns = struct {
+	Name      string
+	Oink      string
+	CreatedAt string
+}{
+	Name:      "oink_aliases.name",
+	Oink:      "oink_aliases.oink",
+	CreatedAt: "oink_aliases.created_at",
+}
+
+// Generated where
+
+var OinkAliasWhere = struct {
+	Name      whereHelperstring
+	Oink      whereHelperstring
+	CreatedAt whereHelpertime_Time
+}{
+	Name:      whereHelperstring{field: "\"oink_aliases\".\"name\""},
+	Oink:      whereHelperstring{field: "\"oink_aliases\".\"oink\""},
+	CreatedAt: whereHelpertime_Time{field: "\"oink_aliases\".\"created_at\""},
+}
+
+// OinkAliasRels is where relationship names are stored.
+var OinkAliasRels = struct {
+	OinkAliasOink string
+}{
+	OinkAliasOink: "OinkAliasOink",
+}
+
+// oinkAliasR is where relationships are stored.
+type oinkAliasR struct {
+	OinkAliasOink *Oink `boil:"OinkAliasOink" json:"OinkAliasOink" toml:"OinkAliasOink" yaml:"OinkAliasOink"`
+}
+
+// NewStruct creates a new relationship struct
+func (*oinkAliasR) NewStruct() *oinkAliasR {
+	return &oinkAliasR{}
+}
+
+func (r *oinkAliasR) GetOinkAliasOink() *Oink {
+	if r == nil {
+		return nil
+	}
+	return r.OinkAliasOink
+}
+
+// oinkAliasL is where Load methods for each relationship are stored.
+type oinkAliasL struct{}
+
+var (
+	oinkAliasAllColumns            = []string{"name", "oink", "created_at"}
+	oinkAliasColumnsWithoutDefault = []string{"name", "oink", "created_at"}
+	oinkAliasColumnsWithDefault    = []string{}
+	oinkAliasPrimaryKeyColumns     = []string{"name"}
+	oinkAliasGeneratedColumns      = []string{}
+)
+
+type (
+	// OinkAliasSlice is an alias for a slice of pointers to OinkAlias.
+	// This should almost always be used instead of []OinkAlias.
+	OinkAliasSlice []*OinkAlias
+	// OinkAliasHook is the signature for custom OinkAlias hook methods
+	OinkAliasHook func(context.Context, boil.ContextExecutor, *OinkAlias) error
+
+	oinkAliasQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	oinkAliasType                 = reflect.TypeOf(&OinkAlias{})
+	oinkAliasMapping              = queries.MakeStructMapping(oinkAliasType)
+	oinkAliasPrimaryKeyMapping, _ = queries.BindMapping(oinkAliasType, oinkAliasMapping, oinkAliasPrimaryKeyColumns)
+	oinkAliasInsertCacheMut       sync.RWMutex
+	oinkAliasInsertCache          = make(map[string]insertCache)
+	oinkAliasUpdateCacheMut       sync.RWMutex
+	oinkAliasUpdateCache          = make(map[string]updateCache)
+	oinkAliasUpsertCacheMut       sync.RWMutex
+	oinkAliasUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var oinkAliasAfterSelectHooks []OinkAliasHook
+
+var oinkAliasBeforeInsertHooks []OinkAliasHook
+var oinkAliasAfterInsertHooks []OinkAliasHook
+
+var oinkAliasBeforeUpdateHooks []OinkAliasHook
+var oinkAliasAfterUpdateHooks []OinkAliasHook
+
+var oinkAliasBeforeDeleteHooks []OinkAliasHook
+var oinkAliasAfterDeleteHooks []OinkAliasHook
+
+var oinkAliasBeforeUpsertHooks []OinkAliasHook
+var oinkAliasAfterUpsertHooks []OinkAliasHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *OinkAlias) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range oinkAliasAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *OinkAlias) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range oinkAliasBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *OinkAlias) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range oinkAliasAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *OinkAlias) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range oinkAliasBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *OinkAlias) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range oinkAliasAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *OinkAlias) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range oinkAliasBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *OinkAlias) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range oinkAliasAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *OinkAlias) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range oinkAliasBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *OinkAlias) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range oinkAliasAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddOinkAliasHook registers your hook function for all future operations.
+func AddOinkAliasHook(hookPoint boil.HookPoint, oinkAliasHook OinkAliasHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		oinkAliasAfterSelectHooks = append(oinkAliasAfterSelectHooks, oinkAliasHook)
+	case boil.BeforeInsertHook:
+		oinkAliasBeforeInsertHooks = append(oinkAliasBeforeInsertHooks, oinkAliasHook)
+	case boil.AfterInsertHook:
+		oinkAliasAfterInsertHooks = append(oinkAliasAfterInsertHooks, oinkAliasHook)
+	case boil.BeforeUpdateHook:
+		oinkAliasBeforeUpdateHooks = append(oinkAliasBeforeUpdateHooks, oinkAliasHook)
+	case boil.AfterUpdateHook:
+		oinkAliasAfterUpdateHooks = append(oinkAliasAfterUpdateHooks, oinkAliasHook)
+	case boil.BeforeDeleteHook:
+		oinkAliasBeforeDeleteHooks = append(oinkAliasBeforeDeleteHooks, oinkAliasHook)
+	case boil.AfterDeleteHook:
+		oinkAliasAfterDeleteHooks = append(oinkAliasAfterDeleteHooks, oinkAliasHook)
+	case boil.BeforeUpsertHook:
+		oinkAliasBeforeUpsertHooks = append(oinkAliasBeforeUpsertHooks, oinkAliasHook)
+	case boil.AfterUpsertHook:
+		oinkAliasAfterUpsertHooks = append(oinkAliasAfterUpsertHooks, oinkAliasHook)
+	}
+}
+
+// One returns a single oinkAlias record from the query.
+func (q oinkAliasQuery) One(ctx context.Context, exec boil.ContextExecutor) (*OinkAlias, error) {
+	o := &OinkAlias{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "dbmodels: failed to execute a one query for oink_aliases")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all OinkAlias records from the query.
+func (q oinkAliasQuery) All(ctx context.Context, exec boil.ContextExecutor) (OinkAliasSlice, error) {
+	var o []*OinkAlias
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "dbmodels: failed to assign all query results to OinkAlias slice")
+	}
+
+	if len(oinkAliasAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all OinkAlias records in the query.
+func (q oinkAliasQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to count oink_aliases rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q oinkAliasQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "dbmodels: failed to check if oink_aliases exists")
+	}
+
+	return count > 0, nil
+}
+
+// OinkAliasOink pointed to by the foreign key.
+func (o *OinkAlias) OinkAliasOink(mods ...qm.QueryMod) oinkQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.Oink),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Oinks(queryMods...)
+}
+
+// LoadOinkAliasOink allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (oinkAliasL) LoadOinkAliasOink(ctx context.Context, e boil.ContextExecutor, singular bool, maybeOinkAlias interface{}, mods queries.Applicator) error {
+	var slice []*OinkAlias
+	var object *OinkAlias
+
+	if singular {
+		var ok bool
+		object, ok = maybeOinkAlias.(*OinkAlias)
+		if !ok {
+			object = new(OinkAlias)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeOinkAlias)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeOinkAlias))
+			}
+		}
+	} else {
+		s, ok := maybeOinkAlias.(*[]*OinkAlias)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeOinkAlias)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeOinkAlias))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &oinkAliasR{}
+		}
+		args = append(args, object.Oink)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &oinkAliasR{}
+			}
+
+			for _, a := range args {
+				if a == obj.Oink {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.Oink)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`oinks`),
+		qm.WhereIn(`oinks.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Oink")
+	}
+
+	var resultSlice []*Oink
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Oink")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for oinks")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for oinks")
+	}
+
+	if len(oinkAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.OinkAliasOink = foreign
+		if foreign.R == nil {
+			foreign.R = &oinkR{}
+		}
+		foreign.R.OinkAliases = append(foreign.R.OinkAliases, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.Oink == foreign.ID {
+				local.R.OinkAliasOink = foreign
+				if foreign.R == nil {
+					foreign.R = &oinkR{}
+				}
+				foreign.R.OinkAliases = append(foreign.R.OinkAliases, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetOinkAliasOink of the oinkAlias to the related item.
+// Sets o.R.OinkAliasOink to related.
+// Adds o to related.R.OinkAliases.
+func (o *OinkAlias) SetOinkAliasOink(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Oink) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"oink_aliases\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"oink"}),
+		strmangle.WhereClause("\"", "\"", 2, oinkAliasPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.Name}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.Oink = related.ID
+	if o.R == nil {
+		o.R = &oinkAliasR{
+			OinkAliasOink: related,
+		}
+	} else {
+		o.R.OinkAliasOink = related
+	}
+
+	if related.R == nil {
+		related.R = &oinkR{
+			OinkAliases: OinkAliasSlice{o},
+		}
+	} else {
+		related.R.OinkAliases = append(related.R.OinkAliases, o)
+	}
+
+	return nil
+}
+
+// OinkAliases retrieves all the records using an executor.
+func OinkAliases(mods ...qm.QueryMod) oinkAliasQuery {
+	mods = append(mods, qm.From("\"oink_aliases\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"oink_aliases\".*"})
+	}
+
+	return oinkAliasQuery{q}
+}
+
+// FindOinkAlias retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindOinkAlias(ctx context.Context, exec boil.ContextExecutor, name string, selectCols ...string) (*OinkAlias, error) {
+	oinkAliasObj := &OinkAlias{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"oink_aliases\" where \"name\"=$1", sel,
+	)
+
+	q := queries.Raw(query, name)
+
+	err := q.Bind(ctx, exec, oinkAliasObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "dbmodels: unable to select from oink_aliases")
+	}
+
+	if err = oinkAliasObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return oinkAliasObj, err
+	}
+
+	return oinkAliasObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *OinkAlias) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("dbmodels: no oink_aliases provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(oinkAliasColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	oinkAliasInsertCacheMut.RLock()
+	cache, cached := oinkAliasInsertCache[key]
+	oinkAliasInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			oinkAliasAllColumns,
+			oinkAliasColumnsWithDefault,
+			oinkAliasColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(oinkAliasType, oinkAliasMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(oinkAliasType, oinkAliasMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"oink_aliases\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"oink_aliases\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to insert into oink_aliases")
+	}
+
+	if !cached {
+		oinkAliasInsertCacheMut.Lock()
+		oinkAliasInsertCache[key] = cache
+		oinkAliasInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the OinkAlias.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *OinkAlias) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	oinkAliasUpdateCacheMut.RLock()
+	cache, cached := oinkAliasUpdateCache[key]
+	oinkAliasUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			oinkAliasAllColumns,
+			oinkAliasPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("dbmodels: unable to update oink_aliases, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"oink_aliases\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, oinkAliasPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(oinkAliasType, oinkAliasMapping, append(wl, oinkAliasPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to update oink_aliases row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to get rows affected by update for oink_aliases")
+	}
+
+	if !cached {
+		oinkAliasUpdateCacheMut.Lock()
+		oinkAliasUpdateCache[key] = cache
+		oinkAliasUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q oinkAliasQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to update all for oink_aliases")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to retrieve rows affected for oink_aliases")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o OinkAliasSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("dbmodels: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), oinkAliasPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"oink_aliases\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, oinkAliasPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to update all in oinkAlias slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to retrieve rows affected all in update all oinkAlias")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *OinkAlias) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("dbmodels: no oink_aliases provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(oinkAliasColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	oinkAliasUpsertCacheMut.RLock()
+	cache, cached := oinkAliasUpsertCache[key]
+	oinkAliasUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			oinkAliasAllColumns,
+			oinkAliasColumnsWithDefault,
+			oinkAliasColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			oinkAliasAllColumns,
+			oinkAliasPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("dbmodels: unable to upsert oink_aliases, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(oinkAliasPrimaryKeyColumns))
+			copy(conflict, oinkAliasPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"oink_aliases\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(oinkAliasType, oinkAliasMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(oinkAliasType, oinkAliasMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to upsert oink_aliases")
+	}
+
+	if !cached {
+		oinkAliasUpsertCacheMut.Lock()
+		oinkAliasUpsertCache[key] = cache
+		oinkAliasUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single OinkAlias record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *OinkAlias) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("dbmodels: no OinkAlias provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), oinkAliasPrimaryKeyMapping)
+	sql := "DELETE FROM \"oink_aliases\" WHERE \"name\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to delete from oink_aliases")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to get rows affected by delete for oink_aliases")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q oinkAliasQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("dbmodels: no oinkAliasQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to delete all from oink_aliases")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to get rows affected by deleteall for oink_aliases")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o OinkAliasSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(oinkAliasBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), oinkAliasPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"oink_aliases\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, oinkAliasPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to delete all from oinkAlias slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to get rows affected by deleteall for oink_aliases")
+	}
+
+	if len(oinkAliasAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *OinkAlias) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindOinkAlias(ctx, exec, o.Name)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *OinkAliasSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := OinkAliasSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), oinkAliasPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"oink_aliases\".* FROM \"oink_aliases\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, oinkAliasPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to reload all in OinkAliasSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// OinkAliasExists checks if the OinkAlias row exists.
+func OinkAliasExists(ctx context.Context, exec boil.ContextExecutor, name string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"oink_aliases\" where \"name\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, name)
+	}
+	row := exec.QueryRowContext(ctx, sql, name)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "dbmodels: unable to check if oink_aliases exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the OinkAlias row exists.
+func (o *OinkAlias) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return OinkAliasExists(ctx, exec, o.Name)
+}
@@ -0,0 +1,851 @@
+// Code generated by SQLBoiler 4.14.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dbmodels
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testOinkAliases(t *testing.T) {
+	t.Parallel()
+
+	query := OinkAliases()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testOinkAliasesDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OinkAlias{}
+	if err = randomize.Struct(seed, o, oinkAliasDBTypes, true, oinkAliasColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OinkAlias struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := OinkAliases().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testOinkAliasesQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OinkAlias{}
+	if err = randomize.Struct(seed, o, oinkAliasDBTypes, true, oinkAliasColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OinkAlias struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := OinkAliases().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := OinkAliases().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testOinkAliasesSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OinkAlias{}
+	if err = randomize.Struct(seed, o, oinkAliasDBTypes, true, oinkAliasColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OinkAlias struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := OinkAliasSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := OinkAliases().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testOinkAliasesExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OinkAlias{}
+	if err = randomize.Struct(seed, o, oinkAliasDBTypes, true, oinkAliasColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OinkAlias struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := OinkAliasExists(ctx, tx, o.Name)
+	if err != nil {
+		t.Errorf("Unable to check if OinkAlias exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected OinkAliasExists to return true, but got false.")
+	}
+}
+
+func testOinkAliasesFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OinkAlias{}
+	if err = randomize.Struct(seed, o, oinkAliasDBTypes, true, oinkAliasColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OinkAlias struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	oinkAliasFound, err := FindOinkAlias(ctx, tx, o.Name)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if oinkAliasFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testOinkAliasesBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OinkAlias{}
+	if err = randomize.Struct(seed, o, oinkAliasDBTypes, true, oinkAliasColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OinkAlias struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = OinkAliases().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testOinkAliasesOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OinkAlias{}
+	if err = randomize.Struct(seed, o, oinkAliasDBTypes, true, oinkAliasColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OinkAlias struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := OinkAliases().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testOinkAliasesAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	oinkAliasOne := &OinkAlias{}
+	oinkAliasTwo := &OinkAlias{}
+	if err = randomize.Struct(seed, oinkAliasOne, oinkAliasDBTypes, false, oinkAliasColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OinkAlias struct: %s", err)
+	}
+	if err = randomize.Struct(seed, oinkAliasTwo, oinkAliasDBTypes, false, oinkAliasColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OinkAlias struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = oinkAliasOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = oinkAliasTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := OinkAliases().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testOinkAliasesCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	oinkAliasOne := &OinkAlias{}
+	oinkAliasTwo := &OinkAlias{}
+	if err = randomize.Struct(seed, oinkAliasOne, oinkAliasDBTypes, false, oinkAliasColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OinkAlias struct: %s", err)
+	}
+	if err = randomize.Struct(seed, oinkAliasTwo, oinkAliasDBTypes, false, oinkAliasColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OinkAlias struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = oinkAliasOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = oinkAliasTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := OinkAliases().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func oinkAliasBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *OinkAlias) error {
+	*o = OinkAlias{}
+	return nil
+}
+
+func oinkAliasAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *OinkAlias) error {
+	*o = OinkAlias{}
+	return nil
+}
+
+func oinkAliasAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *OinkAlias) error {
+	*o = OinkAlias{}
+	return nil
+}
+
+func oinkAliasBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *OinkAlias) error {
+	*o = OinkAlias{}
+	return nil
+}
+
+func oinkAliasAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *OinkAlias) error {
+	*o = OinkAlias{}
+	return nil
+}
+
+func oinkAliasBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *OinkAlias) error {
+	*o = OinkAlias{}
+	return nil
+}
+
+func oinkAliasAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *OinkAlias) error {
+	*o = OinkAlias{}
+	return nil
+}
+
+func oinkAliasBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *OinkAlias) error {
+	*o = OinkAlias{}
+	return nil
+}
+
+func oinkAliasAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *OinkAlias) error {
+	*o = OinkAlias{}
+	return nil
+}
+
+func testOinkAliasesHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &OinkAlias{}
+	o := &OinkAlias{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, oinkAliasDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize OinkAlias object: %s", err)
+	}
+
+	AddOinkAliasHook(boil.BeforeInsertHook, oinkAliasBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	oinkAliasBeforeInsertHooks = []OinkAliasHook{}
+
+	AddOinkAliasHook(boil.AfterInsertHook, oinkAliasAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	oinkAliasAfterInsertHooks = []OinkAliasHook{}
+
+	AddOinkAliasHook(boil.AfterSelectHook, oinkAliasAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	oinkAliasAfterSelectHooks = []OinkAliasHook{}
+
+	AddOinkAliasHook(boil.BeforeUpdateHook, oinkAliasBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	oinkAliasBeforeUpdateHooks = []OinkAliasHook{}
+
+	AddOinkAliasHook(boil.AfterUpdateHook, oinkAliasAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	oinkAliasAfterUpdateHooks = []OinkAliasHook{}
+
+	AddOinkAliasHook(boil.BeforeDeleteHook, oinkAliasBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	oinkAliasBeforeDeleteHooks = []OinkAliasHook{}
+
+	AddOinkAliasHook(boil.AfterDeleteHook, oinkAliasAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	oinkAliasAfterDeleteHooks = []OinkAliasHook{}
+
+	AddOinkAliasHook(boil.BeforeUpsertHook, oinkAliasBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	oinkAliasBeforeUpsertHooks = []OinkAliasHook{}
+
+	AddOinkAliasHook(boil.AfterUpsertHook, oinkAliasAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	oinkAliasAfterUpsertHooks = []OinkAliasHook{}
+}
+
+func testOinkAliasesInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OinkAlias{}
+	if err = randomize.Struct(seed, o, oinkAliasDBTypes, true, oinkAliasColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OinkAlias struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := OinkAliases().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testOinkAliasesInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OinkAlias{}
+	if err = randomize.Struct(seed, o, oinkAliasDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize OinkAlias struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(oinkAliasColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := OinkAliases().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testOinkAliasToOneOinkUsingOinkAliasOink(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local OinkAlias
+	var foreign Oink
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, oinkAliasDBTypes, false, oinkAliasColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OinkAlias struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, oinkDBTypes, false, oinkColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Oink struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.Oink = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.OinkAliasOink().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	ranAfterSelectHook := false
+	AddOinkHook(boil.AfterSelectHook, func(ctx context.Context, e boil.ContextExecutor, o *Oink) error {
+		ranAfterSelectHook = true
+		return nil
+	})
+
+	slice := OinkAliasSlice{&local}
+	if err = local.L.LoadOinkAliasOink(ctx, tx, false, (*[]*OinkAlias)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.OinkAliasOink == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.OinkAliasOink = nil
+	if err = local.L.LoadOinkAliasOink(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.OinkAliasOink == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	if !ranAfterSelectHook {
+		t.Error("failed to run AfterSelect hook for relationship")
+	}
+}
+
+func testOinkAliasToOneSetOpOinkUsingOinkAliasOink(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a OinkAlias
+	var b, c Oink
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, oinkAliasDBTypes, false, strmangle.SetComplement(oinkAliasPrimaryKeyColumns, oinkAliasColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, oinkDBTypes, false, strmangle.SetComplement(oinkPrimaryKeyColumns, oinkColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, oinkDBTypes, false, strmangle.SetComplement(oinkPrimaryKeyColumns, oinkColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Oink{&b, &c} {
+		err = a.SetOinkAliasOink(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.OinkAliasOink != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.OinkAliases[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.Oink != x.ID {
+			t.Error("foreign key was wrong value", a.Oink)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.Oink))
+		reflect.Indirect(reflect.ValueOf(&a.Oink)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.Oink != x.ID {
+			t.Error("foreign key was wrong value", a.Oink, x.ID)
+		}
+	}
+}
+
+func testOinkAliasesReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OinkAlias{}
+	if err = randomize.Struct(seed, o, oinkAliasDBTypes, true, oinkAliasColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OinkAlias struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testOinkAliasesReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OinkAlias{}
+	if err = randomize.Struct(seed, o, oinkAliasDBTypes, true, oinkAliasColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OinkAlias struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := OinkAliasSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testOinkAliasesSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OinkAlias{}
+	if err = randomize.Struct(seed, o, oinkAliasDBTypes, true, oinkAliasColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OinkAlias struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := OinkAliases().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	oinkAliasDBTypes = map[string]string{`Name`: `character varying`, `Oink`: `uuid`, `CreatedAt`: `timestamp with time zone`}
+	_                = bytes.MinRead
+)
+
+func testOinkAliasesUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(oinkAliasPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(oinkAliasAllColumns) == len(oinkAliasPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OinkAlias{}
+	if err = randomize.Struct(seed, o, oinkAliasDBTypes, true, oinkAliasColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OinkAlias struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := OinkAliases().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, oinkAliasDBTypes, true, oinkAliasPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize OinkAlias struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testOinkAliasesSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(oinkAliasAllColumns) == len(oinkAliasPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OinkAlias{}
+	if err = randomize.Struct(seed, o, oinkAliasDBTypes, true, oinkAliasColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OinkAlias struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := OinkAliases().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, oinkAliasDBTypes, true, oinkAliasPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize OinkAlias struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(oinkAliasAllColumns, oinkAliasPrimaryKeyColumns) {
+		fields = oinkAliasAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			oinkAliasAllColumns,
+			oinkAliasPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := OinkAliasSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testOinkAliasesUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(oinkAliasAllColumns) == len(oinkAliasPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := OinkAlias{}
+	if err = randomize.Struct(seed, &o, oinkAliasDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize OinkAlias struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert OinkAlias: %s", err)
+	}
+
+	count, err := OinkAliases().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, oinkAliasDBTypes, false, oinkAliasPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize OinkAlias struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert OinkAlias: %s", err)
+	}
+
+	count, err = OinkAliases().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
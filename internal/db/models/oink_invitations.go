@@ -0,0 +1,1495 @@
+// Code generated by SQLBoiler 4.14.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dbmodels
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// OinkInvitation is an object representing the database table.
+type OinkInvitation struct {
+	Oink      string    `boil:"oink" json:"oink" toml:"oink" yaml:"oink"`
+	User      string    `boil:"user" json:"user" toml:"user" yaml:"user"`
+	Kind      string    `boil:"kind" json:"kind" toml:"kind" yaml:"kind"`
+	CreatedBy string    `boil:"created_by" json:"created_by" toml:"created_by" yaml:"created_by"`
+	CreatedAt time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+
+	R *oinkInvitationR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L oinkInvitationL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var OinkInvitationColumns = struct {
+	Oink      string
+	User      string
+	Kind      string
+	CreatedBy string
+	CreatedAt string
+}{
+	Oink:      "oink",
+	User:      "user",
+	Kind:      "kind",
+	CreatedBy: "created_by",
+	CreatedAt: "created_at",
+}
+
+var OinkInvitationTableColumns = struct {
+	Oink      string
+	User      string
+	Kind      string
+	CreatedBy string
+	CreatedAt string
+}{
+	Oink:      "oink_invitations.oink",
+	User:      "oink_invitations.user",
+	Kind:      "oink_invitations.kind",
+	CreatedBy: "oink_invitations.created_by",
+	CreatedAt: "oink_invitations.created_at",
+}
+
+// Generated where
+
+var OinkInvitationWhere = struct {
+	Oink      whereHelperstring
+	User      whereHelperstring
+	Kind      whereHelperstring
+	CreatedBy whereHelperstring
+	CreatedAt whereHelpertime_Time
+}{
+	Oink:      whereHelperstring{field: "\"oink_invitations\".\"oink\""},
+	User:      whereHelperstring{field: "\"oink_invitations\".\"user\""},
+	Kind:      whereHelperstring{field: "\"oink_invitations\".\"kind\""},
+	CreatedBy: whereHelperstring{field: "\"oink_invitations\".\"created_by\""},
+	CreatedAt: whereHelpertime_Time{field: "\"oink_invitations\".\"created_at\""},
+}
+
+// OinkInvitationRels is where relationship names are stored.
+var OinkInvitationRels = struct {
+	CreatedByUser      string
+	OinkInvitationOink string
+	OinkInvitationUser string
+}{
+	CreatedByUser:      "CreatedByUser",
+	OinkInvitationOink: "OinkInvitationOink",
+	OinkInvitationUser: "OinkInvitationUser",
+}
+
+// oinkInvitationR is where relationships are stored.
+type oinkInvitationR struct {
+	CreatedByUser      *User `boil:"CreatedByUser" json:"CreatedByUser" toml:"CreatedByUser" yaml:"CreatedByUser"`
+	OinkInvitationOink *Oink `boil:"OinkInvitationOink" json:"OinkInvitationOink" toml:"OinkInvitationOink" yaml:"OinkInvitationOink"`
+	OinkInvitationUser *User `boil:"OinkInvitationUser" json:"OinkInvitationUser" toml:"OinkInvitationUser" yaml:"OinkInvitationUser"`
+}
+
+// NewStruct creates a new relationship struct
+func (*oinkInvitationR) NewStruct() *oinkInvitationR {
+	return &oinkInvitationR{}
+}
+
+func (r *oinkInvitationR) GetCreatedByUser() *User {
+	if r == nil {
+		return nil
+	}
+	return r.CreatedByUser
+}
+
+func (r *oinkInvitationR) GetOinkInvitationOink() *Oink {
+	if r == nil {
+		return nil
+	}
+	return r.OinkInvitationOink
+}
+
+func (r *oinkInvitationR) GetOinkInvitationUser() *User {
+	if r == nil {
+		return nil
+	}
+	return r.OinkInvitationUser
+}
+
+// oinkInvitationL is where Load methods for each relationship are stored.
+type oinkInvitationL struct{}
+
+var (
+	oinkInvitationAllColumns            = []string{"oink", "user", "kind", "created_by", "created_at"}
+	oinkInvitationColumnsWithoutDefault = []string{"oink", "user", "kind", "created_by", "created_at"}
+	oinkInvitationColumnsWithDefault    = []string{}
+	oinkInvitationPrimaryKeyColumns     = []string{"oink", "user"}
+	oinkInvitationGeneratedColumns      = []string{}
+)
+
+type (
+	// OinkInvitationSlice is an alias for a slice of pointers to OinkInvitation.
+	// This should almost always be used instead of []OinkInvitation.
+	OinkInvitationSlice []*OinkInvitation
+	// OinkInvitationHook is the signature for custom OinkInvitation hook methods
+	OinkInvitationHook func(context.Context, boil.ContextExecutor, *OinkInvitation) error
+
+	oinkInvitationQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	oinkInvitationType                 = reflect.TypeOf(&OinkInvitation{})
+	oinkInvitationMapping              = queries.MakeStructMapping(oinkInvitationType)
+	oinkInvitationPrimaryKeyMapping, _ = queries.BindMapping(oinkInvitationType, oinkInvitationMapping, oinkInvitationPrimaryKeyColumns)
+	oinkInvitationInsertCacheMut       sync.RWMutex
+	oinkInvitationInsertCache          = make(map[string]insertCache)
+	oinkInvitationUpdateCacheMut       sync.RWMutex
+	oinkInvitationUpdateCache          = make(map[string]updateCache)
+	oinkInvitationUpsertCacheMut       sync.RWMutex
+	oinkInvitationUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var oinkInvitationAfterSelectHooks []OinkInvitationHook
+
+var oinkInvitationBeforeInsertHooks []OinkInvitationHook
+var oinkInvitationAfterInsertHooks []OinkInvitationHook
+
+var oinkInvitationBeforeUpdateHooks []OinkInvitationHook
+var oinkInvitationAfterUpdateHooks []OinkInvitationHook
+
+var oinkInvitationBeforeDeleteHooks []OinkInvitationHook
+var oinkInvitationAfterDeleteHooks []OinkInvitationHook
+
+var oinkInvitationBeforeUpsertHooks []OinkInvitationHook
+var oinkInvitationAfterUpsertHooks []OinkInvitationHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *OinkInvitation) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range oinkInvitationAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *OinkInvitation) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range oinkInvitationBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *OinkInvitation) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range oinkInvitationAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *OinkInvitation) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range oinkInvitationBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *OinkInvitation) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range oinkInvitationAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *OinkInvitation) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range oinkInvitationBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *OinkInvitation) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range oinkInvitationAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *OinkInvitation) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range oinkInvitationBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *OinkInvitation) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range oinkInvitationAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddOinkInvitationHook registers your hook function for all future operations.
+func AddOinkInvitationHook(hookPoint boil.HookPoint, oinkInvitationHook OinkInvitationHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		oinkInvitationAfterSelectHooks = append(oinkInvitationAfterSelectHooks, oinkInvitationHook)
+	case boil.BeforeInsertHook:
+		oinkInvitationBeforeInsertHooks = append(oinkInvitationBeforeInsertHooks, oinkInvitationHook)
+	case boil.AfterInsertHook:
+		oinkInvitationAfterInsertHooks = append(oinkInvitationAfterInsertHooks, oinkInvitationHook)
+	case boil.BeforeUpdateHook:
+		oinkInvitationBeforeUpdateHooks = append(oinkInvitationBeforeUpdateHooks, oinkInvitationHook)
+	case boil.AfterUpdateHook:
+		oinkInvitationAfterUpdateHooks = append(oinkInvitationAfterUpdateHooks, oinkInvitationHook)
+	case boil.BeforeDeleteHook:
+		oinkInvitationBeforeDeleteHooks = append(oinkInvitationBeforeDeleteHooks, oinkInvitationHook)
+	case boil.AfterDeleteHook:
+		oinkInvitationAfterDeleteHooks = append(oinkInvitationAfterDeleteHooks, oinkInvitationHook)
+	case boil.BeforeUpsertHook:
+		oinkInvitationBeforeUpsertHooks = append(oinkInvitationBeforeUpsertHooks, oinkInvitationHook)
+	case boil.AfterUpsertHook:
+		oinkInvitationAfterUpsertHooks = append(oinkInvitationAfterUpsertHooks, oinkInvitationHook)
+	}
+}
+
+// One returns a single oinkInvitation record from the query.
+func (q oinkInvitationQuery) One(ctx context.Context, exec boil.ContextExecutor) (*OinkInvitation, error) {
+	o := &OinkInvitation{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "dbmodels: failed to execute a one query for oink_invitations")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all OinkInvitation records from the query.
+func (q oinkInvitationQuery) All(ctx context.Context, exec boil.ContextExecutor) (OinkInvitationSlice, error) {
+	var o []*OinkInvitation
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "dbmodels: failed to assign all query results to OinkInvitation slice")
+	}
+
+	if len(oinkInvitationAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all OinkInvitation records in the query.
+func (q oinkInvitationQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to count oink_invitations rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q oinkInvitationQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "dbmodels: failed to check if oink_invitations exists")
+	}
+
+	return count > 0, nil
+}
+
+// CreatedByUser pointed to by the foreign key.
+func (o *OinkInvitation) CreatedByUser(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.CreatedBy),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// OinkInvitationOink pointed to by the foreign key.
+func (o *OinkInvitation) OinkInvitationOink(mods ...qm.QueryMod) oinkQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.Oink),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Oinks(queryMods...)
+}
+
+// OinkInvitationUser pointed to by the foreign key.
+func (o *OinkInvitation) OinkInvitationUser(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.User),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// LoadCreatedByUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (oinkInvitationL) LoadCreatedByUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeOinkInvitation interface{}, mods queries.Applicator) error {
+	var slice []*OinkInvitation
+	var object *OinkInvitation
+
+	if singular {
+		var ok bool
+		object, ok = maybeOinkInvitation.(*OinkInvitation)
+		if !ok {
+			object = new(OinkInvitation)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeOinkInvitation)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeOinkInvitation))
+			}
+		}
+	} else {
+		s, ok := maybeOinkInvitation.(*[]*OinkInvitation)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeOinkInvitation)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeOinkInvitation))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &oinkInvitationR{}
+		}
+		args = append(args, object.CreatedBy)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &oinkInvitationR{}
+			}
+
+			for _, a := range args {
+				if a == obj.CreatedBy {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.CreatedBy)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.CreatedByUser = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.CreatedByOinkInvitations = append(foreign.R.CreatedByOinkInvitations, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.CreatedBy == foreign.ID {
+				local.R.CreatedByUser = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.CreatedByOinkInvitations = append(foreign.R.CreatedByOinkInvitations, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadOinkInvitationOink allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (oinkInvitationL) LoadOinkInvitationOink(ctx context.Context, e boil.ContextExecutor, singular bool, maybeOinkInvitation interface{}, mods queries.Applicator) error {
+	var slice []*OinkInvitation
+	var object *OinkInvitation
+
+	if singular {
+		var ok bool
+		object, ok = maybeOinkInvitation.(*OinkInvitation)
+		if !ok {
+			object = new(OinkInvitation)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeOinkInvitation)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeOinkInvitation))
+			}
+		}
+	} else {
+		s, ok := maybeOinkInvitation.(*[]*OinkInvitation)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeOinkInvitation)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeOinkInvitation))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &oinkInvitationR{}
+		}
+		args = append(args, object.Oink)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &oinkInvitationR{}
+			}
+
+			for _, a := range args {
+				if a == obj.Oink {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.Oink)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`oinks`),
+		qm.WhereIn(`oinks.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Oink")
+	}
+
+	var resultSlice []*Oink
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Oink")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for oinks")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for oinks")
+	}
+
+	if len(oinkAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.OinkInvitationOink = foreign
+		if foreign.R == nil {
+			foreign.R = &oinkR{}
+		}
+		foreign.R.OinkInvitations = append(foreign.R.OinkInvitations, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.Oink == foreign.ID {
+				local.R.OinkInvitationOink = foreign
+				if foreign.R == nil {
+					foreign.R = &oinkR{}
+				}
+				foreign.R.OinkInvitations = append(foreign.R.OinkInvitations, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadOinkInvitationUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (oinkInvitationL) LoadOinkInvitationUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeOinkInvitation interface{}, mods queries.Applicator) error {
+	var slice []*OinkInvitation
+	var object *OinkInvitation
+
+	if singular {
+		var ok bool
+		object, ok = maybeOinkInvitation.(*OinkInvitation)
+		if !ok {
+			object = new(OinkInvitation)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeOinkInvitation)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeOinkInvitation))
+			}
+		}
+	} else {
+		s, ok := maybeOinkInvitation.(*[]*OinkInvitation)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeOinkInvitation)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeOinkInvitation))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &oinkInvitationR{}
+		}
+		args = append(args, object.User)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &oinkInvitationR{}
+			}
+
+			for _, a := range args {
+				if a == obj.User {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.User)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.OinkInvitationUser = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.OinkInvitations = append(foreign.R.OinkInvitations, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.User == foreign.ID {
+				local.R.OinkInvitationUser = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.OinkInvitations = append(foreign.R.OinkInvitations, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetCreatedByUser of the oinkInvitation to the related item.
+// Sets o.R.CreatedByUser to related.
+// Adds o to related.R.CreatedByOinkInvitations.
+func (o *OinkInvitation) SetCreatedByUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"oink_invitations\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"created_by"}),
+		strmangle.WhereClause("\"", "\"", 2, oinkInvitationPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.Oink, o.User}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.CreatedBy = related.ID
+	if o.R == nil {
+		o.R = &oinkInvitationR{
+			CreatedByUser: related,
+		}
+	} else {
+		o.R.CreatedByUser = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			CreatedByOinkInvitations: OinkInvitationSlice{o},
+		}
+	} else {
+		related.R.CreatedByOinkInvitations = append(related.R.CreatedByOinkInvitations, o)
+	}
+
+	return nil
+}
+
+// SetOinkInvitationOink of the oinkInvitation to the related item.
+// Sets o.R.OinkInvitationOink to related.
+// Adds o to related.R.OinkInvitations.
+func (o *OinkInvitation) SetOinkInvitationOink(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Oink) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"oink_invitations\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"oink"}),
+		strmangle.WhereClause("\"", "\"", 2, oinkInvitationPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.Oink, o.User}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.Oink = related.ID
+	if o.R == nil {
+		o.R = &oinkInvitationR{
+			OinkInvitationOink: related,
+		}
+	} else {
+		o.R.OinkInvitationOink = related
+	}
+
+	if related.R == nil {
+		related.R = &oinkR{
+			OinkInvitations: OinkInvitationSlice{o},
+		}
+	} else {
+		related.R.OinkInvitations = append(related.R.OinkInvitations, o)
+	}
+
+	return nil
+}
+
+// SetOinkInvitationUser of the oinkInvitation to the related item.
+// Sets o.R.OinkInvitationUser to related.
+// Adds o to related.R.OinkInvitations.
+func (o *OinkInvitation) SetOinkInvitationUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"oink_invitations\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"user"}),
+		strmangle.WhereClause("\"", "\"", 2, oinkInvitationPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.Oink, o.User}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.User = related.ID
+	if o.R == nil {
+		o.R = &oinkInvitationR{
+			OinkInvitationUser: related,
+		}
+	} else {
+		o.R.OinkInvitationUser = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			OinkInvitations: OinkInvitationSlice{o},
+		}
+	} else {
+		related.R.OinkInvitations = append(related.R.OinkInvitations, o)
+	}
+
+	return nil
+}
+
+// OinkInvitations retrieves all the records using an executor.
+func OinkInvitations(mods ...qm.QueryMod) oinkInvitationQuery {
+	mods = append(mods, qm.From("\"oink_invitations\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"oink_invitations\".*"})
+	}
+
+	return oinkInvitationQuery{q}
+}
+
+// FindOinkInvitation retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindOinkInvitation(ctx context.Context, exec boil.ContextExecutor, oink string, user string, selectCols ...string) (*OinkInvitation, error) {
+	oinkInvitationObj := &OinkInvitation{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"oink_invitations\" where \"oink\"=$1 AND \"user\"=$2", sel,
+	)
+
+	q := queries.Raw(query, oink, user)
+
+	err := q.Bind(ctx, exec, oinkInvitationObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "dbmodels: unable to select from oink_invitations")
+	}
+
+	if err = oinkInvitationObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return oinkInvitationObj, err
+	}
+
+	return oinkInvitationObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *OinkInvitation) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("dbmodels: no oink_invitations provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(oinkInvitationColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	oinkInvitationInsertCacheMut.RLock()
+	cache, cached := oinkInvitationInsertCache[key]
+	oinkInvitationInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			oinkInvitationAllColumns,
+			oinkInvitationColumnsWithDefault,
+			oinkInvitationColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(oinkInvitationType, oinkInvitationMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(oinkInvitationType, oinkInvitationMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"oink_invitations\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"oink_invitations\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to insert into oink_invitations")
+	}
+
+	if !cached {
+		oinkInvitationInsertCacheMut.Lock()
+		oinkInvitationInsertCache[key] = cache
+		oinkInvitationInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the OinkInvitation.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *OinkInvitation) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	oinkInvitationUpdateCacheMut.RLock()
+	cache, cached := oinkInvitationUpdateCache[key]
+	oinkInvitationUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			oinkInvitationAllColumns,
+			oinkInvitationPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("dbmodels: unable to update oink_invitations, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"oink_invitations\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, oinkInvitationPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(oinkInvitationType, oinkInvitationMapping, append(wl, oinkInvitationPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to update oink_invitations row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to get rows affected by update for oink_invitations")
+	}
+
+	if !cached {
+		oinkInvitationUpdateCacheMut.Lock()
+		oinkInvitationUpdateCache[key] = cache
+		oinkInvitationUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q oinkInvitationQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to update all for oink_invitations")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to retrieve rows affected for oink_invitations")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o OinkInvitationSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("dbmodels: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), oinkInvitationPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"oink_invitations\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, oinkInvitationPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to update all in oinkInvitation slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to retrieve rows affected all in update all oinkInvitation")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *OinkInvitation) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("dbmodels: no oink_invitations provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(oinkInvitationColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	oinkInvitationUpsertCacheMut.RLock()
+	cache, cached := oinkInvitationUpsertCache[key]
+	oinkInvitationUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			oinkInvitationAllColumns,
+			oinkInvitationColumnsWithDefault,
+			oinkInvitationColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			oinkInvitationAllColumns,
+			oinkInvitationPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("dbmodels: unable to upsert oink_invitations, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(oinkInvitationPrimaryKeyColumns))
+			copy(conflict, oinkInvitationPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"oink_invitations\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(oinkInvitationType, oinkInvitationMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(oinkInvitationType, oinkInvitationMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to upsert oink_invitations")
+	}
+
+	if !cached {
+		oinkInvitationUpsertCacheMut.Lock()
+		oinkInvitationUpsertCache[key] = cache
+		oinkInvitationUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single OinkInvitation record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *OinkInvitation) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("dbmodels: no OinkInvitation provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), oinkInvitationPrimaryKeyMapping)
+	sql := "DELETE FROM \"oink_invitations\" WHERE \"oink\"=$1 AND \"user\"=$2"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to delete from oink_invitations")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to get rows affected by delete for oink_invitations")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q oinkInvitationQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("dbmodels: no oinkInvitationQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to delete all from oink_invitations")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to get rows affected by deleteall for oink_invitations")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o OinkInvitationSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(oinkInvitationBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), oinkInvitationPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"oink_invitations\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, oinkInvitationPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to delete all from oinkInvitation slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to get rows affected by deleteall for oink_invitations")
+	}
+
+	if len(oinkInvitationAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *OinkInvitation) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindOinkInvitation(ctx, exec, o.Oink, o.User)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *OinkInvitationSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := OinkInvitationSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), oinkInvitationPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"oink_invitations\".* FROM \"oink_invitations\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, oinkInvitationPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to reload all in OinkInvitationSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// OinkInvitationExists checks if the OinkInvitation row exists.
+func OinkInvitationExists(ctx context.Context, exec boil.ContextExecutor, oink string, user string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"oink_invitations\" where \"oink\"=$1 AND \"user\"=$2 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, oink, user)
+	}
+	row := exec.QueryRowContext(ctx, sql, oink, user)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "dbmodels: unable to check if oink_invitations exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the OinkInvitation row exists.
+func (o *OinkInvitation) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return OinkInvitationExists(ctx, exec, o.Oink, o.User)
+}
@@ -0,0 +1,1079 @@
+// Code generated by SQLBoiler 4.14.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dbmodels
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testOinkInvitations(t *testing.T) {
+	t.Parallel()
+
+	query := OinkInvitations()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testOinkInvitationsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OinkInvitation{}
+	if err = randomize.Struct(seed, o, oinkInvitationDBTypes, true, oinkInvitationColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OinkInvitation struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := OinkInvitations().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testOinkInvitationsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OinkInvitation{}
+	if err = randomize.Struct(seed, o, oinkInvitationDBTypes, true, oinkInvitationColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OinkInvitation struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := OinkInvitations().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := OinkInvitations().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testOinkInvitationsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OinkInvitation{}
+	if err = randomize.Struct(seed, o, oinkInvitationDBTypes, true, oinkInvitationColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OinkInvitation struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := OinkInvitationSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := OinkInvitations().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testOinkInvitationsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OinkInvitation{}
+	if err = randomize.Struct(seed, o, oinkInvitationDBTypes, true, oinkInvitationColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OinkInvitation struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := OinkInvitationExists(ctx, tx, o.Oink, o.User)
+	if err != nil {
+		t.Errorf("Unable to check if OinkInvitation exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected OinkInvitationExists to return true, but got false.")
+	}
+}
+
+func testOinkInvitationsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OinkInvitation{}
+	if err = randomize.Struct(seed, o, oinkInvitationDBTypes, true, oinkInvitationColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OinkInvitation struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	oinkInvitationFound, err := FindOinkInvitation(ctx, tx, o.Oink, o.User)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if oinkInvitationFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testOinkInvitationsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OinkInvitation{}
+	if err = randomize.Struct(seed, o, oinkInvitationDBTypes, true, oinkInvitationColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OinkInvitation struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = OinkInvitations().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testOinkInvitationsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OinkInvitation{}
+	if err = randomize.Struct(seed, o, oinkInvitationDBTypes, true, oinkInvitationColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OinkInvitation struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := OinkInvitations().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testOinkInvitationsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	oinkInvitationOne := &OinkInvitation{}
+	oinkInvitationTwo := &OinkInvitation{}
+	if err = randomize.Struct(seed, oinkInvitationOne, oinkInvitationDBTypes, false, oinkInvitationColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OinkInvitation struct: %s", err)
+	}
+	if err = randomize.Struct(seed, oinkInvitationTwo, oinkInvitationDBTypes, false, oinkInvitationColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OinkInvitation struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = oinkInvitationOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = oinkInvitationTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := OinkInvitations().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testOinkInvitationsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	oinkInvitationOne := &OinkInvitation{}
+	oinkInvitationTwo := &OinkInvitation{}
+	if err = randomize.Struct(seed, oinkInvitationOne, oinkInvitationDBTypes, false, oinkInvitationColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OinkInvitation struct: %s", err)
+	}
+	if err = randomize.Struct(seed, oinkInvitationTwo, oinkInvitationDBTypes, false, oinkInvitationColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OinkInvitation struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = oinkInvitationOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = oinkInvitationTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := OinkInvitations().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func oinkInvitationBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *OinkInvitation) error {
+	*o = OinkInvitation{}
+	return nil
+}
+
+func oinkInvitationAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *OinkInvitation) error {
+	*o = OinkInvitation{}
+	return nil
+}
+
+func oinkInvitationAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *OinkInvitation) error {
+	*o = OinkInvitation{}
+	return nil
+}
+
+func oinkInvitationBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *OinkInvitation) error {
+	*o = OinkInvitation{}
+	return nil
+}
+
+func oinkInvitationAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *OinkInvitation) error {
+	*o = OinkInvitation{}
+	return nil
+}
+
+func oinkInvitationBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *OinkInvitation) error {
+	*o = OinkInvitation{}
+	return nil
+}
+
+func oinkInvitationAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *OinkInvitation) error {
+	*o = OinkInvitation{}
+	return nil
+}
+
+func oinkInvitationBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *OinkInvitation) error {
+	*o = OinkInvitation{}
+	return nil
+}
+
+func oinkInvitationAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *OinkInvitation) error {
+	*o = OinkInvitation{}
+	return nil
+}
+
+func testOinkInvitationsHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &OinkInvitation{}
+	o := &OinkInvitation{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, oinkInvitationDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize OinkInvitation object: %s", err)
+	}
+
+	AddOinkInvitationHook(boil.BeforeInsertHook, oinkInvitationBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	oinkInvitationBeforeInsertHooks = []OinkInvitationHook{}
+
+	AddOinkInvitationHook(boil.AfterInsertHook, oinkInvitationAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	oinkInvitationAfterInsertHooks = []OinkInvitationHook{}
+
+	AddOinkInvitationHook(boil.AfterSelectHook, oinkInvitationAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	oinkInvitationAfterSelectHooks = []OinkInvitationHook{}
+
+	AddOinkInvitationHook(boil.BeforeUpdateHook, oinkInvitationBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	oinkInvitationBeforeUpdateHooks = []OinkInvitationHook{}
+
+	AddOinkInvitationHook(boil.AfterUpdateHook, oinkInvitationAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	oinkInvitationAfterUpdateHooks = []OinkInvitationHook{}
+
+	AddOinkInvitationHook(boil.BeforeDeleteHook, oinkInvitationBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	oinkInvitationBeforeDeleteHooks = []OinkInvitationHook{}
+
+	AddOinkInvitationHook(boil.AfterDeleteHook, oinkInvitationAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	oinkInvitationAfterDeleteHooks = []OinkInvitationHook{}
+
+	AddOinkInvitationHook(boil.BeforeUpsertHook, oinkInvitationBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	oinkInvitationBeforeUpsertHooks = []OinkInvitationHook{}
+
+	AddOinkInvitationHook(boil.AfterUpsertHook, oinkInvitationAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	oinkInvitationAfterUpsertHooks = []OinkInvitationHook{}
+}
+
+func testOinkInvitationsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OinkInvitation{}
+	if err = randomize.Struct(seed, o, oinkInvitationDBTypes, true, oinkInvitationColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OinkInvitation struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := OinkInvitations().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testOinkInvitationsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OinkInvitation{}
+	if err = randomize.Struct(seed, o, oinkInvitationDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize OinkInvitation struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(oinkInvitationColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := OinkInvitations().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testOinkInvitationToOneUserUsingCreatedByUser(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local OinkInvitation
+	var foreign User
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, oinkInvitationDBTypes, false, oinkInvitationColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OinkInvitation struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, userDBTypes, false, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.CreatedBy = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.CreatedByUser().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	ranAfterSelectHook := false
+	AddUserHook(boil.AfterSelectHook, func(ctx context.Context, e boil.ContextExecutor, o *User) error {
+		ranAfterSelectHook = true
+		return nil
+	})
+
+	slice := OinkInvitationSlice{&local}
+	if err = local.L.LoadCreatedByUser(ctx, tx, false, (*[]*OinkInvitation)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.CreatedByUser == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.CreatedByUser = nil
+	if err = local.L.LoadCreatedByUser(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.CreatedByUser == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	if !ranAfterSelectHook {
+		t.Error("failed to run AfterSelect hook for relationship")
+	}
+}
+
+func testOinkInvitationToOneOinkUsingOinkInvitationOink(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local OinkInvitation
+	var foreign Oink
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, oinkInvitationDBTypes, false, oinkInvitationColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OinkInvitation struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, oinkDBTypes, false, oinkColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Oink struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.Oink = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.OinkInvitationOink().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	ranAfterSelectHook := false
+	AddOinkHook(boil.AfterSelectHook, func(ctx context.Context, e boil.ContextExecutor, o *Oink) error {
+		ranAfterSelectHook = true
+		return nil
+	})
+
+	slice := OinkInvitationSlice{&local}
+	if err = local.L.LoadOinkInvitationOink(ctx, tx, false, (*[]*OinkInvitation)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.OinkInvitationOink == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.OinkInvitationOink = nil
+	if err = local.L.LoadOinkInvitationOink(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.OinkInvitationOink == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	if !ranAfterSelectHook {
+		t.Error("failed to run AfterSelect hook for relationship")
+	}
+}
+
+func testOinkInvitationToOneUserUsingOinkInvitationUser(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local OinkInvitation
+	var foreign User
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, oinkInvitationDBTypes, false, oinkInvitationColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OinkInvitation struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, userDBTypes, false, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.User = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.OinkInvitationUser().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	ranAfterSelectHook := false
+	AddUserHook(boil.AfterSelectHook, func(ctx context.Context, e boil.ContextExecutor, o *User) error {
+		ranAfterSelectHook = true
+		return nil
+	})
+
+	slice := OinkInvitationSlice{&local}
+	if err = local.L.LoadOinkInvitationUser(ctx, tx, false, (*[]*OinkInvitation)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.OinkInvitationUser == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.OinkInvitationUser = nil
+	if err = local.L.LoadOinkInvitationUser(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.OinkInvitationUser == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	if !ranAfterSelectHook {
+		t.Error("failed to run AfterSelect hook for relationship")
+	}
+}
+
+func testOinkInvitationToOneSetOpUserUsingCreatedByUser(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a OinkInvitation
+	var b, c User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, oinkInvitationDBTypes, false, strmangle.SetComplement(oinkInvitationPrimaryKeyColumns, oinkInvitationColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*User{&b, &c} {
+		err = a.SetCreatedByUser(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.CreatedByUser != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.CreatedByOinkInvitations[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.CreatedBy != x.ID {
+			t.Error("foreign key was wrong value", a.CreatedBy)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.CreatedBy))
+		reflect.Indirect(reflect.ValueOf(&a.CreatedBy)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.CreatedBy != x.ID {
+			t.Error("foreign key was wrong value", a.CreatedBy, x.ID)
+		}
+	}
+}
+func testOinkInvitationToOneSetOpOinkUsingOinkInvitationOink(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a OinkInvitation
+	var b, c Oink
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, oinkInvitationDBTypes, false, strmangle.SetComplement(oinkInvitationPrimaryKeyColumns, oinkInvitationColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, oinkDBTypes, false, strmangle.SetComplement(oinkPrimaryKeyColumns, oinkColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, oinkDBTypes, false, strmangle.SetComplement(oinkPrimaryKeyColumns, oinkColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Oink{&b, &c} {
+		err = a.SetOinkInvitationOink(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.OinkInvitationOink != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.OinkInvitations[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.Oink != x.ID {
+			t.Error("foreign key was wrong value", a.Oink)
+		}
+
+		if exists, err := OinkInvitationExists(ctx, tx, a.Oink, a.User); err != nil {
+			t.Fatal(err)
+		} else if !exists {
+			t.Error("want 'a' to exist")
+		}
+
+	}
+}
+func testOinkInvitationToOneSetOpUserUsingOinkInvitationUser(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a OinkInvitation
+	var b, c User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, oinkInvitationDBTypes, false, strmangle.SetComplement(oinkInvitationPrimaryKeyColumns, oinkInvitationColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*User{&b, &c} {
+		err = a.SetOinkInvitationUser(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.OinkInvitationUser != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.OinkInvitations[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.User != x.ID {
+			t.Error("foreign key was wrong value", a.User)
+		}
+
+		if exists, err := OinkInvitationExists(ctx, tx, a.Oink, a.User); err != nil {
+			t.Fatal(err)
+		} else if !exists {
+			t.Error("want 'a' to exist")
+		}
+
+	}
+}
+
+func testOinkInvitationsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OinkInvitation{}
+	if err = randomize.Struct(seed, o, oinkInvitationDBTypes, true, oinkInvitationColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OinkInvitation struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testOinkInvitationsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OinkInvitation{}
+	if err = randomize.Struct(seed, o, oinkInvitationDBTypes, true, oinkInvitationColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OinkInvitation struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := OinkInvitationSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testOinkInvitationsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OinkInvitation{}
+	if err = randomize.Struct(seed, o, oinkInvitationDBTypes, true, oinkInvitationColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OinkInvitation struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := OinkInvitations().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	oinkInvitationDBTypes = map[string]string{`Oink`: `uuid`, `User`: `uuid`, `Kind`: `character varying`, `CreatedBy`: `uuid`, `CreatedAt`: `timestamp with time zone`}
+	_                     = bytes.MinRead
+)
+
+func testOinkInvitationsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(oinkInvitationPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(oinkInvitationAllColumns) == len(oinkInvitationPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OinkInvitation{}
+	if err = randomize.Struct(seed, o, oinkInvitationDBTypes, true, oinkInvitationColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OinkInvitation struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := OinkInvitations().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, oinkInvitationDBTypes, true, oinkInvitationPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize OinkInvitation struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testOinkInvitationsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(oinkInvitationAllColumns) == len(oinkInvitationPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OinkInvitation{}
+	if err = randomize.Struct(seed, o, oinkInvitationDBTypes, true, oinkInvitationColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OinkInvitation struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := OinkInvitations().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, oinkInvitationDBTypes, true, oinkInvitationPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize OinkInvitation struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(oinkInvitationAllColumns, oinkInvitationPrimaryKeyColumns) {
+		fields = oinkInvitationAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			oinkInvitationAllColumns,
+			oinkInvitationPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := OinkInvitationSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testOinkInvitationsUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(oinkInvitationAllColumns) == len(oinkInvitationPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := OinkInvitation{}
+	if err = randomize.Struct(seed, &o, oinkInvitationDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize OinkInvitation struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert OinkInvitation: %s", err)
+	}
+
+	count, err := OinkInvitations().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, oinkInvitationDBTypes, false, oinkInvitationPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize OinkInvitation struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert OinkInvitation: %s", err)
+	}
+
+	count, err = OinkInvitations().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
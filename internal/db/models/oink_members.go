@@ -0,0 +1,1300 @@
+// Code generated by SQLBoiler 4.14.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dbmodels
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// OinkMember is an object representing the database table.
+type OinkMember struct {
+	Oink      string    `boil:"oink" json:"oink" toml:"oink" yaml:"oink"`
+	User      string    `boil:"user" json:"user" toml:"user" yaml:"user"`
+	CreatedAt time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	Role      string    `boil:"role" json:"role" toml:"role" yaml:"role"`
+
+	R *oinkMemberR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L oinkMemberL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var OinkMemberColumns = struct {
+	Oink      string
+	User      string
+	CreatedAt string
+	Role      string
+}{
+	Oink:      "oink",
+	User:      "user",
+	CreatedAt: "created_at",
+	Role:      "role",
+}
+
+var OinkMemberTableColumns = struct {
+	Oink      string
+	User      string
+	CreatedAt string
+	Role      string
+}{
+	Oink:      "oink_members.oink",
+	User:      "oink_members.user",
+	CreatedAt: "oink_members.created_at",
+	Role:      "oink_members.role",
+}
+
+// Generated where
+
+var OinkMemberWhere = struct {
+	Oink      whereHelperstring
+	User      whereHelperstring
+	CreatedAt whereHelpertime_Time
+	Role      whereHelperstring
+}{
+	Oink:      whereHelperstring{field: "\"oink_members\".\"oink\""},
+	User:      whereHelperstring{field: "\"oink_members\".\"user\""},
+	CreatedAt: whereHelpertime_Time{field: "\"oink_members\".\"created_at\""},
+	Role:      whereHelperstring{field: "\"oink_members\".\"role\""},
+}
+
+// OinkMemberRels is where relationship names are stored.
+var OinkMemberRels = struct {
+	OinkMemberOink string
+	OinkMemberUser string
+}{
+	OinkMemberOink: "OinkMemberOink",
+	OinkMemberUser: "OinkMemberUser",
+}
+
+// oinkMemberR is where relationships are stored.
+type oinkMemberR struct {
+	OinkMemberOink *Oink `boil:"OinkMemberOink" json:"OinkMemberOink" toml:"OinkMemberOink" yaml:"OinkMemberOink"`
+	OinkMemberUser *User `boil:"OinkMemberUser" json:"OinkMemberUser" toml:"OinkMemberUser" yaml:"OinkMemberUser"`
+}
+
+// NewStruct creates a new relationship struct
+func (*oinkMemberR) NewStruct() *oinkMemberR {
+	return &oinkMemberR{}
+}
+
+func (r *oinkMemberR) GetOinkMemberOink() *Oink {
+	if r == nil {
+		return nil
+	}
+	return r.OinkMemberOink
+}
+
+func (r *oinkMemberR) GetOinkMemberUser() *User {
+	if r == nil {
+		return nil
+	}
+	return r.OinkMemberUser
+}
+
+// oinkMemberL is where Load methods for each relationship are stored.
+type oinkMemberL struct{}
+
+var (
+	oinkMemberAllColumns            = []string{"oink", "user", "created_at", "role"}
+	oinkMemberColumnsWithoutDefault = []string{"oink", "user", "created_at"}
+	oinkMemberColumnsWithDefault    = []string{"role"}
+	oinkMemberPrimaryKeyColumns     = []string{"oink", "user"}
+	oinkMemberGeneratedColumns      = []string{}
+)
+
+type (
+	// OinkMemberSlice is an alias for a slice of pointers to OinkMember.
+	// This should almost always be used instead of []OinkMember.
+	OinkMemberSlice []*OinkMember
+	// OinkMemberHook is the signature for custom OinkMember hook methods
+	OinkMemberHook func(context.Context, boil.ContextExecutor, *OinkMember) error
+
+	oinkMemberQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	oinkMemberType                 = reflect.TypeOf(&OinkMember{})
+	oinkMemberMapping              = queries.MakeStructMapping(oinkMemberType)
+	oinkMemberPrimaryKeyMapping, _ = queries.BindMapping(oinkMemberType, oinkMemberMapping, oinkMemberPrimaryKeyColumns)
+	oinkMemberInsertCacheMut       sync.RWMutex
+	oinkMemberInsertCache          = make(map[string]insertCache)
+	oinkMemberUpdateCacheMut       sync.RWMutex
+	oinkMemberUpdateCache          = make(map[string]updateCache)
+	oinkMemberUpsertCacheMut       sync.RWMutex
+	oinkMemberUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var oinkMemberAfterSelectHooks []OinkMemberHook
+
+var oinkMemberBeforeInsertHooks []OinkMemberHook
+var oinkMemberAfterInsertHooks []OinkMemberHook
+
+var oinkMemberBeforeUpdateHooks []OinkMemberHook
+var oinkMemberAfterUpdateHooks []OinkMemberHook
+
+var oinkMemberBeforeDeleteHooks []OinkMemberHook
+var oinkMemberAfterDeleteHooks []OinkMemberHook
+
+var oinkMemberBeforeUpsertHooks []OinkMemberHook
+var oinkMemberAfterUpsertHooks []OinkMemberHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *OinkMember) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range oinkMemberAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *OinkMember) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range oinkMemberBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *OinkMember) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range oinkMemberAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *OinkMember) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range oinkMemberBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *OinkMember) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range oinkMemberAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *OinkMember) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range oinkMemberBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *OinkMember) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range oinkMemberAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *OinkMember) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range oinkMemberBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *OinkMember) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range oinkMemberAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddOinkMemberHook registers your hook function for all future operations.
+func AddOinkMemberHook(hookPoint boil.HookPoint, oinkMemberHook OinkMemberHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		oinkMemberAfterSelectHooks = append(oinkMemberAfterSelectHooks, oinkMemberHook)
+	case boil.BeforeInsertHook:
+		oinkMemberBeforeInsertHooks = append(oinkMemberBeforeInsertHooks, oinkMemberHook)
+	case boil.AfterInsertHook:
+		oinkMemberAfterInsertHooks = append(oinkMemberAfterInsertHooks, oinkMemberHook)
+	case boil.BeforeUpdateHook:
+		oinkMemberBeforeUpdateHooks = append(oinkMemberBeforeUpdateHooks, oinkMemberHook)
+	case boil.AfterUpdateHook:
+		oinkMemberAfterUpdateHooks = append(oinkMemberAfterUpdateHooks, oinkMemberHook)
+	case boil.BeforeDeleteHook:
+		oinkMemberBeforeDeleteHooks = append(oinkMemberBeforeDeleteHooks, oinkMemberHook)
+	case boil.AfterDeleteHook:
+		oinkMemberAfterDeleteHooks = append(oinkMemberAfterDeleteHooks, oinkMemberHook)
+	case boil.BeforeUpsertHook:
+		oinkMemberBeforeUpsertHooks = append(oinkMemberBeforeUpsertHooks, oinkMemberHook)
+	case boil.AfterUpsertHook:
+		oinkMemberAfterUpsertHooks = append(oinkMemberAfterUpsertHooks, oinkMemberHook)
+	}
+}
+
+// One returns a single oinkMember record from the query.
+func (q oinkMemberQuery) One(ctx context.Context, exec boil.ContextExecutor) (*OinkMember, error) {
+	o := &OinkMember{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "dbmodels: failed to execute a one query for oink_members")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all OinkMember records from the query.
+func (q oinkMemberQuery) All(ctx context.Context, exec boil.ContextExecutor) (OinkMemberSlice, error) {
+	var o []*OinkMember
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "dbmodels: failed to assign all query results to OinkMember slice")
+	}
+
+	if len(oinkMemberAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all OinkMember records in the query.
+func (q oinkMemberQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to count oink_members rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q oinkMemberQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "dbmodels: failed to check if oink_members exists")
+	}
+
+	return count > 0, nil
+}
+
+// OinkMemberOink pointed to by the foreign key.
+func (o *OinkMember) OinkMemberOink(mods ...qm.QueryMod) oinkQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.Oink),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Oinks(queryMods...)
+}
+
+// OinkMemberUser pointed to by the foreign key.
+func (o *OinkMember) OinkMemberUser(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.User),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// LoadOinkMemberOink allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (oinkMemberL) LoadOinkMemberOink(ctx context.Context, e boil.ContextExecutor, singular bool, maybeOinkMember interface{}, mods queries.Applicator) error {
+	var slice []*OinkMember
+	var object *OinkMember
+
+	if singular {
+		var ok bool
+		object, ok = maybeOinkMember.(*OinkMember)
+		if !ok {
+			object = new(OinkMember)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeOinkMember)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeOinkMember))
+			}
+		}
+	} else {
+		s, ok := maybeOinkMember.(*[]*OinkMember)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeOinkMember)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeOinkMember))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &oinkMemberR{}
+		}
+		args = append(args, object.Oink)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &oinkMemberR{}
+			}
+
+			for _, a := range args {
+				if a == obj.Oink {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.Oink)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`oinks`),
+		qm.WhereIn(`oinks.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Oink")
+	}
+
+	var resultSlice []*Oink
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Oink")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for oinks")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for oinks")
+	}
+
+	if len(oinkAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.OinkMemberOink = foreign
+		if foreign.R == nil {
+			foreign.R = &oinkR{}
+		}
+		foreign.R.OinkMembers = append(foreign.R.OinkMembers, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.Oink == foreign.ID {
+				local.R.OinkMemberOink = foreign
+				if foreign.R == nil {
+					foreign.R = &oinkR{}
+				}
+				foreign.R.OinkMembers = append(foreign.R.OinkMembers, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadOinkMemberUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (oinkMemberL) LoadOinkMemberUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeOinkMember interface{}, mods queries.Applicator) error {
+	var slice []*OinkMember
+	var object *OinkMember
+
+	if singular {
+		var ok bool
+		object, ok = maybeOinkMember.(*OinkMember)
+		if !ok {
+			object = new(OinkMember)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeOinkMember)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeOinkMember))
+			}
+		}
+	} else {
+		s, ok := maybeOinkMember.(*[]*OinkMember)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeOinkMember)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeOinkMember))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &oinkMemberR{}
+		}
+		args = append(args, object.User)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &oinkMemberR{}
+			}
+
+			for _, a := range args {
+				if a == obj.User {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.User)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.OinkMemberUser = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.OinkMembers = append(foreign.R.OinkMembers, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.User == foreign.ID {
+				local.R.OinkMemberUser = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.OinkMembers = append(foreign.R.OinkMembers, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetOinkMemberOink of the oinkMember to the related item.
+// Sets o.R.OinkMemberOink to related.
+// Adds o to related.R.OinkMembers.
+func (o *OinkMember) SetOinkMemberOink(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Oink) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"oink_members\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"oink"}),
+		strmangle.WhereClause("\"", "\"", 2, oinkMemberPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.Oink, o.User}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.Oink = related.ID
+	if o.R == nil {
+		o.R = &oinkMemberR{
+			OinkMemberOink: related,
+		}
+	} else {
+		o.R.OinkMemberOink = related
+	}
+
+	if related.R == nil {
+		related.R = &oinkR{
+			OinkMembers: OinkMemberSlice{o},
+		}
+	} else {
+		related.R.OinkMembers = append(related.R.OinkMembers, o)
+	}
+
+	return nil
+}
+
+// SetOinkMemberUser of the oinkMember to the related item.
+// Sets o.R.OinkMemberUser to related.
+// Adds o to related.R.OinkMembers.
+func (o *OinkMember) SetOinkMemberUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"oink_members\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"user"}),
+		strmangle.WhereClause("\"", "\"", 2, oinkMemberPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.Oink, o.User}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.User = related.ID
+	if o.R == nil {
+		o.R = &oinkMemberR{
+			OinkMemberUser: related,
+		}
+	} else {
+		o.R.OinkMemberUser = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			OinkMembers: OinkMemberSlice{o},
+		}
+	} else {
+		related.R.OinkMembers = append(related.R.OinkMembers, o)
+	}
+
+	return nil
+}
+
+// OinkMembers retrieves all the records using an executor.
+func OinkMembers(mods ...qm.QueryMod) oinkMemberQuery {
+	mods = append(mods, qm.From("\"oink_members\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"oink_members\".*"})
+	}
+
+	return oinkMemberQuery{q}
+}
+
+// FindOinkMember retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindOinkMember(ctx context.Context, exec boil.ContextExecutor, oink string, user string, selectCols ...string) (*OinkMember, error) {
+	oinkMemberObj := &OinkMember{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"oink_members\" where \"oink\"=$1 AND \"user\"=$2", sel,
+	)
+
+	q := queries.Raw(query, oink, user)
+
+	err := q.Bind(ctx, exec, oinkMemberObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "dbmodels: unable to select from oink_members")
+	}
+
+	if err = oinkMemberObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return oinkMemberObj, err
+	}
+
+	return oinkMemberObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *OinkMember) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("dbmodels: no oink_members provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(oinkMemberColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	oinkMemberInsertCacheMut.RLock()
+	cache, cached := oinkMemberInsertCache[key]
+	oinkMemberInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			oinkMemberAllColumns,
+			oinkMemberColumnsWithDefault,
+			oinkMemberColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(oinkMemberType, oinkMemberMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(oinkMemberType, oinkMemberMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"oink_members\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"oink_members\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to insert into oink_members")
+	}
+
+	if !cached {
+		oinkMemberInsertCacheMut.Lock()
+		oinkMemberInsertCache[key] = cache
+		oinkMemberInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the OinkMember.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *OinkMember) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	oinkMemberUpdateCacheMut.RLock()
+	cache, cached := oinkMemberUpdateCache[key]
+	oinkMemberUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			oinkMemberAllColumns,
+			oinkMemberPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("dbmodels: unable to update oink_members, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"oink_members\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, oinkMemberPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(oinkMemberType, oinkMemberMapping, append(wl, oinkMemberPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to update oink_members row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to get rows affected by update for oink_members")
+	}
+
+	if !cached {
+		oinkMemberUpdateCacheMut.Lock()
+		oinkMemberUpdateCache[key] = cache
+		oinkMemberUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q oinkMemberQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to update all for oink_members")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to retrieve rows affected for oink_members")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o OinkMemberSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("dbmodels: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), oinkMemberPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"oink_members\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, oinkMemberPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to update all in oinkMember slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to retrieve rows affected all in update all oinkMember")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *OinkMember) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("dbmodels: no oink_members provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(oinkMemberColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	oinkMemberUpsertCacheMut.RLock()
+	cache, cached := oinkMemberUpsertCache[key]
+	oinkMemberUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			oinkMemberAllColumns,
+			oinkMemberColumnsWithDefault,
+			oinkMemberColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			oinkMemberAllColumns,
+			oinkMemberPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("dbmodels: unable to upsert oink_members, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(oinkMemberPrimaryKeyColumns))
+			copy(conflict, oinkMemberPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"oink_members\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(oinkMemberType, oinkMemberMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(oinkMemberType, oinkMemberMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to upsert oink_members")
+	}
+
+	if !cached {
+		oinkMemberUpsertCacheMut.Lock()
+		oinkMemberUpsertCache[key] = cache
+		oinkMemberUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single OinkMember record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *OinkMember) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("dbmodels: no OinkMember provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), oinkMemberPrimaryKeyMapping)
+	sql := "DELETE FROM \"oink_members\" WHERE \"oink\"=$1 AND \"user\"=$2"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to delete from oink_members")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to get rows affected by delete for oink_members")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q oinkMemberQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("dbmodels: no oinkMemberQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to delete all from oink_members")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to get rows affected by deleteall for oink_members")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o OinkMemberSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(oinkMemberBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), oinkMemberPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"oink_members\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, oinkMemberPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to delete all from oinkMember slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to get rows affected by deleteall for oink_members")
+	}
+
+	if len(oinkMemberAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *OinkMember) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindOinkMember(ctx, exec, o.Oink, o.User)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *OinkMemberSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := OinkMemberSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), oinkMemberPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"oink_members\".* FROM \"oink_members\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, oinkMemberPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to reload all in OinkMemberSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// OinkMemberExists checks if the OinkMember row exists.
+func OinkMemberExists(ctx context.Context, exec boil.ContextExecutor, oink string, user string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"oink_members\" where \"oink\"=$1 AND \"user\"=$2 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, oink, user)
+	}
+	row := exec.QueryRowContext(ctx, sql, oink, user)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "dbmodels: unable to check if oink_members exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the OinkMember row exists.
+func (o *OinkMember) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return OinkMemberExists(ctx, exec, o.Oink, o.User)
+}
@@ -0,0 +1,961 @@
+// Code generated by SQLBoiler 4.14.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dbmodels
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testOinkMembers(t *testing.T) {
+	t.Parallel()
+
+	query := OinkMembers()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testOinkMembersDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OinkMember{}
+	if err = randomize.Struct(seed, o, oinkMemberDBTypes, true, oinkMemberColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OinkMember struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := OinkMembers().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testOinkMembersQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OinkMember{}
+	if err = randomize.Struct(seed, o, oinkMemberDBTypes, true, oinkMemberColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OinkMember struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := OinkMembers().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := OinkMembers().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testOinkMembersSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OinkMember{}
+	if err = randomize.Struct(seed, o, oinkMemberDBTypes, true, oinkMemberColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OinkMember struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := OinkMemberSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := OinkMembers().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testOinkMembersExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OinkMember{}
+	if err = randomize.Struct(seed, o, oinkMemberDBTypes, true, oinkMemberColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OinkMember struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := OinkMemberExists(ctx, tx, o.Oink, o.User)
+	if err != nil {
+		t.Errorf("Unable to check if OinkMember exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected OinkMemberExists to return true, but got false.")
+	}
+}
+
+func testOinkMembersFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OinkMember{}
+	if err = randomize.Struct(seed, o, oinkMemberDBTypes, true, oinkMemberColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OinkMember struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	oinkMemberFound, err := FindOinkMember(ctx, tx, o.Oink, o.User)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if oinkMemberFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testOinkMembersBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OinkMember{}
+	if err = randomize.Struct(seed, o, oinkMemberDBTypes, true, oinkMemberColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OinkMember struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = OinkMembers().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testOinkMembersOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OinkMember{}
+	if err = randomize.Struct(seed, o, oinkMemberDBTypes, true, oinkMemberColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OinkMember struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := OinkMembers().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testOinkMembersAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	oinkMemberOne := &OinkMember{}
+	oinkMemberTwo := &OinkMember{}
+	if err = randomize.Struct(seed, oinkMemberOne, oinkMemberDBTypes, false, oinkMemberColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OinkMember struct: %s", err)
+	}
+	if err = randomize.Struct(seed, oinkMemberTwo, oinkMemberDBTypes, false, oinkMemberColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OinkMember struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = oinkMemberOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = oinkMemberTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := OinkMembers().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testOinkMembersCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	oinkMemberOne := &OinkMember{}
+	oinkMemberTwo := &OinkMember{}
+	if err = randomize.Struct(seed, oinkMemberOne, oinkMemberDBTypes, false, oinkMemberColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OinkMember struct: %s", err)
+	}
+	if err = randomize.Struct(seed, oinkMemberTwo, oinkMemberDBTypes, false, oinkMemberColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OinkMember struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = oinkMemberOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = oinkMemberTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := OinkMembers().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func oinkMemberBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *OinkMember) error {
+	*o = OinkMember{}
+	return nil
+}
+
+func oinkMemberAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *OinkMember) error {
+	*o = OinkMember{}
+	return nil
+}
+
+func oinkMemberAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *OinkMember) error {
+	*o = OinkMember{}
+	return nil
+}
+
+func oinkMemberBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *OinkMember) error {
+	*o = OinkMember{}
+	return nil
+}
+
+func oinkMemberAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *OinkMember) error {
+	*o = OinkMember{}
+	return nil
+}
+
+func oinkMemberBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *OinkMember) error {
+	*o = OinkMember{}
+	return nil
+}
+
+func oinkMemberAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *OinkMember) error {
+	*o = OinkMember{}
+	return nil
+}
+
+func oinkMemberBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *OinkMember) error {
+	*o = OinkMember{}
+	return nil
+}
+
+func oinkMemberAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *OinkMember) error {
+	*o = OinkMember{}
+	return nil
+}
+
+func testOinkMembersHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &OinkMember{}
+	o := &OinkMember{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, oinkMemberDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize OinkMember object: %s", err)
+	}
+
+	AddOinkMemberHook(boil.BeforeInsertHook, oinkMemberBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	oinkMemberBeforeInsertHooks = []OinkMemberHook{}
+
+	AddOinkMemberHook(boil.AfterInsertHook, oinkMemberAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	oinkMemberAfterInsertHooks = []OinkMemberHook{}
+
+	AddOinkMemberHook(boil.AfterSelectHook, oinkMemberAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	oinkMemberAfterSelectHooks = []OinkMemberHook{}
+
+	AddOinkMemberHook(boil.BeforeUpdateHook, oinkMemberBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	oinkMemberBeforeUpdateHooks = []OinkMemberHook{}
+
+	AddOinkMemberHook(boil.AfterUpdateHook, oinkMemberAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	oinkMemberAfterUpdateHooks = []OinkMemberHook{}
+
+	AddOinkMemberHook(boil.BeforeDeleteHook, oinkMemberBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	oinkMemberBeforeDeleteHooks = []OinkMemberHook{}
+
+	AddOinkMemberHook(boil.AfterDeleteHook, oinkMemberAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	oinkMemberAfterDeleteHooks = []OinkMemberHook{}
+
+	AddOinkMemberHook(boil.BeforeUpsertHook, oinkMemberBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	oinkMemberBeforeUpsertHooks = []OinkMemberHook{}
+
+	AddOinkMemberHook(boil.AfterUpsertHook, oinkMemberAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	oinkMemberAfterUpsertHooks = []OinkMemberHook{}
+}
+
+func testOinkMembersInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OinkMember{}
+	if err = randomize.Struct(seed, o, oinkMemberDBTypes, true, oinkMemberColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OinkMember struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := OinkMembers().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testOinkMembersInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OinkMember{}
+	if err = randomize.Struct(seed, o, oinkMemberDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize OinkMember struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(oinkMemberColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := OinkMembers().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testOinkMemberToOneOinkUsingOinkMemberOink(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local OinkMember
+	var foreign Oink
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, oinkMemberDBTypes, false, oinkMemberColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OinkMember struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, oinkDBTypes, false, oinkColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Oink struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.Oink = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.OinkMemberOink().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	ranAfterSelectHook := false
+	AddOinkHook(boil.AfterSelectHook, func(ctx context.Context, e boil.ContextExecutor, o *Oink) error {
+		ranAfterSelectHook = true
+		return nil
+	})
+
+	slice := OinkMemberSlice{&local}
+	if err = local.L.LoadOinkMemberOink(ctx, tx, false, (*[]*OinkMember)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.OinkMemberOink == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.OinkMemberOink = nil
+	if err = local.L.LoadOinkMemberOink(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.OinkMemberOink == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	if !ranAfterSelectHook {
+		t.Error("failed to run AfterSelect hook for relationship")
+	}
+}
+
+func testOinkMemberToOneUserUsingOinkMemberUser(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local OinkMember
+	var foreign User
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, oinkMemberDBTypes, false, oinkMemberColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OinkMember struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, userDBTypes, false, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.User = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.OinkMemberUser().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	ranAfterSelectHook := false
+	AddUserHook(boil.AfterSelectHook, func(ctx context.Context, e boil.ContextExecutor, o *User) error {
+		ranAfterSelectHook = true
+		return nil
+	})
+
+	slice := OinkMemberSlice{&local}
+	if err = local.L.LoadOinkMemberUser(ctx, tx, false, (*[]*OinkMember)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.OinkMemberUser == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.OinkMemberUser = nil
+	if err = local.L.LoadOinkMemberUser(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.OinkMemberUser == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	if !ranAfterSelectHook {
+		t.Error("failed to run AfterSelect hook for relationship")
+	}
+}
+
+func testOinkMemberToOneSetOpOinkUsingOinkMemberOink(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a OinkMember
+	var b, c Oink
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, oinkMemberDBTypes, false, strmangle.SetComplement(oinkMemberPrimaryKeyColumns, oinkMemberColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, oinkDBTypes, false, strmangle.SetComplement(oinkPrimaryKeyColumns, oinkColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, oinkDBTypes, false, strmangle.SetComplement(oinkPrimaryKeyColumns, oinkColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Oink{&b, &c} {
+		err = a.SetOinkMemberOink(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.OinkMemberOink != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.OinkMembers[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.Oink != x.ID {
+			t.Error("foreign key was wrong value", a.Oink)
+		}
+
+		if exists, err := OinkMemberExists(ctx, tx, a.Oink, a.User); err != nil {
+			t.Fatal(err)
+		} else if !exists {
+			t.Error("want 'a' to exist")
+		}
+
+	}
+}
+func testOinkMemberToOneSetOpUserUsingOinkMemberUser(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a OinkMember
+	var b, c User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, oinkMemberDBTypes, false, strmangle.SetComplement(oinkMemberPrimaryKeyColumns, oinkMemberColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*User{&b, &c} {
+		err = a.SetOinkMemberUser(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.OinkMemberUser != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.OinkMembers[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.User != x.ID {
+			t.Error("foreign key was wrong value", a.User)
+		}
+
+		if exists, err := OinkMemberExists(ctx, tx, a.Oink, a.User); err != nil {
+			t.Fatal(err)
+		} else if !exists {
+			t.Error("want 'a' to exist")
+		}
+
+	}
+}
+
+func testOinkMembersReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OinkMember{}
+	if err = randomize.Struct(seed, o, oinkMemberDBTypes, true, oinkMemberColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OinkMember struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testOinkMembersReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OinkMember{}
+	if err = randomize.Struct(seed, o, oinkMemberDBTypes, true, oinkMemberColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OinkMember struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := OinkMemberSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testOinkMembersSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OinkMember{}
+	if err = randomize.Struct(seed, o, oinkMemberDBTypes, true, oinkMemberColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OinkMember struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := OinkMembers().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	oinkMemberDBTypes = map[string]string{`Oink`: `uuid`, `User`: `uuid`, `CreatedAt`: `timestamp with time zone`, `Role`: `character varying`}
+	_                 = bytes.MinRead
+)
+
+func testOinkMembersUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(oinkMemberPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(oinkMemberAllColumns) == len(oinkMemberPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OinkMember{}
+	if err = randomize.Struct(seed, o, oinkMemberDBTypes, true, oinkMemberColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OinkMember struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := OinkMembers().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, oinkMemberDBTypes, true, oinkMemberPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize OinkMember struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testOinkMembersSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(oinkMemberAllColumns) == len(oinkMemberPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OinkMember{}
+	if err = randomize.Struct(seed, o, oinkMemberDBTypes, true, oinkMemberColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OinkMember struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := OinkMembers().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, oinkMemberDBTypes, true, oinkMemberPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize OinkMember struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(oinkMemberAllColumns, oinkMemberPrimaryKeyColumns) {
+		fields = oinkMemberAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			oinkMemberAllColumns,
+			oinkMemberPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := OinkMemberSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testOinkMembersUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(oinkMemberAllColumns) == len(oinkMemberPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := OinkMember{}
+	if err = randomize.Struct(seed, &o, oinkMemberDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize OinkMember struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert OinkMember: %s", err)
+	}
+
+	count, err := OinkMembers().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, oinkMemberDBTypes, false, oinkMemberPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize OinkMember struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert OinkMember: %s", err)
+	}
+
+	count, err = OinkMembers().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
@@ -0,0 +1,1488 @@
+// Code generated by SQLBoiler 4.14.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dbmodels
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// OinkTransfer is an object representing the database table.
+type OinkTransfer struct {
+	Oink      string    `boil:"oink" json:"oink" toml:"oink" yaml:"oink"`
+	FromUser  string    `boil:"from_user" json:"from_user" toml:"from_user" yaml:"from_user"`
+	ToUser    string    `boil:"to_user" json:"to_user" toml:"to_user" yaml:"to_user"`
+	CreatedAt time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+
+	R *oinkTransferR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L oinkTransferL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var OinkTransferColumns = struct {
+	Oink      string
+	FromUser  string
+	ToUser    string
+	CreatedAt string
+}{
+	Oink:      "oink",
+	FromUser:  "from_user",
+	ToUser:    "to_user",
+	CreatedAt: "created_at",
+}
+
+var OinkTransferTableColumns = struct {
+	Oink      string
+	FromUser  string
+	ToUser    string
+	CreatedAt string
+}{
+	Oink:      "oink_transfers.oink",
+	FromUser:  "oink_transfers.from_user",
+	ToUser:    "oink_transfers.to_user",
+	CreatedAt: "oink_transfers.created_at",
+}
+
+// Generated where
+
+var OinkTransferWhere = struct {
+	Oink      whereHelperstring
+	FromUser  whereHelperstring
+	ToUser    whereHelperstring
+	CreatedAt whereHelpertime_Time
+}{
+	Oink:      whereHelperstring{field: "\"oink_transfers\".\"oink\""},
+	FromUser:  whereHelperstring{field: "\"oink_transfers\".\"from_user\""},
+	ToUser:    whereHelperstring{field: "\"oink_transfers\".\"to_user\""},
+	CreatedAt: whereHelpertime_Time{field: "\"oink_transfers\".\"created_at\""},
+}
+
+// OinkTransferRels is where relationship names are stored.
+var OinkTransferRels = struct {
+	FromUserUser     string
+	OinkTransferOink string
+	ToUserUser       string
+}{
+	FromUserUser:     "FromUserUser",
+	OinkTransferOink: "OinkTransferOink",
+	ToUserUser:       "ToUserUser",
+}
+
+// oinkTransferR is where relationships are stored.
+type oinkTransferR struct {
+	FromUserUser     *User `boil:"FromUserUser" json:"FromUserUser" toml:"FromUserUser" yaml:"FromUserUser"`
+	OinkTransferOink *Oink `boil:"OinkTransferOink" json:"OinkTransferOink" toml:"OinkTransferOink" yaml:"OinkTransferOink"`
+	ToUserUser       *User `boil:"ToUserUser" json:"ToUserUser" toml:"ToUserUser" yaml:"ToUserUser"`
+}
+
+// NewStruct creates a new relationship struct
+func (*oinkTransferR) NewStruct() *oinkTransferR {
+	return &oinkTransferR{}
+}
+
+func (r *oinkTransferR) GetFromUserUser() *User {
+	if r == nil {
+		return nil
+	}
+	return r.FromUserUser
+}
+
+func (r *oinkTransferR) GetOinkTransferOink() *Oink {
+	if r == nil {
+		return nil
+	}
+	return r.OinkTransferOink
+}
+
+func (r *oinkTransferR) GetToUserUser() *User {
+	if r == nil {
+		return nil
+	}
+	return r.ToUserUser
+}
+
+// oinkTransferL is where Load methods for each relationship are stored.
+type oinkTransferL struct{}
+
+var (
+	oinkTransferAllColumns            = []string{"oink", "from_user", "to_user", "created_at"}
+	oinkTransferColumnsWithoutDefault = []string{"oink", "from_user", "to_user", "created_at"}
+	oinkTransferColumnsWithDefault    = []string{}
+	oinkTransferPrimaryKeyColumns     = []string{"oink"}
+	oinkTransferGeneratedColumns      = []string{}
+)
+
+type (
+	// OinkTransferSlice is an alias for a slice of pointers to OinkTransfer.
+	// This should almost always be used instead of []OinkTransfer.
+	OinkTransferSlice []*OinkTransfer
+	// OinkTransferHook is the signature for custom OinkTransfer hook methods
+	OinkTransferHook func(context.Context, boil.ContextExecutor, *OinkTransfer) error
+
+	oinkTransferQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	oinkTransferType                 = reflect.TypeOf(&OinkTransfer{})
+	oinkTransferMapping              = queries.MakeStructMapping(oinkTransferType)
+	oinkTransferPrimaryKeyMapping, _ = queries.BindMapping(oinkTransferType, oinkTransferMapping, oinkTransferPrimaryKeyColumns)
+	oinkTransferInsertCacheMut       sync.RWMutex
+	oinkTransferInsertCache          = make(map[string]insertCache)
+	oinkTransferUpdateCacheMut       sync.RWMutex
+	oinkTransferUpdateCache          = make(map[string]updateCache)
+	oinkTransferUpsertCacheMut       sync.RWMutex
+	oinkTransferUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var oinkTransferAfterSelectHooks []OinkTransferHook
+
+var oinkTransferBeforeInsertHooks []OinkTransferHook
+var oinkTransferAfterInsertHooks []OinkTransferHook
+
+var oinkTransferBeforeUpdateHooks []OinkTransferHook
+var oinkTransferAfterUpdateHooks []OinkTransferHook
+
+var oinkTransferBeforeDeleteHooks []OinkTransferHook
+var oinkTransferAfterDeleteHooks []OinkTransferHook
+
+var oinkTransferBeforeUpsertHooks []OinkTransferHook
+var oinkTransferAfterUpsertHooks []OinkTransferHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *OinkTransfer) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range oinkTransferAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *OinkTransfer) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range oinkTransferBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *OinkTransfer) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range oinkTransferAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *OinkTransfer) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range oinkTransferBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *OinkTransfer) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range oinkTransferAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *OinkTransfer) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range oinkTransferBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *OinkTransfer) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range oinkTransferAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *OinkTransfer) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range oinkTransferBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *OinkTransfer) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range oinkTransferAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddOinkTransferHook registers your hook function for all future operations.
+func AddOinkTransferHook(hookPoint boil.HookPoint, oinkTransferHook OinkTransferHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		oinkTransferAfterSelectHooks = append(oinkTransferAfterSelectHooks, oinkTransferHook)
+	case boil.BeforeInsertHook:
+		oinkTransferBeforeInsertHooks = append(oinkTransferBeforeInsertHooks, oinkTransferHook)
+	case boil.AfterInsertHook:
+		oinkTransferAfterInsertHooks = append(oinkTransferAfterInsertHooks, oinkTransferHook)
+	case boil.BeforeUpdateHook:
+		oinkTransferBeforeUpdateHooks = append(oinkTransferBeforeUpdateHooks, oinkTransferHook)
+	case boil.AfterUpdateHook:
+		oinkTransferAfterUpdateHooks = append(oinkTransferAfterUpdateHooks, oinkTransferHook)
+	case boil.BeforeDeleteHook:
+		oinkTransferBeforeDeleteHooks = append(oinkTransferBeforeDeleteHooks, oinkTransferHook)
+	case boil.AfterDeleteHook:
+		oinkTransferAfterDeleteHooks = append(oinkTransferAfterDeleteHooks, oinkTransferHook)
+	case boil.BeforeUpsertHook:
+		oinkTransferBeforeUpsertHooks = append(oinkTransferBeforeUpsertHooks, oinkTransferHook)
+	case boil.AfterUpsertHook:
+		oinkTransferAfterUpsertHooks = append(oinkTransferAfterUpsertHooks, oinkTransferHook)
+	}
+}
+
+// One returns a single oinkTransfer record from the query.
+func (q oinkTransferQuery) One(ctx context.Context, exec boil.ContextExecutor) (*OinkTransfer, error) {
+	o := &OinkTransfer{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "dbmodels: failed to execute a one query for oink_transfers")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all OinkTransfer records from the query.
+func (q oinkTransferQuery) All(ctx context.Context, exec boil.ContextExecutor) (OinkTransferSlice, error) {
+	var o []*OinkTransfer
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "dbmodels: failed to assign all query results to OinkTransfer slice")
+	}
+
+	if len(oinkTransferAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all OinkTransfer records in the query.
+func (q oinkTransferQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to count oink_transfers rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q oinkTransferQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "dbmodels: failed to check if oink_transfers exists")
+	}
+
+	return count > 0, nil
+}
+
+// FromUserUser pointed to by the foreign key.
+func (o *OinkTransfer) FromUserUser(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.FromUser),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// OinkTransferOink pointed to by the foreign key.
+func (o *OinkTransfer) OinkTransferOink(mods ...qm.QueryMod) oinkQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.Oink),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Oinks(queryMods...)
+}
+
+// ToUserUser pointed to by the foreign key.
+func (o *OinkTransfer) ToUserUser(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ToUser),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// LoadFromUserUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (oinkTransferL) LoadFromUserUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeOinkTransfer interface{}, mods queries.Applicator) error {
+	var slice []*OinkTransfer
+	var object *OinkTransfer
+
+	if singular {
+		var ok bool
+		object, ok = maybeOinkTransfer.(*OinkTransfer)
+		if !ok {
+			object = new(OinkTransfer)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeOinkTransfer)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeOinkTransfer))
+			}
+		}
+	} else {
+		s, ok := maybeOinkTransfer.(*[]*OinkTransfer)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeOinkTransfer)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeOinkTransfer))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &oinkTransferR{}
+		}
+		args = append(args, object.FromUser)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &oinkTransferR{}
+			}
+
+			for _, a := range args {
+				if a == obj.FromUser {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.FromUser)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.FromUserUser = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.FromUserOinkTransfers = append(foreign.R.FromUserOinkTransfers, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.FromUser == foreign.ID {
+				local.R.FromUserUser = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.FromUserOinkTransfers = append(foreign.R.FromUserOinkTransfers, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadOinkTransferOink allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (oinkTransferL) LoadOinkTransferOink(ctx context.Context, e boil.ContextExecutor, singular bool, maybeOinkTransfer interface{}, mods queries.Applicator) error {
+	var slice []*OinkTransfer
+	var object *OinkTransfer
+
+	if singular {
+		var ok bool
+		object, ok = maybeOinkTransfer.(*OinkTransfer)
+		if !ok {
+			object = new(OinkTransfer)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeOinkTransfer)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeOinkTransfer))
+			}
+		}
+	} else {
+		s, ok := maybeOinkTransfer.(*[]*OinkTransfer)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeOinkTransfer)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeOinkTransfer))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &oinkTransferR{}
+		}
+		args = append(args, object.Oink)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &oinkTransferR{}
+			}
+
+			for _, a := range args {
+				if a == obj.Oink {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.Oink)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`oinks`),
+		qm.WhereIn(`oinks.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Oink")
+	}
+
+	var resultSlice []*Oink
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Oink")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for oinks")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for oinks")
+	}
+
+	if len(oinkAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.OinkTransferOink = foreign
+		if foreign.R == nil {
+			foreign.R = &oinkR{}
+		}
+		foreign.R.OinkTransfer = object
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.Oink == foreign.ID {
+				local.R.OinkTransferOink = foreign
+				if foreign.R == nil {
+					foreign.R = &oinkR{}
+				}
+				foreign.R.OinkTransfer = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadToUserUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (oinkTransferL) LoadToUserUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeOinkTransfer interface{}, mods queries.Applicator) error {
+	var slice []*OinkTransfer
+	var object *OinkTransfer
+
+	if singular {
+		var ok bool
+		object, ok = maybeOinkTransfer.(*OinkTransfer)
+		if !ok {
+			object = new(OinkTransfer)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeOinkTransfer)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeOinkTransfer))
+			}
+		}
+	} else {
+		s, ok := maybeOinkTransfer.(*[]*OinkTransfer)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeOinkTransfer)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeOinkTransfer))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &oinkTransferR{}
+		}
+		args = append(args, object.ToUser)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &oinkTransferR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ToUser {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ToUser)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.ToUserUser = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.ToUserOinkTransfers = append(foreign.R.ToUserOinkTransfers, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ToUser == foreign.ID {
+				local.R.ToUserUser = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.ToUserOinkTransfers = append(foreign.R.ToUserOinkTransfers, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetFromUserUser of the oinkTransfer to the related item.
+// Sets o.R.FromUserUser to related.
+// Adds o to related.R.FromUserOinkTransfers.
+func (o *OinkTransfer) SetFromUserUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"oink_transfers\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"from_user"}),
+		strmangle.WhereClause("\"", "\"", 2, oinkTransferPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.Oink}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.FromUser = related.ID
+	if o.R == nil {
+		o.R = &oinkTransferR{
+			FromUserUser: related,
+		}
+	} else {
+		o.R.FromUserUser = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			FromUserOinkTransfers: OinkTransferSlice{o},
+		}
+	} else {
+		related.R.FromUserOinkTransfers = append(related.R.FromUserOinkTransfers, o)
+	}
+
+	return nil
+}
+
+// SetOinkTransferOink of the oinkTransfer to the related item.
+// Sets o.R.OinkTransferOink to related.
+// Adds o to related.R.OinkTransfer.
+func (o *OinkTransfer) SetOinkTransferOink(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Oink) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"oink_transfers\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"oink"}),
+		strmangle.WhereClause("\"", "\"", 2, oinkTransferPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.Oink}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.Oink = related.ID
+	if o.R == nil {
+		o.R = &oinkTransferR{
+			OinkTransferOink: related,
+		}
+	} else {
+		o.R.OinkTransferOink = related
+	}
+
+	if related.R == nil {
+		related.R = &oinkR{
+			OinkTransfer: o,
+		}
+	} else {
+		related.R.OinkTransfer = o
+	}
+
+	return nil
+}
+
+// SetToUserUser of the oinkTransfer to the related item.
+// Sets o.R.ToUserUser to related.
+// Adds o to related.R.ToUserOinkTransfers.
+func (o *OinkTransfer) SetToUserUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"oink_transfers\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"to_user"}),
+		strmangle.WhereClause("\"", "\"", 2, oinkTransferPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.Oink}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ToUser = related.ID
+	if o.R == nil {
+		o.R = &oinkTransferR{
+			ToUserUser: related,
+		}
+	} else {
+		o.R.ToUserUser = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			ToUserOinkTransfers: OinkTransferSlice{o},
+		}
+	} else {
+		related.R.ToUserOinkTransfers = append(related.R.ToUserOinkTransfers, o)
+	}
+
+	return nil
+}
+
+// OinkTransfers retrieves all the records using an executor.
+func OinkTransfers(mods ...qm.QueryMod) oinkTransferQuery {
+	mods = append(mods, qm.From("\"oink_transfers\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"oink_transfers\".*"})
+	}
+
+	return oinkTransferQuery{q}
+}
+
+// FindOinkTransfer retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindOinkTransfer(ctx context.Context, exec boil.ContextExecutor, oink string, selectCols ...string) (*OinkTransfer, error) {
+	oinkTransferObj := &OinkTransfer{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"oink_transfers\" where \"oink\"=$1", sel,
+	)
+
+	q := queries.Raw(query, oink)
+
+	err := q.Bind(ctx, exec, oinkTransferObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "dbmodels: unable to select from oink_transfers")
+	}
+
+	if err = oinkTransferObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return oinkTransferObj, err
+	}
+
+	return oinkTransferObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *OinkTransfer) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("dbmodels: no oink_transfers provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(oinkTransferColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	oinkTransferInsertCacheMut.RLock()
+	cache, cached := oinkTransferInsertCache[key]
+	oinkTransferInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			oinkTransferAllColumns,
+			oinkTransferColumnsWithDefault,
+			oinkTransferColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(oinkTransferType, oinkTransferMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(oinkTransferType, oinkTransferMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"oink_transfers\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"oink_transfers\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to insert into oink_transfers")
+	}
+
+	if !cached {
+		oinkTransferInsertCacheMut.Lock()
+		oinkTransferInsertCache[key] = cache
+		oinkTransferInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the OinkTransfer.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *OinkTransfer) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	oinkTransferUpdateCacheMut.RLock()
+	cache, cached := oinkTransferUpdateCache[key]
+	oinkTransferUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			oinkTransferAllColumns,
+			oinkTransferPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("dbmodels: unable to update oink_transfers, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"oink_transfers\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, oinkTransferPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(oinkTransferType, oinkTransferMapping, append(wl, oinkTransferPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to update oink_transfers row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to get rows affected by update for oink_transfers")
+	}
+
+	if !cached {
+		oinkTransferUpdateCacheMut.Lock()
+		oinkTransferUpdateCache[key] = cache
+		oinkTransferUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q oinkTransferQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to update all for oink_transfers")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to retrieve rows affected for oink_transfers")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o OinkTransferSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("dbmodels: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), oinkTransferPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"oink_transfers\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, oinkTransferPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to update all in oinkTransfer slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to retrieve rows affected all in update all oinkTransfer")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *OinkTransfer) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("dbmodels: no oink_transfers provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(oinkTransferColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	oinkTransferUpsertCacheMut.RLock()
+	cache, cached := oinkTransferUpsertCache[key]
+	oinkTransferUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			oinkTransferAllColumns,
+			oinkTransferColumnsWithDefault,
+			oinkTransferColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			oinkTransferAllColumns,
+			oinkTransferPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("dbmodels: unable to upsert oink_transfers, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(oinkTransferPrimaryKeyColumns))
+			copy(conflict, oinkTransferPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"oink_transfers\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(oinkTransferType, oinkTransferMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(oinkTransferType, oinkTransferMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to upsert oink_transfers")
+	}
+
+	if !cached {
+		oinkTransferUpsertCacheMut.Lock()
+		oinkTransferUpsertCache[key] = cache
+		oinkTransferUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single OinkTransfer record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *OinkTransfer) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("dbmodels: no OinkTransfer provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), oinkTransferPrimaryKeyMapping)
+	sql := "DELETE FROM \"oink_transfers\" WHERE \"oink\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to delete from oink_transfers")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to get rows affected by delete for oink_transfers")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q oinkTransferQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("dbmodels: no oinkTransferQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to delete all from oink_transfers")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to get rows affected by deleteall for oink_transfers")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o OinkTransferSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(oinkTransferBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), oinkTransferPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"oink_transfers\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, oinkTransferPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: unable to delete all from oinkTransfer slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "dbmodels: failed to get rows affected by deleteall for oink_transfers")
+	}
+
+	if len(oinkTransferAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *OinkTransfer) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindOinkTransfer(ctx, exec, o.Oink)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *OinkTransferSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := OinkTransferSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), oinkTransferPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"oink_transfers\".* FROM \"oink_transfers\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, oinkTransferPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "dbmodels: unable to reload all in OinkTransferSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// OinkTransferExists checks if the OinkTransfer row exists.
+func OinkTransferExists(ctx context.Context, exec boil.ContextExecutor, oink string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"oink_transfers\" where \"oink\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, oink)
+	}
+	row := exec.QueryRowContext(ctx, sql, oink)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "dbmodels: unable to check if oink_transfers exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the OinkTransfer row exists.
+func (o *OinkTransfer) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return OinkTransferExists(ctx, exec, o.Oink)
+}
@@ -0,0 +1,1083 @@
+// Code generated by SQLBoiler 4.14.2 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package dbmodels
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/volatiletech/randomize"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testOinkTransfers(t *testing.T) {
+	t.Parallel()
+
+	query := OinkTransfers()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testOinkTransfersDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OinkTransfer{}
+	if err = randomize.Struct(seed, o, oinkTransferDBTypes, true, oinkTransferColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OinkTransfer struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := OinkTransfers().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testOinkTransfersQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OinkTransfer{}
+	if err = randomize.Struct(seed, o, oinkTransferDBTypes, true, oinkTransferColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OinkTransfer struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := OinkTransfers().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := OinkTransfers().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testOinkTransfersSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OinkTransfer{}
+	if err = randomize.Struct(seed, o, oinkTransferDBTypes, true, oinkTransferColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OinkTransfer struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := OinkTransferSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := OinkTransfers().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testOinkTransfersExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OinkTransfer{}
+	if err = randomize.Struct(seed, o, oinkTransferDBTypes, true, oinkTransferColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OinkTransfer struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := OinkTransferExists(ctx, tx, o.Oink)
+	if err != nil {
+		t.Errorf("Unable to check if OinkTransfer exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected OinkTransferExists to return true, but got false.")
+	}
+}
+
+func testOinkTransfersFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OinkTransfer{}
+	if err = randomize.Struct(seed, o, oinkTransferDBTypes, true, oinkTransferColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OinkTransfer struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	oinkTransferFound, err := FindOinkTransfer(ctx, tx, o.Oink)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if oinkTransferFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testOinkTransfersBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OinkTransfer{}
+	if err = randomize.Struct(seed, o, oinkTransferDBTypes, true, oinkTransferColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OinkTransfer struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = OinkTransfers().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testOinkTransfersOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OinkTransfer{}
+	if err = randomize.Struct(seed, o, oinkTransferDBTypes, true, oinkTransferColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OinkTransfer struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := OinkTransfers().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testOinkTransfersAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	oinkTransferOne := &OinkTransfer{}
+	oinkTransferTwo := &OinkTransfer{}
+	if err = randomize.Struct(seed, oinkTransferOne, oinkTransferDBTypes, false, oinkTransferColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OinkTransfer struct: %s", err)
+	}
+	if err = randomize.Struct(seed, oinkTransferTwo, oinkTransferDBTypes, false, oinkTransferColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OinkTransfer struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = oinkTransferOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = oinkTransferTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := OinkTransfers().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testOinkTransfersCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	oinkTransferOne := &OinkTransfer{}
+	oinkTransferTwo := &OinkTransfer{}
+	if err = randomize.Struct(seed, oinkTransferOne, oinkTransferDBTypes, false, oinkTransferColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OinkTransfer struct: %s", err)
+	}
+	if err = randomize.Struct(seed, oinkTransferTwo, oinkTransferDBTypes, false, oinkTransferColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OinkTransfer struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = oinkTransferOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = oinkTransferTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := OinkTransfers().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func oinkTransferBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *OinkTransfer) error {
+	*o = OinkTransfer{}
+	return nil
+}
+
+func oinkTransferAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *OinkTransfer) error {
+	*o = OinkTransfer{}
+	return nil
+}
+
+func oinkTransferAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *OinkTransfer) error {
+	*o = OinkTransfer{}
+	return nil
+}
+
+func oinkTransferBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *OinkTransfer) error {
+	*o = OinkTransfer{}
+	return nil
+}
+
+func oinkTransferAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *OinkTransfer) error {
+	*o = OinkTransfer{}
+	return nil
+}
+
+func oinkTransferBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *OinkTransfer) error {
+	*o = OinkTransfer{}
+	return nil
+}
+
+func oinkTransferAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *OinkTransfer) error {
+	*o = OinkTransfer{}
+	return nil
+}
+
+func oinkTransferBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *OinkTransfer) error {
+	*o = OinkTransfer{}
+	return nil
+}
+
+func oinkTransferAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *OinkTransfer) error {
+	*o = OinkTransfer{}
+	return nil
+}
+
+func testOinkTransfersHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &OinkTransfer{}
+	o := &OinkTransfer{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, oinkTransferDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize OinkTransfer object: %s", err)
+	}
+
+	AddOinkTransferHook(boil.BeforeInsertHook, oinkTransferBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	oinkTransferBeforeInsertHooks = []OinkTransferHook{}
+
+	AddOinkTransferHook(boil.AfterInsertHook, oinkTransferAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	oinkTransferAfterInsertHooks = []OinkTransferHook{}
+
+	AddOinkTransferHook(boil.AfterSelectHook, oinkTransferAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	oinkTransferAfterSelectHooks = []OinkTransferHook{}
+
+	AddOinkTransferHook(boil.BeforeUpdateHook, oinkTransferBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	oinkTransferBeforeUpdateHooks = []OinkTransferHook{}
+
+	AddOinkTransferHook(boil.AfterUpdateHook, oinkTransferAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	oinkTransferAfterUpdateHooks = []OinkTransferHook{}
+
+	AddOinkTransferHook(boil.BeforeDeleteHook, oinkTransferBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	oinkTransferBeforeDeleteHooks = []OinkTransferHook{}
+
+	AddOinkTransferHook(boil.AfterDeleteHook, oinkTransferAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	oinkTransferAfterDeleteHooks = []OinkTransferHook{}
+
+	AddOinkTransferHook(boil.BeforeUpsertHook, oinkTransferBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	oinkTransferBeforeUpsertHooks = []OinkTransferHook{}
+
+	AddOinkTransferHook(boil.AfterUpsertHook, oinkTransferAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	oinkTransferAfterUpsertHooks = []OinkTransferHook{}
+}
+
+func testOinkTransfersInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OinkTransfer{}
+	if err = randomize.Struct(seed, o, oinkTransferDBTypes, true, oinkTransferColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OinkTransfer struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := OinkTransfers().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testOinkTransfersInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OinkTransfer{}
+	if err = randomize.Struct(seed, o, oinkTransferDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize OinkTransfer struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(oinkTransferColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := OinkTransfers().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testOinkTransferToOneUserUsingFromUserUser(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local OinkTransfer
+	var foreign User
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, oinkTransferDBTypes, false, oinkTransferColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OinkTransfer struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, userDBTypes, false, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.FromUser = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.FromUserUser().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	ranAfterSelectHook := false
+	AddUserHook(boil.AfterSelectHook, func(ctx context.Context, e boil.ContextExecutor, o *User) error {
+		ranAfterSelectHook = true
+		return nil
+	})
+
+	slice := OinkTransferSlice{&local}
+	if err = local.L.LoadFromUserUser(ctx, tx, false, (*[]*OinkTransfer)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.FromUserUser == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.FromUserUser = nil
+	if err = local.L.LoadFromUserUser(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.FromUserUser == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	if !ranAfterSelectHook {
+		t.Error("failed to run AfterSelect hook for relationship")
+	}
+}
+
+func testOinkTransferToOneOinkUsingOinkTransferOink(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local OinkTransfer
+	var foreign Oink
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, oinkTransferDBTypes, false, oinkTransferColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OinkTransfer struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, oinkDBTypes, false, oinkColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Oink struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.Oink = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.OinkTransferOink().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	ranAfterSelectHook := false
+	AddOinkHook(boil.AfterSelectHook, func(ctx context.Context, e boil.ContextExecutor, o *Oink) error {
+		ranAfterSelectHook = true
+		return nil
+	})
+
+	slice := OinkTransferSlice{&local}
+	if err = local.L.LoadOinkTransferOink(ctx, tx, false, (*[]*OinkTransfer)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.OinkTransferOink == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.OinkTransferOink = nil
+	if err = local.L.LoadOinkTransferOink(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.OinkTransferOink == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	if !ranAfterSelectHook {
+		t.Error("failed to run AfterSelect hook for relationship")
+	}
+}
+
+func testOinkTransferToOneUserUsingToUserUser(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local OinkTransfer
+	var foreign User
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, oinkTransferDBTypes, false, oinkTransferColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OinkTransfer struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, userDBTypes, false, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.ToUser = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.ToUserUser().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	ranAfterSelectHook := false
+	AddUserHook(boil.AfterSelectHook, func(ctx context.Context, e boil.ContextExecutor, o *User) error {
+		ranAfterSelectHook = true
+		return nil
+	})
+
+	slice := OinkTransferSlice{&local}
+	if err = local.L.LoadToUserUser(ctx, tx, false, (*[]*OinkTransfer)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.ToUserUser == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.ToUserUser = nil
+	if err = local.L.LoadToUserUser(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.ToUserUser == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	if !ranAfterSelectHook {
+		t.Error("failed to run AfterSelect hook for relationship")
+	}
+}
+
+func testOinkTransferToOneSetOpUserUsingFromUserUser(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a OinkTransfer
+	var b, c User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, oinkTransferDBTypes, false, strmangle.SetComplement(oinkTransferPrimaryKeyColumns, oinkTransferColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*User{&b, &c} {
+		err = a.SetFromUserUser(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.FromUserUser != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.FromUserOinkTransfers[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.FromUser != x.ID {
+			t.Error("foreign key was wrong value", a.FromUser)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.FromUser))
+		reflect.Indirect(reflect.ValueOf(&a.FromUser)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.FromUser != x.ID {
+			t.Error("foreign key was wrong value", a.FromUser, x.ID)
+		}
+	}
+}
+func testOinkTransferToOneSetOpOinkUsingOinkTransferOink(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a OinkTransfer
+	var b, c Oink
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, oinkTransferDBTypes, false, strmangle.SetComplement(oinkTransferPrimaryKeyColumns, oinkTransferColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, oinkDBTypes, false, strmangle.SetComplement(oinkPrimaryKeyColumns, oinkColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, oinkDBTypes, false, strmangle.SetComplement(oinkPrimaryKeyColumns, oinkColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*Oink{&b, &c} {
+		err = a.SetOinkTransferOink(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.OinkTransferOink != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.OinkTransfer != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.Oink != x.ID {
+			t.Error("foreign key was wrong value", a.Oink)
+		}
+
+		if exists, err := OinkTransferExists(ctx, tx, a.Oink); err != nil {
+			t.Fatal(err)
+		} else if !exists {
+			t.Error("want 'a' to exist")
+		}
+
+	}
+}
+func testOinkTransferToOneSetOpUserUsingToUserUser(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a OinkTransfer
+	var b, c User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, oinkTransferDBTypes, false, strmangle.SetComplement(oinkTransferPrimaryKeyColumns, oinkTransferColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*User{&b, &c} {
+		err = a.SetToUserUser(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.ToUserUser != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.ToUserOinkTransfers[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.ToUser != x.ID {
+			t.Error("foreign key was wrong value", a.ToUser)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.ToUser))
+		reflect.Indirect(reflect.ValueOf(&a.ToUser)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.ToUser != x.ID {
+			t.Error("foreign key was wrong value", a.ToUser, x.ID)
+		}
+	}
+}
+
+func testOinkTransfersReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OinkTransfer{}
+	if err = randomize.Struct(seed, o, oinkTransferDBTypes, true, oinkTransferColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OinkTransfer struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testOinkTransfersReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OinkTransfer{}
+	if err = randomize.Struct(seed, o, oinkTransferDBTypes, true, oinkTransferColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OinkTransfer struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := OinkTransferSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testOinkTransfersSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OinkTransfer{}
+	if err = randomize.Struct(seed, o, oinkTransferDBTypes, true, oinkTransferColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OinkTransfer struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := OinkTransfers().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	oinkTransferDBTypes = map[string]string{`Oink`: `uuid`, `FromUser`: `uuid`, `ToUser`: `uuid`, `CreatedAt`: `timestamp with time zone`}
+	_                   = bytes.MinRead
+)
+
+func testOinkTransfersUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(oinkTransferPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(oinkTransferAllColumns) == len(oinkTransferPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OinkTransfer{}
+	if err = randomize.Struct(seed, o, oinkTransferDBTypes, true, oinkTransferColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OinkTransfer struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := OinkTransfers().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, oinkTransferDBTypes, true, oinkTransferPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize OinkTransfer struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testOinkTransfersSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(oinkTransferAllColumns) == len(oinkTransferPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &OinkTransfer{}
+	if err = randomize.Struct(seed, o, oinkTransferDBTypes, true, oinkTransferColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OinkTransfer struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := OinkTransfers().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, oinkTransferDBTypes, true, oinkTransferPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize OinkTransfer struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(oinkTransferAllColumns, oinkTransferPrimaryKeyColumns) {
+		fields = oinkTransferAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			oinkTransferAllColumns,
+			oinkTransferPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := OinkTransferSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testOinkTransfersUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(oinkTransferAllColumns) == len(oinkTransferPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := OinkTransfer{}
+	if err = randomize.Struct(seed, &o, oinkTransferDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize OinkTransfer struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert OinkTransfer: %s", err)
+	}
+
+	count, err := OinkTransfers().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, oinkTransferDBTypes, false, oinkTransferPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize OinkTransfer struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert OinkTransfer: %s", err)
+	}
+
+	count, err = OinkTransfers().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
@@ -24,74 +24,86 @@ import (
 
 // Oink is an object representing the database table.
 type Oink struct {
-	Name        string      `boil:"name" json:"name" toml:"name" yaml:"name"`
-	ID          string      `boil:"id" json:"id" toml:"id" yaml:"id"`
-	Description null.String `boil:"description" json:"description,omitempty" toml:"description" yaml:"description,omitempty"`
-	Creator     string      `boil:"creator" json:"creator" toml:"creator" yaml:"creator"`
-	CreatedAt   time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
-	UpdatedAt   time.Time   `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	Name         string      `boil:"name" json:"name" toml:"name" yaml:"name"`
+	ID           string      `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Description  null.String `boil:"description" json:"description,omitempty" toml:"description" yaml:"description,omitempty"`
+	Creator      string      `boil:"creator" json:"creator" toml:"creator" yaml:"creator"`
+	CreatedAt    time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt    time.Time   `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	Visibility   string      `boil:"visibility" json:"visibility" toml:"visibility" yaml:"visibility"`
+	Language     string      `boil:"language" json:"language" toml:"language" yaml:"language"`
+	SearchVector null.String `boil:"search_vector" json:"search_vector,omitempty" toml:"search_vector" yaml:"search_vector,omitempty"`
+	ArchivedAt   null.Time   `boil:"archived_at" json:"archived_at,omitempty" toml:"archived_at" yaml:"archived_at,omitempty"`
+	ArchivedBy   null.String `boil:"archived_by" json:"archived_by,omitempty" toml:"archived_by" yaml:"archived_by,omitempty"`
+	AvatarKey    string      `boil:"avatar_key" json:"avatar_key" toml:"avatar_key" yaml:"avatar_key"`
+	BannerKey    string      `boil:"banner_key" json:"banner_key" toml:"banner_key" yaml:"banner_key"`
 
 	R *oinkR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L oinkL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var OinkColumns = struct {
-	Name        string
-	ID          string
-	Description string
-	Creator     string
-	CreatedAt   string
-	UpdatedAt   string
+	Name         string
+	ID           string
+	Description  string
+	Creator      string
+	CreatedAt    string
+	UpdatedAt    string
+	Visibility   string
+	Language     string
+	SearchVector string
+	ArchivedAt   string
+	ArchivedBy   string
+	AvatarKey    string
+	BannerKey    string
 }{
-	Name:        "name",
-	ID:          "id",
-	Description: "description",
-	Creator:     "creator",
-	CreatedAt:   "created_at",
-	UpdatedAt:   "updated_at",
+	Name:         "name",
+	ID:           "id",
+	Description:  "description",
+	Creator:      "creator",
+	CreatedAt:    "created_at",
+	UpdatedAt:    "updated_at",
+	Visibility:   "visibility",
+	Language:     "language",
+	SearchVector: "search_vector",
+	ArchivedAt:   "archived_at",
+	ArchivedBy:   "archived_by",
+	AvatarKey:    "avatar_key",
+	BannerKey:    "banner_key",
 }
 
 var OinkTableColumns = struct {
-	Name        string
-	ID          string
-	Description string
-	Creator     string
-	CreatedAt   string
-	UpdatedAt   string
+	Name         string
+	ID           string
+	Description  string
+	Creator      string
+	CreatedAt    string
+	UpdatedAt    string
+	Visibility   string
+	Language     string
+	SearchVector string
+	ArchivedAt   string
+	ArchivedBy   string
+	AvatarKey    string
+	BannerKey    string
 }{
-	Name:        "oinks.name",
-	ID:          "oinks.id",
-	Description: "oinks.description",
-	Creator:     "oinks.creator",
-	CreatedAt:   "oinks.created_at",
-	UpdatedAt:   "oinks.updated_at",
+	Name:         "oinks.name",
+	ID:           "oinks.id",
+	Description:  "oinks.description",
+	Creator:      "oinks.creator",
+	CreatedAt:    "oinks.created_at",
+	UpdatedAt:    "oinks.updated_at",
+	Visibility:   "oinks.visibility",
+	Language:     "oinks.language",
+	SearchVector: "oinks.search_vector",
+	ArchivedAt:   "oinks.archived_at",
+	ArchivedBy:   "oinks.archived_by",
+	AvatarKey:    "oinks.avatar_key",
+	BannerKey:    "oinks.banner_key",
 }
 
 // Generated where
 
-type whereHelperstring struct{ field string }
-
-func (w whereHelperstring) EQ(x string) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
-func (w whereHelperstring) NEQ(x string) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
-func (w whereHelperstring) LT(x string) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
-func (w whereHelperstring) LTE(x string) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
-func (w whereHelperstring) GT(x string) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
-func (w whereHelperstring) GTE(x string) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
-func (w whereHelperstring) IN(slice []string) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
-}
-func (w whereHelperstring) NIN(slice []string) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
-}
-
 type whereHelpernull_String struct{ field string }
 
 func (w whereHelpernull_String) EQ(x null.String) qm.QueryMod {
@@ -130,53 +142,91 @@ func (w whereHelpernull_String) NIN(slice []string) qm.QueryMod {
 func (w whereHelpernull_String) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
 func (w whereHelpernull_String) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
 
-type whereHelpertime_Time struct{ field string }
+type whereHelpernull_Time struct{ field string }
 
-func (w whereHelpertime_Time) EQ(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.EQ, x)
+func (w whereHelpernull_Time) EQ(x null.Time) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
 }
-func (w whereHelpertime_Time) NEQ(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+func (w whereHelpernull_Time) NEQ(x null.Time) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
 }
-func (w whereHelpertime_Time) LT(x time.Time) qm.QueryMod {
+func (w whereHelpernull_Time) LT(x null.Time) qm.QueryMod {
 	return qmhelper.Where(w.field, qmhelper.LT, x)
 }
-func (w whereHelpertime_Time) LTE(x time.Time) qm.QueryMod {
+func (w whereHelpernull_Time) LTE(x null.Time) qm.QueryMod {
 	return qmhelper.Where(w.field, qmhelper.LTE, x)
 }
-func (w whereHelpertime_Time) GT(x time.Time) qm.QueryMod {
+func (w whereHelpernull_Time) GT(x null.Time) qm.QueryMod {
 	return qmhelper.Where(w.field, qmhelper.GT, x)
 }
-func (w whereHelpertime_Time) GTE(x time.Time) qm.QueryMod {
+func (w whereHelpernull_Time) GTE(x null.Time) qm.QueryMod {
 	return qmhelper.Where(w.field, qmhelper.GTE, x)
 }
 
+func (w whereHelpernull_Time) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpernull_Time) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+
 var OinkWhere = struct {
-	Name        whereHelperstring
-	ID          whereHelperstring
-	Description whereHelpernull_String
-	Creator     whereHelperstring
-	CreatedAt   whereHelpertime_Time
-	UpdatedAt   whereHelpertime_Time
+	Name         whereHelperstring
+	ID           whereHelperstring
+	Description  whereHelpernull_String
+	Creator      whereHelperstring
+	CreatedAt    whereHelpertime_Time
+	UpdatedAt    whereHelpertime_Time
+	Visibility   whereHelperstring
+	Language     whereHelperstring
+	SearchVector whereHelpernull_String
+	ArchivedAt   whereHelpernull_Time
+	ArchivedBy   whereHelpernull_String
+	AvatarKey    whereHelperstring
+	BannerKey    whereHelperstring
 }{
-	Name:        whereHelperstring{field: "\"oinks\".\"name\""},
-	ID:          whereHelperstring{field: "\"oinks\".\"id\""},
-	Description: whereHelpernull_String{field: "\"oinks\".\"description\""},
-	Creator:     whereHelperstring{field: "\"oinks\".\"creator\""},
-	CreatedAt:   whereHelpertime_Time{field: "\"oinks\".\"created_at\""},
-	UpdatedAt:   whereHelpertime_Time{field: "\"oinks\".\"updated_at\""},
+	Name:         whereHelperstring{field: "\"oinks\".\"name\""},
+	ID:           whereHelperstring{field: "\"oinks\".\"id\""},
+	Description:  whereHelpernull_String{field: "\"oinks\".\"description\""},
+	Creator:      whereHelperstring{field: "\"oinks\".\"creator\""},
+	CreatedAt:    whereHelpertime_Time{field: "\"oinks\".\"created_at\""},
+	UpdatedAt:    whereHelpertime_Time{field: "\"oinks\".\"updated_at\""},
+	Visibility:   whereHelperstring{field: "\"oinks\".\"visibility\""},
+	Language:     whereHelperstring{field: "\"oinks\".\"language\""},
+	SearchVector: whereHelpernull_String{field: "\"oinks\".\"search_vector\""},
+	ArchivedAt:   whereHelpernull_Time{field: "\"oinks\".\"archived_at\""},
+	ArchivedBy:   whereHelpernull_String{field: "\"oinks\".\"archived_by\""},
+	AvatarKey:    whereHelperstring{field: "\"oinks\".\"avatar_key\""},
+	BannerKey:    whereHelperstring{field: "\"oinks\".\"banner_key\""},
 }
 
 // OinkRels is where relationship names are stored.
 var OinkRels = struct {
-	CreatorUser string
+	ArchivedByUser  string
+	CreatorUser     string
+	OinkTransfer    string
+	OinkAliases     string
+	OinkInvitations string
+	OinkMembers     string
+	Tags            string
+	Posts           string
 }{
-	CreatorUser: "CreatorUser",
+	ArchivedByUser:  "ArchivedByUser",
+	CreatorUser:     "CreatorUser",
+	OinkTransfer:    "OinkTransfer",
+	OinkAliases:     "OinkAliases",
+	OinkInvitations: "OinkInvitations",
+	OinkMembers:     "OinkMembers",
+	Tags:            "Tags",
+	Posts:           "Posts",
 }
 
 // oinkR is where relationships are stored.
 type oinkR struct {
-	CreatorUser *User `boil:"CreatorUser" json:"CreatorUser" toml:"CreatorUser" yaml:"CreatorUser"`
+	ArchivedByUser  *User               `boil:"ArchivedByUser" json:"ArchivedByUser" toml:"ArchivedByUser" yaml:"ArchivedByUser"`
+	CreatorUser     *User               `boil:"CreatorUser" json:"CreatorUser" toml:"CreatorUser" yaml:"CreatorUser"`
+	OinkTransfer    *OinkTransfer       `boil:"OinkTransfer" json:"OinkTransfer" toml:"OinkTransfer" yaml:"OinkTransfer"`
+	OinkAliases     OinkAliasSlice      `boil:"OinkAliases" json:"OinkAliases" toml:"OinkAliases" yaml:"OinkAliases"`
+	OinkInvitations OinkInvitationSlice `boil:"OinkInvitations" json:"OinkInvitations" toml:"OinkInvitations" yaml:"OinkInvitations"`
+	OinkMembers     OinkMemberSlice     `boil:"OinkMembers" json:"OinkMembers" toml:"OinkMembers" yaml:"OinkMembers"`
+	Tags            TagSlice            `boil:"Tags" json:"Tags" toml:"Tags" yaml:"Tags"`
+	Posts           PostSlice           `boil:"Posts" json:"Posts" toml:"Posts" yaml:"Posts"`
 }
 
 // NewStruct creates a new relationship struct
@@ -184,6 +234,13 @@ func (*oinkR) NewStruct() *oinkR {
 	return &oinkR{}
 }
 
+func (r *oinkR) GetArchivedByUser() *User {
+	if r == nil {
+		return nil
+	}
+	return r.ArchivedByUser
+}
+
 func (r *oinkR) GetCreatorUser() *User {
 	if r == nil {
 		return nil
@@ -191,13 +248,55 @@ func (r *oinkR) GetCreatorUser() *User {
 	return r.CreatorUser
 }
 
+func (r *oinkR) GetOinkTransfer() *OinkTransfer {
+	if r == nil {
+		return nil
+	}
+	return r.OinkTransfer
+}
+
+func (r *oinkR) GetOinkAliases() OinkAliasSlice {
+	if r == nil {
+		return nil
+	}
+	return r.OinkAliases
+}
+
+func (r *oinkR) GetOinkInvitations() OinkInvitationSlice {
+	if r == nil {
+		return nil
+	}
+	return r.OinkInvitations
+}
+
+func (r *oinkR) GetOinkMembers() OinkMemberSlice {
+	if r == nil {
+		return nil
+	}
+	return r.OinkMembers
+}
+
+func (r *oinkR) GetTags() TagSlice {
+	if r == nil {
+		return nil
+	}
+	return r.Tags
+}
+
+func (r *oinkR) GetPosts() PostSlice {
+	if r == nil {
+		return nil
+	}
+	return r.Posts
+}
+
 // oinkL is where Load methods for each relationship are stored.
 type oinkL struct{}
 
 var (
-	oinkAllColumns            = []string{"name", "id", "description", "creator", "created_at", "updated_at"}
+	oinkAllColumns            = []string{"name", "id", "description", "creator", "created_at", "updated_at", "visibility", "language", "search_vector", "archived_at", "archived_by", "avatar_key", "banner_key"}
 	oinkColumnsWithoutDefault = []string{"name", "id", "creator", "created_at", "updated_at"}
-	oinkColumnsWithDefault    = []string{"description"}
+	oinkColumnsWithDefault    = []string{"description", "visibility", "language", "search_vector", "archived_at", "archived_by", "avatar_key", "banner_key"}
 	oinkPrimaryKeyColumns     = []string{"id"}
 	oinkGeneratedColumns      = []string{}
 )
@@ -480,6 +579,17 @@ func (q oinkQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool,
 	return count > 0, nil
 }
 
+// ArchivedByUser pointed to by the foreign key.
+func (o *Oink) ArchivedByUser(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ArchivedBy),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
 // CreatorUser pointed to by the foreign key.
 func (o *Oink) CreatorUser(mods ...qm.QueryMod) userQuery {
 	queryMods := []qm.QueryMod{
@@ -491,9 +601,91 @@ func (o *Oink) CreatorUser(mods ...qm.QueryMod) userQuery {
 	return Users(queryMods...)
 }
 
-// LoadCreatorUser allows an eager lookup of values, cached into the
+// OinkTransfer pointed to by the foreign key.
+func (o *Oink) OinkTransfer(mods ...qm.QueryMod) oinkTransferQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"oink\" = ?", o.ID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return OinkTransfers(queryMods...)
+}
+
+// OinkAliases retrieves all the oink_alias's OinkAliases with an executor.
+func (o *Oink) OinkAliases(mods ...qm.QueryMod) oinkAliasQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"oink_aliases\".\"oink\"=?", o.ID),
+	)
+
+	return OinkAliases(queryMods...)
+}
+
+// OinkInvitations retrieves all the oink_invitation's OinkInvitations with an executor.
+func (o *Oink) OinkInvitations(mods ...qm.QueryMod) oinkInvitationQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"oink_invitations\".\"oink\"=?", o.ID),
+	)
+
+	return OinkInvitations(queryMods...)
+}
+
+// OinkMembers retrieves all the oink_member's OinkMembers with an executor.
+func (o *Oink) OinkMembers(mods ...qm.QueryMod) oinkMemberQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"oink_members\".\"oink\"=?", o.ID),
+	)
+
+	return OinkMembers(queryMods...)
+}
+
+// Tags retrieves all the tag's Tags with an executor.
+func (o *Oink) Tags(mods ...qm.QueryMod) tagQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.InnerJoin("\"oink_tags\" on \"tags\".\"name\" = \"oink_tags\".\"tag\""),
+		qm.Where("\"oink_tags\".\"oink\"=?", o.ID),
+	)
+
+	return Tags(queryMods...)
+}
+
+// Posts retrieves all the post's Posts with an executor.
+func (o *Oink) Posts(mods ...qm.QueryMod) postQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"posts\".\"oink\"=?", o.ID),
+	)
+
+	return Posts(queryMods...)
+}
+
+// LoadArchivedByUser allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for an N-1 relationship.
-func (oinkL) LoadCreatorUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeOink interface{}, mods queries.Applicator) error {
+func (oinkL) LoadArchivedByUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeOink interface{}, mods queries.Applicator) error {
 	var slice []*Oink
 	var object *Oink
 
@@ -524,7 +716,9 @@ func (oinkL) LoadCreatorUser(ctx context.Context, e boil.ContextExecutor, singul
 		if object.R == nil {
 			object.R = &oinkR{}
 		}
-		args = append(args, object.Creator)
+		if !queries.IsNil(object.ArchivedBy) {
+			args = append(args, object.ArchivedBy)
+		}
 
 	} else {
 	Outer:
@@ -534,12 +728,14 @@ func (oinkL) LoadCreatorUser(ctx context.Context, e boil.ContextExecutor, singul
 			}
 
 			for _, a := range args {
-				if a == obj.Creator {
+				if queries.Equal(a, obj.ArchivedBy) {
 					continue Outer
 				}
 			}
 
-			args = append(args, obj.Creator)
+			if !queries.IsNil(obj.ArchivedBy) {
+				args = append(args, obj.ArchivedBy)
+			}
 
 		}
 	}
@@ -587,22 +783,22 @@ func (oinkL) LoadCreatorUser(ctx context.Context, e boil.ContextExecutor, singul
 
 	if singular {
 		foreign := resultSlice[0]
-		object.R.CreatorUser = foreign
+		object.R.ArchivedByUser = foreign
 		if foreign.R == nil {
 			foreign.R = &userR{}
 		}
-		foreign.R.CreatorOinks = append(foreign.R.CreatorOinks, object)
+		foreign.R.ArchivedByOinks = append(foreign.R.ArchivedByOinks, object)
 		return nil
 	}
 
 	for _, local := range slice {
 		for _, foreign := range resultSlice {
-			if local.Creator == foreign.ID {
-				local.R.CreatorUser = foreign
+			if queries.Equal(local.ArchivedBy, foreign.ID) {
+				local.R.ArchivedByUser = foreign
 				if foreign.R == nil {
 					foreign.R = &userR{}
 				}
-				foreign.R.CreatorOinks = append(foreign.R.CreatorOinks, local)
+				foreign.R.ArchivedByOinks = append(foreign.R.ArchivedByOinks, local)
 				break
 			}
 		}
@@ -611,53 +807,1364 @@ func (oinkL) LoadCreatorUser(ctx context.Context, e boil.ContextExecutor, singul
 	return nil
 }
 
-// SetCreatorUser of the oink to the related item.
-// Sets o.R.CreatorUser to related.
-// Adds o to related.R.CreatorOinks.
-func (o *Oink) SetCreatorUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
-	var err error
-	if insert {
-		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
-			return errors.Wrap(err, "failed to insert into foreign table")
+// LoadCreatorUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (oinkL) LoadCreatorUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeOink interface{}, mods queries.Applicator) error {
+	var slice []*Oink
+	var object *Oink
+
+	if singular {
+		var ok bool
+		object, ok = maybeOink.(*Oink)
+		if !ok {
+			object = new(Oink)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeOink)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeOink))
+			}
+		}
+	} else {
+		s, ok := maybeOink.(*[]*Oink)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeOink)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeOink))
+			}
 		}
 	}
 
-	updateQuery := fmt.Sprintf(
-		"UPDATE \"oinks\" SET %s WHERE %s",
-		strmangle.SetParamNames("\"", "\"", 1, []string{"creator"}),
-		strmangle.WhereClause("\"", "\"", 2, oinkPrimaryKeyColumns),
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &oinkR{}
+		}
+		args = append(args, object.Creator)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &oinkR{}
+			}
+
+			for _, a := range args {
+				if a == obj.Creator {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.Creator)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, args...),
 	)
-	values := []interface{}{related.ID, o.ID}
+	if mods != nil {
+		mods.Apply(query)
+	}
 
-	if boil.IsDebug(ctx) {
-		writer := boil.DebugWriterFrom(ctx)
-		fmt.Fprintln(writer, updateQuery)
-		fmt.Fprintln(writer, values)
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
 	}
-	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
-		return errors.Wrap(err, "failed to update local table")
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
 	}
 
-	o.Creator = related.ID
-	if o.R == nil {
-		o.R = &oinkR{
-			CreatorUser: related,
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
 		}
-	} else {
-		o.R.CreatorUser = related
 	}
 
-	if related.R == nil {
-		related.R = &userR{
-			CreatorOinks: OinkSlice{o},
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.CreatorUser = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.CreatorOinks = append(foreign.R.CreatorOinks, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.Creator == foreign.ID {
+				local.R.CreatorUser = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.CreatorOinks = append(foreign.R.CreatorOinks, local)
+				break
+			}
 		}
-	} else {
-		related.R.CreatorOinks = append(related.R.CreatorOinks, o)
 	}
 
 	return nil
 }
 
+// LoadOinkTransfer allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-1 relationship.
+func (oinkL) LoadOinkTransfer(ctx context.Context, e boil.ContextExecutor, singular bool, maybeOink interface{}, mods queries.Applicator) error {
+	var slice []*Oink
+	var object *Oink
+
+	if singular {
+		var ok bool
+		object, ok = maybeOink.(*Oink)
+		if !ok {
+			object = new(Oink)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeOink)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeOink))
+			}
+		}
+	} else {
+		s, ok := maybeOink.(*[]*Oink)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeOink)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeOink))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &oinkR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &oinkR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`oink_transfers`),
+		qm.WhereIn(`oink_transfers.oink in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load OinkTransfer")
+	}
+
+	var resultSlice []*OinkTransfer
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice OinkTransfer")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for oink_transfers")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for oink_transfers")
+	}
+
+	if len(oinkTransferAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.OinkTransfer = foreign
+		if foreign.R == nil {
+			foreign.R = &oinkTransferR{}
+		}
+		foreign.R.OinkTransferOink = object
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ID == foreign.Oink {
+				local.R.OinkTransfer = foreign
+				if foreign.R == nil {
+					foreign.R = &oinkTransferR{}
+				}
+				foreign.R.OinkTransferOink = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadOinkAliases allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (oinkL) LoadOinkAliases(ctx context.Context, e boil.ContextExecutor, singular bool, maybeOink interface{}, mods queries.Applicator) error {
+	var slice []*Oink
+	var object *Oink
+
+	if singular {
+		var ok bool
+		object, ok = maybeOink.(*Oink)
+		if !ok {
+			object = new(Oink)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeOink)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeOink))
+			}
+		}
+	} else {
+		s, ok := maybeOink.(*[]*Oink)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeOink)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeOink))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &oinkR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &oinkR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`oink_aliases`),
+		qm.WhereIn(`oink_aliases.oink in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load oink_aliases")
+	}
+
+	var resultSlice []*OinkAlias
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice oink_aliases")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on oink_aliases")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for oink_aliases")
+	}
+
+	if len(oinkAliasAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.OinkAliases = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &oinkAliasR{}
+			}
+			foreign.R.OinkAliasOink = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.Oink {
+				local.R.OinkAliases = append(local.R.OinkAliases, foreign)
+				if foreign.R == nil {
+					foreign.R = &oinkAliasR{}
+				}
+				foreign.R.OinkAliasOink = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadOinkInvitations allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (oinkL) LoadOinkInvitations(ctx context.Context, e boil.ContextExecutor, singular bool, maybeOink interface{}, mods queries.Applicator) error {
+	var slice []*Oink
+	var object *Oink
+
+	if singular {
+		var ok bool
+		object, ok = maybeOink.(*Oink)
+		if !ok {
+			object = new(Oink)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeOink)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeOink))
+			}
+		}
+	} else {
+		s, ok := maybeOink.(*[]*Oink)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeOink)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeOink))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &oinkR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &oinkR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`oink_invitations`),
+		qm.WhereIn(`oink_invitations.oink in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load oink_invitations")
+	}
+
+	var resultSlice []*OinkInvitation
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice oink_invitations")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on oink_invitations")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for oink_invitations")
+	}
+
+	if len(oinkInvitationAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.OinkInvitations = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &oinkInvitationR{}
+			}
+			foreign.R.OinkInvitationOink = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.Oink {
+				local.R.OinkInvitations = append(local.R.OinkInvitations, foreign)
+				if foreign.R == nil {
+					foreign.R = &oinkInvitationR{}
+				}
+				foreign.R.OinkInvitationOink = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadOinkMembers allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (oinkL) LoadOinkMembers(ctx context.Context, e boil.ContextExecutor, singular bool, maybeOink interface{}, mods queries.Applicator) error {
+	var slice []*Oink
+	var object *Oink
+
+	if singular {
+		var ok bool
+		object, ok = maybeOink.(*Oink)
+		if !ok {
+			object = new(Oink)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeOink)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeOink))
+			}
+		}
+	} else {
+		s, ok := maybeOink.(*[]*Oink)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeOink)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeOink))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &oinkR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &oinkR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`oink_members`),
+		qm.WhereIn(`oink_members.oink in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load oink_members")
+	}
+
+	var resultSlice []*OinkMember
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice oink_members")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on oink_members")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for oink_members")
+	}
+
+	if len(oinkMemberAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.OinkMembers = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &oinkMemberR{}
+			}
+			foreign.R.OinkMemberOink = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.Oink {
+				local.R.OinkMembers = append(local.R.OinkMembers, foreign)
+				if foreign.R == nil {
+					foreign.R = &oinkMemberR{}
+				}
+				foreign.R.OinkMemberOink = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadTags allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (oinkL) LoadTags(ctx context.Context, e boil.ContextExecutor, singular bool, maybeOink interface{}, mods queries.Applicator) error {
+	var slice []*Oink
+	var object *Oink
+
+	if singular {
+		var ok bool
+		object, ok = maybeOink.(*Oink)
+		if !ok {
+			object = new(Oink)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeOink)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeOink))
+			}
+		}
+	} else {
+		s, ok := maybeOink.(*[]*Oink)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeOink)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeOink))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &oinkR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &oinkR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.Select("\"tags\".\"name\", \"tags\".\"created_at\", \"a\".\"oink\""),
+		qm.From("\"tags\""),
+		qm.InnerJoin("\"oink_tags\" as \"a\" on \"tags\".\"name\" = \"a\".\"tag\""),
+		qm.WhereIn("\"a\".\"oink\" in ?", args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load tags")
+	}
+
+	var resultSlice []*Tag
+
+	var localJoinCols []string
+	for results.Next() {
+		one := new(Tag)
+		var localJoinCol string
+
+		err = results.Scan(&one.Name, &one.CreatedAt, &localJoinCol)
+		if err != nil {
+			return errors.Wrap(err, "failed to scan eager loaded results for tags")
+		}
+		if err = results.Err(); err != nil {
+			return errors.Wrap(err, "failed to plebian-bind eager loaded slice tags")
+		}
+
+		resultSlice = append(resultSlice, one)
+		localJoinCols = append(localJoinCols, localJoinCol)
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on tags")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for tags")
+	}
+
+	if len(tagAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Tags = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &tagR{}
+			}
+			foreign.R.Oinks = append(foreign.R.Oinks, object)
+		}
+		return nil
+	}
+
+	for i, foreign := range resultSlice {
+		localJoinCol := localJoinCols[i]
+		for _, local := range slice {
+			if local.ID == localJoinCol {
+				local.R.Tags = append(local.R.Tags, foreign)
+				if foreign.R == nil {
+					foreign.R = &tagR{}
+				}
+				foreign.R.Oinks = append(foreign.R.Oinks, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadPosts allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (oinkL) LoadPosts(ctx context.Context, e boil.ContextExecutor, singular bool, maybeOink interface{}, mods queries.Applicator) error {
+	var slice []*Oink
+	var object *Oink
+
+	if singular {
+		var ok bool
+		object, ok = maybeOink.(*Oink)
+		if !ok {
+			object = new(Oink)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeOink)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeOink))
+			}
+		}
+	} else {
+		s, ok := maybeOink.(*[]*Oink)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeOink)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeOink))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &oinkR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &oinkR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`posts`),
+		qm.WhereIn(`posts.oink in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load posts")
+	}
+
+	var resultSlice []*Post
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice posts")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on posts")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for posts")
+	}
+
+	if len(postAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Posts = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &postR{}
+			}
+			foreign.R.PostOink = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.Oink {
+				local.R.Posts = append(local.R.Posts, foreign)
+				if foreign.R == nil {
+					foreign.R = &postR{}
+				}
+				foreign.R.PostOink = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetArchivedByUser of the oink to the related item.
+// Sets o.R.ArchivedByUser to related.
+// Adds o to related.R.ArchivedByOinks.
+func (o *Oink) SetArchivedByUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"oinks\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"archived_by"}),
+		strmangle.WhereClause("\"", "\"", 2, oinkPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.ArchivedBy, related.ID)
+	if o.R == nil {
+		o.R = &oinkR{
+			ArchivedByUser: related,
+		}
+	} else {
+		o.R.ArchivedByUser = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			ArchivedByOinks: OinkSlice{o},
+		}
+	} else {
+		related.R.ArchivedByOinks = append(related.R.ArchivedByOinks, o)
+	}
+
+	return nil
+}
+
+// RemoveArchivedByUser relationship.
+// Sets o.R.ArchivedByUser to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *Oink) RemoveArchivedByUser(ctx context.Context, exec boil.ContextExecutor, related *User) error {
+	var err error
+
+	queries.SetScanner(&o.ArchivedBy, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("archived_by")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.ArchivedByUser = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.ArchivedByOinks {
+		if queries.Equal(o.ArchivedBy, ri.ArchivedBy) {
+			continue
+		}
+
+		ln := len(related.R.ArchivedByOinks)
+		if ln > 1 && i < ln-1 {
+			related.R.ArchivedByOinks[i] = related.R.ArchivedByOinks[ln-1]
+		}
+		related.R.ArchivedByOinks = related.R.ArchivedByOinks[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetCreatorUser of the oink to the related item.
+// Sets o.R.CreatorUser to related.
+// Adds o to related.R.CreatorOinks.
+func (o *Oink) SetCreatorUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"oinks\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"creator"}),
+		strmangle.WhereClause("\"", "\"", 2, oinkPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.Creator = related.ID
+	if o.R == nil {
+		o.R = &oinkR{
+			CreatorUser: related,
+		}
+	} else {
+		o.R.CreatorUser = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			CreatorOinks: OinkSlice{o},
+		}
+	} else {
+		related.R.CreatorOinks = append(related.R.CreatorOinks, o)
+	}
+
+	return nil
+}
+
+// SetOinkTransfer of the oink to the related item.
+// Sets o.R.OinkTransfer to related.
+// Adds o to related.R.OinkTransferOink.
+func (o *Oink) SetOinkTransfer(ctx context.Context, exec boil.ContextExecutor, insert bool, related *OinkTransfer) error {
+	var err error
+
+	if insert {
+		related.Oink = o.ID
+
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	} else {
+		updateQuery := fmt.Sprintf(
+			"UPDATE \"oink_transfers\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, []string{"oink"}),
+			strmangle.WhereClause("\"", "\"", 2, oinkTransferPrimaryKeyColumns),
+		)
+		values := []interface{}{o.ID, related.Oink}
+
+		if boil.IsDebug(ctx) {
+			writer := boil.DebugWriterFrom(ctx)
+			fmt.Fprintln(writer, updateQuery)
+			fmt.Fprintln(writer, values)
+		}
+		if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+			return errors.Wrap(err, "failed to update foreign table")
+		}
+
+		related.Oink = o.ID
+	}
+
+	if o.R == nil {
+		o.R = &oinkR{
+			OinkTransfer: related,
+		}
+	} else {
+		o.R.OinkTransfer = related
+	}
+
+	if related.R == nil {
+		related.R = &oinkTransferR{
+			OinkTransferOink: o,
+		}
+	} else {
+		related.R.OinkTransferOink = o
+	}
+	return nil
+}
+
+// AddOinkAliases adds the given related objects to the existing relationships
+// of the oink, optionally inserting them as new records.
+// Appends related to o.R.OinkAliases.
+// Sets related.R.OinkAliasOink appropriately.
+func (o *Oink) AddOinkAliases(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*OinkAlias) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.Oink = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"oink_aliases\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"oink"}),
+				strmangle.WhereClause("\"", "\"", 2, oinkAliasPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.Name}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.Oink = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &oinkR{
+			OinkAliases: related,
+		}
+	} else {
+		o.R.OinkAliases = append(o.R.OinkAliases, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &oinkAliasR{
+				OinkAliasOink: o,
+			}
+		} else {
+			rel.R.OinkAliasOink = o
+		}
+	}
+	return nil
+}
+
+// AddOinkInvitations adds the given related objects to the existing relationships
+// of the oink, optionally inserting them as new records.
+// Appends related to o.R.OinkInvitations.
+// Sets related.R.OinkInvitationOink appropriately.
+func (o *Oink) AddOinkInvitations(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*OinkInvitation) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.Oink = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"oink_invitations\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"oink"}),
+				strmangle.WhereClause("\"", "\"", 2, oinkInvitationPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.Oink, rel.User}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.Oink = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &oinkR{
+			OinkInvitations: related,
+		}
+	} else {
+		o.R.OinkInvitations = append(o.R.OinkInvitations, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &oinkInvitationR{
+				OinkInvitationOink: o,
+			}
+		} else {
+			rel.R.OinkInvitationOink = o
+		}
+	}
+	return nil
+}
+
+// AddOinkMembers adds the given related objects to the existing relationships
+// of the oink, optionally inserting them as new records.
+// Appends related to o.R.OinkMembers.
+// Sets related.R.OinkMemberOink appropriately.
+func (o *Oink) AddOinkMembers(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*OinkMember) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.Oink = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"oink_members\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"oink"}),
+				strmangle.WhereClause("\"", "\"", 2, oinkMemberPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.Oink, rel.User}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.Oink = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &oinkR{
+			OinkMembers: related,
+		}
+	} else {
+		o.R.OinkMembers = append(o.R.OinkMembers, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &oinkMemberR{
+				OinkMemberOink: o,
+			}
+		} else {
+			rel.R.OinkMemberOink = o
+		}
+	}
+	return nil
+}
+
+// AddTags adds the given related objects to the existing relationships
+// of the oink, optionally inserting them as new records.
+// Appends related to o.R.Tags.
+// Sets related.R.Oinks appropriately.
+func (o *Oink) AddTags(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Tag) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		}
+	}
+
+	for _, rel := range related {
+		query := "insert into \"oink_tags\" (\"oink\", \"tag\") values ($1, $2)"
+		values := []interface{}{o.ID, rel.Name}
+
+		if boil.IsDebug(ctx) {
+			writer := boil.DebugWriterFrom(ctx)
+			fmt.Fprintln(writer, query)
+			fmt.Fprintln(writer, values)
+		}
+		_, err = exec.ExecContext(ctx, query, values...)
+		if err != nil {
+			return errors.Wrap(err, "failed to insert into join table")
+		}
+	}
+	if o.R == nil {
+		o.R = &oinkR{
+			Tags: related,
+		}
+	} else {
+		o.R.Tags = append(o.R.Tags, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &tagR{
+				Oinks: OinkSlice{o},
+			}
+		} else {
+			rel.R.Oinks = append(rel.R.Oinks, o)
+		}
+	}
+	return nil
+}
+
+// SetTags removes all previously related items of the
+// oink replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Oinks's Tags accordingly.
+// Replaces o.R.Tags with related.
+// Sets related.R.Oinks's Tags accordingly.
+func (o *Oink) SetTags(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Tag) error {
+	query := "delete from \"oink_tags\" where \"oink\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	removeTagsFromOinksSlice(o, related)
+	if o.R != nil {
+		o.R.Tags = nil
+	}
+
+	return o.AddTags(ctx, exec, insert, related...)
+}
+
+// RemoveTags relationships from objects passed in.
+// Removes related items from R.Tags (uses pointer comparison, removal does not keep order)
+// Sets related.R.Oinks.
+func (o *Oink) RemoveTags(ctx context.Context, exec boil.ContextExecutor, related ...*Tag) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	query := fmt.Sprintf(
+		"delete from \"oink_tags\" where \"oink\" = $1 and \"tag\" in (%s)",
+		strmangle.Placeholders(dialect.UseIndexPlaceholders, len(related), 2, 1),
+	)
+	values := []interface{}{o.ID}
+	for _, rel := range related {
+		values = append(values, rel.Name)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err = exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+	removeTagsFromOinksSlice(o, related)
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.Tags {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.Tags)
+			if ln > 1 && i < ln-1 {
+				o.R.Tags[i] = o.R.Tags[ln-1]
+			}
+			o.R.Tags = o.R.Tags[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+func removeTagsFromOinksSlice(o *Oink, related []*Tag) {
+	for _, rel := range related {
+		if rel.R == nil {
+			continue
+		}
+		for i, ri := range rel.R.Oinks {
+			if o.ID != ri.ID {
+				continue
+			}
+
+			ln := len(rel.R.Oinks)
+			if ln > 1 && i < ln-1 {
+				rel.R.Oinks[i] = rel.R.Oinks[ln-1]
+			}
+			rel.R.Oinks = rel.R.Oinks[:ln-1]
+			break
+		}
+	}
+}
+
+// AddPosts adds the given related objects to the existing relationships
+// of the oink, optionally inserting them as new records.
+// Appends related to o.R.Posts.
+// Sets related.R.PostOink appropriately.
+func (o *Oink) AddPosts(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Post) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.Oink = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"posts\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"oink"}),
+				strmangle.WhereClause("\"", "\"", 2, postPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.Oink = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &oinkR{
+			Posts: related,
+		}
+	} else {
+		o.R.Posts = append(o.R.Posts, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &postR{
+				PostOink: o,
+			}
+		} else {
+			rel.R.PostOink = o
+		}
+	}
+	return nil
+}
+
 // Oinks retrieves all the records using an executor.
 func Oinks(mods ...qm.QueryMod) oinkQuery {
 	mods = append(mods, qm.From("\"oinks\""))
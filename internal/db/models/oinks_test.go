@@ -494,6 +494,1113 @@ func testOinksInsertWhitelist(t *testing.T) {
 	}
 }
 
+func testOinkOneToOneOinkTransferUsingOinkTransfer(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var foreign OinkTransfer
+	var local Oink
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &foreign, oinkTransferDBTypes, true, oinkTransferColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize OinkTransfer struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &local, oinkDBTypes, true, oinkColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Oink struct: %s", err)
+	}
+
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreign.Oink = local.ID
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.OinkTransfer().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.Oink != foreign.Oink {
+		t.Errorf("want: %v, got %v", foreign.Oink, check.Oink)
+	}
+
+	ranAfterSelectHook := false
+	AddOinkTransferHook(boil.AfterSelectHook, func(ctx context.Context, e boil.ContextExecutor, o *OinkTransfer) error {
+		ranAfterSelectHook = true
+		return nil
+	})
+
+	slice := OinkSlice{&local}
+	if err = local.L.LoadOinkTransfer(ctx, tx, false, (*[]*Oink)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.OinkTransfer == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.OinkTransfer = nil
+	if err = local.L.LoadOinkTransfer(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.OinkTransfer == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	if !ranAfterSelectHook {
+		t.Error("failed to run AfterSelect hook for relationship")
+	}
+}
+
+func testOinkOneToOneSetOpOinkTransferUsingOinkTransfer(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Oink
+	var b, c OinkTransfer
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, oinkDBTypes, false, strmangle.SetComplement(oinkPrimaryKeyColumns, oinkColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, oinkTransferDBTypes, false, strmangle.SetComplement(oinkTransferPrimaryKeyColumns, oinkTransferColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, oinkTransferDBTypes, false, strmangle.SetComplement(oinkTransferPrimaryKeyColumns, oinkTransferColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*OinkTransfer{&b, &c} {
+		err = a.SetOinkTransfer(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.OinkTransfer != x {
+			t.Error("relationship struct not set to correct value")
+		}
+		if x.R.OinkTransferOink != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+
+		if a.ID != x.Oink {
+			t.Error("foreign key was wrong value", a.ID)
+		}
+
+		if exists, err := OinkTransferExists(ctx, tx, x.Oink); err != nil {
+			t.Fatal(err)
+		} else if !exists {
+			t.Error("want 'x' to exist")
+		}
+
+		if a.ID != x.Oink {
+			t.Error("foreign key was wrong value", a.ID, x.Oink)
+		}
+
+		if _, err = x.Delete(ctx, tx); err != nil {
+			t.Fatal("failed to delete x", err)
+		}
+	}
+}
+
+func testOinkToManyOinkAliases(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Oink
+	var b, c OinkAlias
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, oinkDBTypes, true, oinkColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Oink struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, oinkAliasDBTypes, false, oinkAliasColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, oinkAliasDBTypes, false, oinkAliasColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.Oink = a.ID
+	c.Oink = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.OinkAliases().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.Oink == b.Oink {
+			bFound = true
+		}
+		if v.Oink == c.Oink {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := OinkSlice{&a}
+	if err = a.L.LoadOinkAliases(ctx, tx, false, (*[]*Oink)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.OinkAliases); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.OinkAliases = nil
+	if err = a.L.LoadOinkAliases(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.OinkAliases); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testOinkToManyOinkInvitations(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Oink
+	var b, c OinkInvitation
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, oinkDBTypes, true, oinkColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Oink struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, oinkInvitationDBTypes, false, oinkInvitationColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, oinkInvitationDBTypes, false, oinkInvitationColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.Oink = a.ID
+	c.Oink = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.OinkInvitations().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.Oink == b.Oink {
+			bFound = true
+		}
+		if v.Oink == c.Oink {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := OinkSlice{&a}
+	if err = a.L.LoadOinkInvitations(ctx, tx, false, (*[]*Oink)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.OinkInvitations); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.OinkInvitations = nil
+	if err = a.L.LoadOinkInvitations(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.OinkInvitations); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testOinkToManyOinkMembers(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Oink
+	var b, c OinkMember
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, oinkDBTypes, true, oinkColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Oink struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, oinkMemberDBTypes, false, oinkMemberColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, oinkMemberDBTypes, false, oinkMemberColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.Oink = a.ID
+	c.Oink = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.OinkMembers().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.Oink == b.Oink {
+			bFound = true
+		}
+		if v.Oink == c.Oink {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := OinkSlice{&a}
+	if err = a.L.LoadOinkMembers(ctx, tx, false, (*[]*Oink)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.OinkMembers); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.OinkMembers = nil
+	if err = a.L.LoadOinkMembers(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.OinkMembers); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testOinkToManyTags(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Oink
+	var b, c Tag
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, oinkDBTypes, true, oinkColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Oink struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, tagDBTypes, false, tagColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, tagDBTypes, false, tagColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = tx.Exec("insert into \"oink_tags\" (\"oink\", \"tag\") values ($1, $2)", a.ID, b.Name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = tx.Exec("insert into \"oink_tags\" (\"oink\", \"tag\") values ($1, $2)", a.ID, c.Name)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.Tags().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.Name == b.Name {
+			bFound = true
+		}
+		if v.Name == c.Name {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := OinkSlice{&a}
+	if err = a.L.LoadTags(ctx, tx, false, (*[]*Oink)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.Tags); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.Tags = nil
+	if err = a.L.LoadTags(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.Tags); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testOinkToManyPosts(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Oink
+	var b, c Post
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, oinkDBTypes, true, oinkColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Oink struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, postDBTypes, false, postColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, postDBTypes, false, postColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.Oink = a.ID
+	c.Oink = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.Posts().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.Oink == b.Oink {
+			bFound = true
+		}
+		if v.Oink == c.Oink {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := OinkSlice{&a}
+	if err = a.L.LoadPosts(ctx, tx, false, (*[]*Oink)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.Posts); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.Posts = nil
+	if err = a.L.LoadPosts(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.Posts); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testOinkToManyAddOpOinkAliases(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Oink
+	var b, c, d, e OinkAlias
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, oinkDBTypes, false, strmangle.SetComplement(oinkPrimaryKeyColumns, oinkColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*OinkAlias{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, oinkAliasDBTypes, false, strmangle.SetComplement(oinkAliasPrimaryKeyColumns, oinkAliasColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*OinkAlias{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddOinkAliases(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.Oink {
+			t.Error("foreign key was wrong value", a.ID, first.Oink)
+		}
+		if a.ID != second.Oink {
+			t.Error("foreign key was wrong value", a.ID, second.Oink)
+		}
+
+		if first.R.OinkAliasOink != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.OinkAliasOink != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.OinkAliases[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.OinkAliases[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.OinkAliases().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+func testOinkToManyAddOpOinkInvitations(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Oink
+	var b, c, d, e OinkInvitation
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, oinkDBTypes, false, strmangle.SetComplement(oinkPrimaryKeyColumns, oinkColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*OinkInvitation{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, oinkInvitationDBTypes, false, strmangle.SetComplement(oinkInvitationPrimaryKeyColumns, oinkInvitationColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*OinkInvitation{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddOinkInvitations(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.Oink {
+			t.Error("foreign key was wrong value", a.ID, first.Oink)
+		}
+		if a.ID != second.Oink {
+			t.Error("foreign key was wrong value", a.ID, second.Oink)
+		}
+
+		if first.R.OinkInvitationOink != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.OinkInvitationOink != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.OinkInvitations[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.OinkInvitations[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.OinkInvitations().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+func testOinkToManyAddOpOinkMembers(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Oink
+	var b, c, d, e OinkMember
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, oinkDBTypes, false, strmangle.SetComplement(oinkPrimaryKeyColumns, oinkColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*OinkMember{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, oinkMemberDBTypes, false, strmangle.SetComplement(oinkMemberPrimaryKeyColumns, oinkMemberColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*OinkMember{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddOinkMembers(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.Oink {
+			t.Error("foreign key was wrong value", a.ID, first.Oink)
+		}
+		if a.ID != second.Oink {
+			t.Error("foreign key was wrong value", a.ID, second.Oink)
+		}
+
+		if first.R.OinkMemberOink != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.OinkMemberOink != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.OinkMembers[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.OinkMembers[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.OinkMembers().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+func testOinkToManyAddOpTags(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Oink
+	var b, c, d, e Tag
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, oinkDBTypes, false, strmangle.SetComplement(oinkPrimaryKeyColumns, oinkColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Tag{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, tagDBTypes, false, strmangle.SetComplement(tagPrimaryKeyColumns, tagColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*Tag{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddTags(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if first.R.Oinks[0] != &a {
+			t.Error("relationship was not added properly to the slice")
+		}
+		if second.R.Oinks[0] != &a {
+			t.Error("relationship was not added properly to the slice")
+		}
+
+		if a.R.Tags[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.Tags[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.Tags().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+
+func testOinkToManySetOpTags(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Oink
+	var b, c, d, e Tag
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, oinkDBTypes, false, strmangle.SetComplement(oinkPrimaryKeyColumns, oinkColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Tag{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, tagDBTypes, false, strmangle.SetComplement(tagPrimaryKeyColumns, tagColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.SetTags(ctx, tx, false, &b, &c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.Tags().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.SetTags(ctx, tx, true, &d, &e)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.Tags().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	// The following checks cannot be implemented since we have no handle
+	// to these when we call Set(). Leaving them here as wishful thinking
+	// and to let people know there's dragons.
+	//
+	// if len(b.R.Oinks) != 0 {
+	// 	t.Error("relationship was not removed properly from the slice")
+	// }
+	// if len(c.R.Oinks) != 0 {
+	// 	t.Error("relationship was not removed properly from the slice")
+	// }
+	if d.R.Oinks[0] != &a {
+		t.Error("relationship was not added properly to the slice")
+	}
+	if e.R.Oinks[0] != &a {
+		t.Error("relationship was not added properly to the slice")
+	}
+
+	if a.R.Tags[0] != &d {
+		t.Error("relationship struct slice not set to correct value")
+	}
+	if a.R.Tags[1] != &e {
+		t.Error("relationship struct slice not set to correct value")
+	}
+}
+
+func testOinkToManyRemoveOpTags(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Oink
+	var b, c, d, e Tag
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, oinkDBTypes, false, strmangle.SetComplement(oinkPrimaryKeyColumns, oinkColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Tag{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, tagDBTypes, false, strmangle.SetComplement(tagPrimaryKeyColumns, tagColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.AddTags(ctx, tx, true, foreigners...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.Tags().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 4 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.RemoveTags(ctx, tx, foreigners[:2]...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.Tags().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if len(b.R.Oinks) != 0 {
+		t.Error("relationship was not removed properly from the slice")
+	}
+	if len(c.R.Oinks) != 0 {
+		t.Error("relationship was not removed properly from the slice")
+	}
+	if d.R.Oinks[0] != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+	if e.R.Oinks[0] != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+
+	if len(a.R.Tags) != 2 {
+		t.Error("should have preserved two relationships")
+	}
+
+	// Removal doesn't do a stable deletion for performance so we have to flip the order
+	if a.R.Tags[1] != &d {
+		t.Error("relationship to d should have been preserved")
+	}
+	if a.R.Tags[0] != &e {
+		t.Error("relationship to e should have been preserved")
+	}
+}
+
+func testOinkToManyAddOpPosts(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Oink
+	var b, c, d, e Post
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, oinkDBTypes, false, strmangle.SetComplement(oinkPrimaryKeyColumns, oinkColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Post{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, postDBTypes, false, strmangle.SetComplement(postPrimaryKeyColumns, postColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*Post{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddPosts(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.Oink {
+			t.Error("foreign key was wrong value", a.ID, first.Oink)
+		}
+		if a.ID != second.Oink {
+			t.Error("foreign key was wrong value", a.ID, second.Oink)
+		}
+
+		if first.R.PostOink != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.PostOink != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.Posts[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.Posts[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.Posts().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+func testOinkToOneUserUsingArchivedByUser(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local Oink
+	var foreign User
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, oinkDBTypes, true, oinkColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Oink struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, userDBTypes, false, userColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize User struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&local.ArchivedBy, foreign.ID)
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.ArchivedByUser().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !queries.Equal(check.ID, foreign.ID) {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	ranAfterSelectHook := false
+	AddUserHook(boil.AfterSelectHook, func(ctx context.Context, e boil.ContextExecutor, o *User) error {
+		ranAfterSelectHook = true
+		return nil
+	})
+
+	slice := OinkSlice{&local}
+	if err = local.L.LoadArchivedByUser(ctx, tx, false, (*[]*Oink)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.ArchivedByUser == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.ArchivedByUser = nil
+	if err = local.L.LoadArchivedByUser(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.ArchivedByUser == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	if !ranAfterSelectHook {
+		t.Error("failed to run AfterSelect hook for relationship")
+	}
+}
+
 func testOinkToOneUserUsingCreatorUser(t *testing.T) {
 	ctx := context.Background()
 	tx := MustTx(boil.BeginTx(ctx, nil))
@@ -555,6 +1662,115 @@ func testOinkToOneUserUsingCreatorUser(t *testing.T) {
 	}
 }
 
+func testOinkToOneSetOpUserUsingArchivedByUser(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Oink
+	var b, c User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, oinkDBTypes, false, strmangle.SetComplement(oinkPrimaryKeyColumns, oinkColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*User{&b, &c} {
+		err = a.SetArchivedByUser(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.ArchivedByUser != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.ArchivedByOinks[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if !queries.Equal(a.ArchivedBy, x.ID) {
+			t.Error("foreign key was wrong value", a.ArchivedBy)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.ArchivedBy))
+		reflect.Indirect(reflect.ValueOf(&a.ArchivedBy)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if !queries.Equal(a.ArchivedBy, x.ID) {
+			t.Error("foreign key was wrong value", a.ArchivedBy, x.ID)
+		}
+	}
+}
+
+func testOinkToOneRemoveOpUserUsingArchivedByUser(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Oink
+	var b User
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, oinkDBTypes, false, strmangle.SetComplement(oinkPrimaryKeyColumns, oinkColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, userDBTypes, false, strmangle.SetComplement(userPrimaryKeyColumns, userColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.SetArchivedByUser(ctx, tx, true, &b); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = a.RemoveArchivedByUser(ctx, tx, &b); err != nil {
+		t.Error("failed to remove relationship")
+	}
+
+	count, err := a.ArchivedByUser().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 0 {
+		t.Error("want no relationships remaining")
+	}
+
+	if a.R.ArchivedByUser != nil {
+		t.Error("R struct entry should be nil")
+	}
+
+	if !queries.IsValuerNil(a.ArchivedBy) {
+		t.Error("foreign key value should be nil")
+	}
+
+	if len(b.R.ArchivedByOinks) != 0 {
+		t.Error("failed to remove a from b's relationships")
+	}
+}
+
 func testOinkToOneSetOpUserUsingCreatorUser(t *testing.T) {
 	var err error
 
@@ -687,7 +1903,7 @@ func testOinksSelect(t *testing.T) {
 }
 
 var (
-	oinkDBTypes = map[string]string{`Name`: `character varying`, `ID`: `uuid`, `Description`: `character varying`, `Creator`: `uuid`, `CreatedAt`: `timestamp with time zone`, `UpdatedAt`: `timestamp with time zone`}
+	oinkDBTypes = map[string]string{`Name`: `character varying`, `ID`: `uuid`, `Description`: `character varying`, `Creator`: `uuid`, `CreatedAt`: `timestamp with time zone`, `UpdatedAt`: `timestamp with time zone`, `Visibility`: `character varying`, `Language`: `character varying`, `SearchVector`: `tsvector`, `ArchivedAt`: `timestamp with time zone`, `ArchivedBy`: `uuid`, `AvatarKey`: `character varying`, `BannerKey`: `character varying`}
 	_           = bytes.MinRead
 )
 
@@ -6,10 +6,24 @@ package dbmodels
 import "testing"
 
 func TestUpsert(t *testing.T) {
+	t.Run("IdempotencyKeys", testIdempotencyKeysUpsert)
+
+	t.Run("OinkAliases", testOinkAliasesUpsert)
+
+	t.Run("OinkInvitations", testOinkInvitationsUpsert)
+
+	t.Run("OinkMembers", testOinkMembersUpsert)
+
+	t.Run("OinkTransfers", testOinkTransfersUpsert)
+
 	t.Run("Oinks", testOinksUpsert)
 
+	t.Run("Posts", testPostsUpsert)
+
 	t.Run("SchemaMigrations", testSchemaMigrationsUpsert)
 
+	t.Run("Tags", testTagsUpsert)
+
 	t.Run("Tokens", testTokensUpsert)
 
 	t.Run("Users", testUsersUpsert)
@@ -29,6 +29,8 @@ type User struct {
 	Username  string    `boil:"username" json:"username" toml:"username" yaml:"username"`
 	CreatedAt time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
 	UpdatedAt time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	IsAdmin   bool      `boil:"is_admin" json:"is_admin" toml:"is_admin" yaml:"is_admin"`
+	AvatarKey string    `boil:"avatar_key" json:"avatar_key" toml:"avatar_key" yaml:"avatar_key"`
 
 	R *userR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L userL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -41,6 +43,8 @@ var UserColumns = struct {
 	Username  string
 	CreatedAt string
 	UpdatedAt string
+	IsAdmin   string
+	AvatarKey string
 }{
 	Email:     "email",
 	ID:        "id",
@@ -48,6 +52,8 @@ var UserColumns = struct {
 	Username:  "username",
 	CreatedAt: "created_at",
 	UpdatedAt: "updated_at",
+	IsAdmin:   "is_admin",
+	AvatarKey: "avatar_key",
 }
 
 var UserTableColumns = struct {
@@ -57,6 +63,8 @@ var UserTableColumns = struct {
 	Username  string
 	CreatedAt string
 	UpdatedAt string
+	IsAdmin   string
+	AvatarKey string
 }{
 	Email:     "users.email",
 	ID:        "users.id",
@@ -64,6 +72,8 @@ var UserTableColumns = struct {
 	Username:  "users.username",
 	CreatedAt: "users.created_at",
 	UpdatedAt: "users.updated_at",
+	IsAdmin:   "users.is_admin",
+	AvatarKey: "users.avatar_key",
 }
 
 // Generated where
@@ -75,6 +85,8 @@ var UserWhere = struct {
 	Username  whereHelperstring
 	CreatedAt whereHelpertime_Time
 	UpdatedAt whereHelpertime_Time
+	IsAdmin   whereHelperbool
+	AvatarKey whereHelperstring
 }{
 	Email:     whereHelperstring{field: "\"users\".\"email\""},
 	ID:        whereHelperstring{field: "\"users\".\"id\""},
@@ -82,21 +94,47 @@ var UserWhere = struct {
 	Username:  whereHelperstring{field: "\"users\".\"username\""},
 	CreatedAt: whereHelpertime_Time{field: "\"users\".\"created_at\""},
 	UpdatedAt: whereHelpertime_Time{field: "\"users\".\"updated_at\""},
+	IsAdmin:   whereHelperbool{field: "\"users\".\"is_admin\""},
+	AvatarKey: whereHelperstring{field: "\"users\".\"avatar_key\""},
 }
 
 // UserRels is where relationship names are stored.
 var UserRels = struct {
-	CreatorOinks string
-	Tokens       string
+	IdempotencyKeys          string
+	CreatedByOinkInvitations string
+	OinkInvitations          string
+	OinkMembers              string
+	FromUserOinkTransfers    string
+	ToUserOinkTransfers      string
+	ArchivedByOinks          string
+	CreatorOinks             string
+	AuthorPosts              string
+	Tokens                   string
 }{
-	CreatorOinks: "CreatorOinks",
-	Tokens:       "Tokens",
+	IdempotencyKeys:          "IdempotencyKeys",
+	CreatedByOinkInvitations: "CreatedByOinkInvitations",
+	OinkInvitations:          "OinkInvitations",
+	OinkMembers:              "OinkMembers",
+	FromUserOinkTransfers:    "FromUserOinkTransfers",
+	ToUserOinkTransfers:      "ToUserOinkTransfers",
+	ArchivedByOinks:          "ArchivedByOinks",
+	CreatorOinks:             "CreatorOinks",
+	AuthorPosts:              "AuthorPosts",
+	Tokens:                   "Tokens",
 }
 
 // userR is where relationships are stored.
 type userR struct {
-	CreatorOinks OinkSlice  `boil:"CreatorOinks" json:"CreatorOinks" toml:"CreatorOinks" yaml:"CreatorOinks"`
-	Tokens       TokenSlice `boil:"Tokens" json:"Tokens" toml:"Tokens" yaml:"Tokens"`
+	IdempotencyKeys          IdempotencyKeySlice `boil:"IdempotencyKeys" json:"IdempotencyKeys" toml:"IdempotencyKeys" yaml:"IdempotencyKeys"`
+	CreatedByOinkInvitations OinkInvitationSlice `boil:"CreatedByOinkInvitations" json:"CreatedByOinkInvitations" toml:"CreatedByOinkInvitations" yaml:"CreatedByOinkInvitations"`
+	OinkInvitations          OinkInvitationSlice `boil:"OinkInvitations" json:"OinkInvitations" toml:"OinkInvitations" yaml:"OinkInvitations"`
+	OinkMembers              OinkMemberSlice     `boil:"OinkMembers" json:"OinkMembers" toml:"OinkMembers" yaml:"OinkMembers"`
+	FromUserOinkTransfers    OinkTransferSlice   `boil:"FromUserOinkTransfers" json:"FromUserOinkTransfers" toml:"FromUserOinkTransfers" yaml:"FromUserOinkTransfers"`
+	ToUserOinkTransfers      OinkTransferSlice   `boil:"ToUserOinkTransfers" json:"ToUserOinkTransfers" toml:"ToUserOinkTransfers" yaml:"ToUserOinkTransfers"`
+	ArchivedByOinks          OinkSlice           `boil:"ArchivedByOinks" json:"ArchivedByOinks" toml:"ArchivedByOinks" yaml:"ArchivedByOinks"`
+	CreatorOinks             OinkSlice           `boil:"CreatorOinks" json:"CreatorOinks" toml:"CreatorOinks" yaml:"CreatorOinks"`
+	AuthorPosts              PostSlice           `boil:"AuthorPosts" json:"AuthorPosts" toml:"AuthorPosts" yaml:"AuthorPosts"`
+	Tokens                   TokenSlice          `boil:"Tokens" json:"Tokens" toml:"Tokens" yaml:"Tokens"`
 }
 
 // NewStruct creates a new relationship struct
@@ -104,6 +142,55 @@ func (*userR) NewStruct() *userR {
 	return &userR{}
 }
 
+func (r *userR) GetIdempotencyKeys() IdempotencyKeySlice {
+	if r == nil {
+		return nil
+	}
+	return r.IdempotencyKeys
+}
+
+func (r *userR) GetCreatedByOinkInvitations() OinkInvitationSlice {
+	if r == nil {
+		return nil
+	}
+	return r.CreatedByOinkInvitations
+}
+
+func (r *userR) GetOinkInvitations() OinkInvitationSlice {
+	if r == nil {
+		return nil
+	}
+	return r.OinkInvitations
+}
+
+func (r *userR) GetOinkMembers() OinkMemberSlice {
+	if r == nil {
+		return nil
+	}
+	return r.OinkMembers
+}
+
+func (r *userR) GetFromUserOinkTransfers() OinkTransferSlice {
+	if r == nil {
+		return nil
+	}
+	return r.FromUserOinkTransfers
+}
+
+func (r *userR) GetToUserOinkTransfers() OinkTransferSlice {
+	if r == nil {
+		return nil
+	}
+	return r.ToUserOinkTransfers
+}
+
+func (r *userR) GetArchivedByOinks() OinkSlice {
+	if r == nil {
+		return nil
+	}
+	return r.ArchivedByOinks
+}
+
 func (r *userR) GetCreatorOinks() OinkSlice {
 	if r == nil {
 		return nil
@@ -111,6 +198,13 @@ func (r *userR) GetCreatorOinks() OinkSlice {
 	return r.CreatorOinks
 }
 
+func (r *userR) GetAuthorPosts() PostSlice {
+	if r == nil {
+		return nil
+	}
+	return r.AuthorPosts
+}
+
 func (r *userR) GetTokens() TokenSlice {
 	if r == nil {
 		return nil
@@ -122,9 +216,9 @@ func (r *userR) GetTokens() TokenSlice {
 type userL struct{}
 
 var (
-	userAllColumns            = []string{"email", "id", "password", "username", "created_at", "updated_at"}
+	userAllColumns            = []string{"email", "id", "password", "username", "created_at", "updated_at", "is_admin", "avatar_key"}
 	userColumnsWithoutDefault = []string{"email", "id", "password", "username", "created_at", "updated_at"}
-	userColumnsWithDefault    = []string{}
+	userColumnsWithDefault    = []string{"is_admin", "avatar_key"}
 	userPrimaryKeyColumns     = []string{"id"}
 	userGeneratedColumns      = []string{}
 )
@@ -407,6 +501,104 @@ func (q userQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool,
 	return count > 0, nil
 }
 
+// IdempotencyKeys retrieves all the idempotency_key's IdempotencyKeys with an executor.
+func (o *User) IdempotencyKeys(mods ...qm.QueryMod) idempotencyKeyQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"idempotency_keys\".\"user\"=?", o.ID),
+	)
+
+	return IdempotencyKeys(queryMods...)
+}
+
+// CreatedByOinkInvitations retrieves all the oink_invitation's OinkInvitations with an executor via created_by column.
+func (o *User) CreatedByOinkInvitations(mods ...qm.QueryMod) oinkInvitationQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"oink_invitations\".\"created_by\"=?", o.ID),
+	)
+
+	return OinkInvitations(queryMods...)
+}
+
+// OinkInvitations retrieves all the oink_invitation's OinkInvitations with an executor.
+func (o *User) OinkInvitations(mods ...qm.QueryMod) oinkInvitationQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"oink_invitations\".\"user\"=?", o.ID),
+	)
+
+	return OinkInvitations(queryMods...)
+}
+
+// OinkMembers retrieves all the oink_member's OinkMembers with an executor.
+func (o *User) OinkMembers(mods ...qm.QueryMod) oinkMemberQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"oink_members\".\"user\"=?", o.ID),
+	)
+
+	return OinkMembers(queryMods...)
+}
+
+// FromUserOinkTransfers retrieves all the oink_transfer's OinkTransfers with an executor via from_user column.
+func (o *User) FromUserOinkTransfers(mods ...qm.QueryMod) oinkTransferQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"oink_transfers\".\"from_user\"=?", o.ID),
+	)
+
+	return OinkTransfers(queryMods...)
+}
+
+// ToUserOinkTransfers retrieves all the oink_transfer's OinkTransfers with an executor via to_user column.
+func (o *User) ToUserOinkTransfers(mods ...qm.QueryMod) oinkTransferQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"oink_transfers\".\"to_user\"=?", o.ID),
+	)
+
+	return OinkTransfers(queryMods...)
+}
+
+// ArchivedByOinks retrieves all the oink's Oinks with an executor via archived_by column.
+func (o *User) ArchivedByOinks(mods ...qm.QueryMod) oinkQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"oinks\".\"archived_by\"=?", o.ID),
+	)
+
+	return Oinks(queryMods...)
+}
+
 // CreatorOinks retrieves all the oink's Oinks with an executor via creator column.
 func (o *User) CreatorOinks(mods ...qm.QueryMod) oinkQuery {
 	var queryMods []qm.QueryMod
@@ -421,6 +613,20 @@ func (o *User) CreatorOinks(mods ...qm.QueryMod) oinkQuery {
 	return Oinks(queryMods...)
 }
 
+// AuthorPosts retrieves all the post's Posts with an executor via author column.
+func (o *User) AuthorPosts(mods ...qm.QueryMod) postQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"posts\".\"author\"=?", o.ID),
+	)
+
+	return Posts(queryMods...)
+}
+
 // Tokens retrieves all the token's Tokens with an executor.
 func (o *User) Tokens(mods ...qm.QueryMod) tokenQuery {
 	var queryMods []qm.QueryMod
@@ -435,9 +641,9 @@ func (o *User) Tokens(mods ...qm.QueryMod) tokenQuery {
 	return Tokens(queryMods...)
 }
 
-// LoadCreatorOinks allows an eager lookup of values, cached into the
+// LoadIdempotencyKeys allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadCreatorOinks(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+func (userL) LoadIdempotencyKeys(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -491,8 +697,8 @@ func (userL) LoadCreatorOinks(ctx context.Context, e boil.ContextExecutor, singu
 	}
 
 	query := NewQuery(
-		qm.From(`oinks`),
-		qm.WhereIn(`oinks.creator in ?`, args...),
+		qm.From(`idempotency_keys`),
+		qm.WhereIn(`idempotency_keys.user in ?`, args...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -500,22 +706,22 @@ func (userL) LoadCreatorOinks(ctx context.Context, e boil.ContextExecutor, singu
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load oinks")
+		return errors.Wrap(err, "failed to eager load idempotency_keys")
 	}
 
-	var resultSlice []*Oink
+	var resultSlice []*IdempotencyKey
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice oinks")
+		return errors.Wrap(err, "failed to bind eager loaded slice idempotency_keys")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on oinks")
+		return errors.Wrap(err, "failed to close results in eager load on idempotency_keys")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for oinks")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for idempotency_keys")
 	}
 
-	if len(oinkAfterSelectHooks) != 0 {
+	if len(idempotencyKeyAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -523,24 +729,24 @@ func (userL) LoadCreatorOinks(ctx context.Context, e boil.ContextExecutor, singu
 		}
 	}
 	if singular {
-		object.R.CreatorOinks = resultSlice
+		object.R.IdempotencyKeys = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &oinkR{}
+				foreign.R = &idempotencyKeyR{}
 			}
-			foreign.R.CreatorUser = object
+			foreign.R.IdempotencyKeyUser = object
 		}
 		return nil
 	}
 
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if local.ID == foreign.Creator {
-				local.R.CreatorOinks = append(local.R.CreatorOinks, foreign)
+			if local.ID == foreign.User {
+				local.R.IdempotencyKeys = append(local.R.IdempotencyKeys, foreign)
 				if foreign.R == nil {
-					foreign.R = &oinkR{}
+					foreign.R = &idempotencyKeyR{}
 				}
-				foreign.R.CreatorUser = local
+				foreign.R.IdempotencyKeyUser = local
 				break
 			}
 		}
@@ -549,9 +755,9 @@ func (userL) LoadCreatorOinks(ctx context.Context, e boil.ContextExecutor, singu
 	return nil
 }
 
-// LoadTokens allows an eager lookup of values, cached into the
+// LoadCreatedByOinkInvitations allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadTokens(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+func (userL) LoadCreatedByOinkInvitations(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -605,8 +811,8 @@ func (userL) LoadTokens(ctx context.Context, e boil.ContextExecutor, singular bo
 	}
 
 	query := NewQuery(
-		qm.From(`tokens`),
-		qm.WhereIn(`tokens.user in ?`, args...),
+		qm.From(`oink_invitations`),
+		qm.WhereIn(`oink_invitations.created_by in ?`, args...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -614,22 +820,22 @@ func (userL) LoadTokens(ctx context.Context, e boil.ContextExecutor, singular bo
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load tokens")
+		return errors.Wrap(err, "failed to eager load oink_invitations")
 	}
 
-	var resultSlice []*Token
+	var resultSlice []*OinkInvitation
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice tokens")
+		return errors.Wrap(err, "failed to bind eager loaded slice oink_invitations")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on tokens")
+		return errors.Wrap(err, "failed to close results in eager load on oink_invitations")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for tokens")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for oink_invitations")
 	}
 
-	if len(tokenAfterSelectHooks) != 0 {
+	if len(oinkInvitationAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -637,24 +843,24 @@ func (userL) LoadTokens(ctx context.Context, e boil.ContextExecutor, singular bo
 		}
 	}
 	if singular {
-		object.R.Tokens = resultSlice
+		object.R.CreatedByOinkInvitations = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &tokenR{}
+				foreign.R = &oinkInvitationR{}
 			}
-			foreign.R.TokenUser = object
+			foreign.R.CreatedByUser = object
 		}
 		return nil
 	}
 
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if local.ID == foreign.User {
-				local.R.Tokens = append(local.R.Tokens, foreign)
+			if local.ID == foreign.CreatedBy {
+				local.R.CreatedByOinkInvitations = append(local.R.CreatedByOinkInvitations, foreign)
 				if foreign.R == nil {
-					foreign.R = &tokenR{}
+					foreign.R = &oinkInvitationR{}
 				}
-				foreign.R.TokenUser = local
+				foreign.R.CreatedByUser = local
 				break
 			}
 		}
@@ -663,54 +869,1464 @@ func (userL) LoadTokens(ctx context.Context, e boil.ContextExecutor, singular bo
 	return nil
 }
 
-// AddCreatorOinks adds the given related objects to the existing relationships
-// of the user, optionally inserting them as new records.
-// Appends related to o.R.CreatorOinks.
-// Sets related.R.CreatorUser appropriately.
-func (o *User) AddCreatorOinks(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Oink) error {
-	var err error
-	for _, rel := range related {
-		if insert {
-			rel.Creator = o.ID
-			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
-				return errors.Wrap(err, "failed to insert into foreign table")
+// LoadOinkInvitations allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadOinkInvitations(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
 			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
 		} else {
-			updateQuery := fmt.Sprintf(
-				"UPDATE \"oinks\" SET %s WHERE %s",
-				strmangle.SetParamNames("\"", "\"", 1, []string{"creator"}),
-				strmangle.WhereClause("\"", "\"", 2, oinkPrimaryKeyColumns),
-			)
-			values := []interface{}{o.ID, rel.ID}
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
 
-			if boil.IsDebug(ctx) {
-				writer := boil.DebugWriterFrom(ctx)
-				fmt.Fprintln(writer, updateQuery)
-				fmt.Fprintln(writer, values)
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
 			}
-			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
-				return errors.Wrap(err, "failed to update foreign table")
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
 			}
 
-			rel.Creator = o.ID
+			args = append(args, obj.ID)
 		}
 	}
 
-	if o.R == nil {
-		o.R = &userR{
-			CreatorOinks: related,
-		}
-	} else {
-		o.R.CreatorOinks = append(o.R.CreatorOinks, related...)
+	if len(args) == 0 {
+		return nil
 	}
 
-	for _, rel := range related {
-		if rel.R == nil {
-			rel.R = &oinkR{
-				CreatorUser: o,
+	query := NewQuery(
+		qm.From(`oink_invitations`),
+		qm.WhereIn(`oink_invitations.user in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load oink_invitations")
+	}
+
+	var resultSlice []*OinkInvitation
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice oink_invitations")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on oink_invitations")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for oink_invitations")
+	}
+
+	if len(oinkInvitationAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
 			}
-		} else {
-			rel.R.CreatorUser = o
+		}
+	}
+	if singular {
+		object.R.OinkInvitations = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &oinkInvitationR{}
+			}
+			foreign.R.OinkInvitationUser = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.User {
+				local.R.OinkInvitations = append(local.R.OinkInvitations, foreign)
+				if foreign.R == nil {
+					foreign.R = &oinkInvitationR{}
+				}
+				foreign.R.OinkInvitationUser = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadOinkMembers allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadOinkMembers(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`oink_members`),
+		qm.WhereIn(`oink_members.user in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load oink_members")
+	}
+
+	var resultSlice []*OinkMember
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice oink_members")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on oink_members")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for oink_members")
+	}
+
+	if len(oinkMemberAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.OinkMembers = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &oinkMemberR{}
+			}
+			foreign.R.OinkMemberUser = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.User {
+				local.R.OinkMembers = append(local.R.OinkMembers, foreign)
+				if foreign.R == nil {
+					foreign.R = &oinkMemberR{}
+				}
+				foreign.R.OinkMemberUser = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadFromUserOinkTransfers allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadFromUserOinkTransfers(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`oink_transfers`),
+		qm.WhereIn(`oink_transfers.from_user in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load oink_transfers")
+	}
+
+	var resultSlice []*OinkTransfer
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice oink_transfers")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on oink_transfers")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for oink_transfers")
+	}
+
+	if len(oinkTransferAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.FromUserOinkTransfers = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &oinkTransferR{}
+			}
+			foreign.R.FromUserUser = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.FromUser {
+				local.R.FromUserOinkTransfers = append(local.R.FromUserOinkTransfers, foreign)
+				if foreign.R == nil {
+					foreign.R = &oinkTransferR{}
+				}
+				foreign.R.FromUserUser = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadToUserOinkTransfers allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadToUserOinkTransfers(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`oink_transfers`),
+		qm.WhereIn(`oink_transfers.to_user in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load oink_transfers")
+	}
+
+	var resultSlice []*OinkTransfer
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice oink_transfers")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on oink_transfers")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for oink_transfers")
+	}
+
+	if len(oinkTransferAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ToUserOinkTransfers = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &oinkTransferR{}
+			}
+			foreign.R.ToUserUser = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ToUser {
+				local.R.ToUserOinkTransfers = append(local.R.ToUserOinkTransfers, foreign)
+				if foreign.R == nil {
+					foreign.R = &oinkTransferR{}
+				}
+				foreign.R.ToUserUser = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadArchivedByOinks allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadArchivedByOinks(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`oinks`),
+		qm.WhereIn(`oinks.archived_by in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load oinks")
+	}
+
+	var resultSlice []*Oink
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice oinks")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on oinks")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for oinks")
+	}
+
+	if len(oinkAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ArchivedByOinks = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &oinkR{}
+			}
+			foreign.R.ArchivedByUser = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.ArchivedBy) {
+				local.R.ArchivedByOinks = append(local.R.ArchivedByOinks, foreign)
+				if foreign.R == nil {
+					foreign.R = &oinkR{}
+				}
+				foreign.R.ArchivedByUser = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadCreatorOinks allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadCreatorOinks(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`oinks`),
+		qm.WhereIn(`oinks.creator in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load oinks")
+	}
+
+	var resultSlice []*Oink
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice oinks")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on oinks")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for oinks")
+	}
+
+	if len(oinkAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.CreatorOinks = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &oinkR{}
+			}
+			foreign.R.CreatorUser = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.Creator {
+				local.R.CreatorOinks = append(local.R.CreatorOinks, foreign)
+				if foreign.R == nil {
+					foreign.R = &oinkR{}
+				}
+				foreign.R.CreatorUser = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadAuthorPosts allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadAuthorPosts(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`posts`),
+		qm.WhereIn(`posts.author in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load posts")
+	}
+
+	var resultSlice []*Post
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice posts")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on posts")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for posts")
+	}
+
+	if len(postAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.AuthorPosts = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &postR{}
+			}
+			foreign.R.AuthorUser = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.Author {
+				local.R.AuthorPosts = append(local.R.AuthorPosts, foreign)
+				if foreign.R == nil {
+					foreign.R = &postR{}
+				}
+				foreign.R.AuthorUser = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadTokens allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadTokens(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`tokens`),
+		qm.WhereIn(`tokens.user in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load tokens")
+	}
+
+	var resultSlice []*Token
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice tokens")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on tokens")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for tokens")
+	}
+
+	if len(tokenAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.Tokens = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &tokenR{}
+			}
+			foreign.R.TokenUser = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.User {
+				local.R.Tokens = append(local.R.Tokens, foreign)
+				if foreign.R == nil {
+					foreign.R = &tokenR{}
+				}
+				foreign.R.TokenUser = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// AddIdempotencyKeys adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.IdempotencyKeys.
+// Sets related.R.IdempotencyKeyUser appropriately.
+func (o *User) AddIdempotencyKeys(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*IdempotencyKey) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.User = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"idempotency_keys\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user"}),
+				strmangle.WhereClause("\"", "\"", 2, idempotencyKeyPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.User, rel.Key}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.User = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			IdempotencyKeys: related,
+		}
+	} else {
+		o.R.IdempotencyKeys = append(o.R.IdempotencyKeys, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &idempotencyKeyR{
+				IdempotencyKeyUser: o,
+			}
+		} else {
+			rel.R.IdempotencyKeyUser = o
+		}
+	}
+	return nil
+}
+
+// AddCreatedByOinkInvitations adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.CreatedByOinkInvitations.
+// Sets related.R.CreatedByUser appropriately.
+func (o *User) AddCreatedByOinkInvitations(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*OinkInvitation) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.CreatedBy = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"oink_invitations\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"created_by"}),
+				strmangle.WhereClause("\"", "\"", 2, oinkInvitationPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.Oink, rel.User}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.CreatedBy = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			CreatedByOinkInvitations: related,
+		}
+	} else {
+		o.R.CreatedByOinkInvitations = append(o.R.CreatedByOinkInvitations, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &oinkInvitationR{
+				CreatedByUser: o,
+			}
+		} else {
+			rel.R.CreatedByUser = o
+		}
+	}
+	return nil
+}
+
+// AddOinkInvitations adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.OinkInvitations.
+// Sets related.R.OinkInvitationUser appropriately.
+func (o *User) AddOinkInvitations(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*OinkInvitation) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.User = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"oink_invitations\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user"}),
+				strmangle.WhereClause("\"", "\"", 2, oinkInvitationPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.Oink, rel.User}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.User = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			OinkInvitations: related,
+		}
+	} else {
+		o.R.OinkInvitations = append(o.R.OinkInvitations, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &oinkInvitationR{
+				OinkInvitationUser: o,
+			}
+		} else {
+			rel.R.OinkInvitationUser = o
+		}
+	}
+	return nil
+}
+
+// AddOinkMembers adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.OinkMembers.
+// Sets related.R.OinkMemberUser appropriately.
+func (o *User) AddOinkMembers(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*OinkMember) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.User = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"oink_members\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user"}),
+				strmangle.WhereClause("\"", "\"", 2, oinkMemberPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.Oink, rel.User}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.User = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			OinkMembers: related,
+		}
+	} else {
+		o.R.OinkMembers = append(o.R.OinkMembers, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &oinkMemberR{
+				OinkMemberUser: o,
+			}
+		} else {
+			rel.R.OinkMemberUser = o
+		}
+	}
+	return nil
+}
+
+// AddFromUserOinkTransfers adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.FromUserOinkTransfers.
+// Sets related.R.FromUserUser appropriately.
+func (o *User) AddFromUserOinkTransfers(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*OinkTransfer) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.FromUser = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"oink_transfers\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"from_user"}),
+				strmangle.WhereClause("\"", "\"", 2, oinkTransferPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.Oink}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.FromUser = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			FromUserOinkTransfers: related,
+		}
+	} else {
+		o.R.FromUserOinkTransfers = append(o.R.FromUserOinkTransfers, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &oinkTransferR{
+				FromUserUser: o,
+			}
+		} else {
+			rel.R.FromUserUser = o
+		}
+	}
+	return nil
+}
+
+// AddToUserOinkTransfers adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.ToUserOinkTransfers.
+// Sets related.R.ToUserUser appropriately.
+func (o *User) AddToUserOinkTransfers(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*OinkTransfer) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ToUser = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"oink_transfers\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"to_user"}),
+				strmangle.WhereClause("\"", "\"", 2, oinkTransferPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.Oink}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ToUser = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			ToUserOinkTransfers: related,
+		}
+	} else {
+		o.R.ToUserOinkTransfers = append(o.R.ToUserOinkTransfers, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &oinkTransferR{
+				ToUserUser: o,
+			}
+		} else {
+			rel.R.ToUserUser = o
+		}
+	}
+	return nil
+}
+
+// AddArchivedByOinks adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.ArchivedByOinks.
+// Sets related.R.ArchivedByUser appropriately.
+func (o *User) AddArchivedByOinks(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Oink) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.ArchivedBy, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"oinks\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"archived_by"}),
+				strmangle.WhereClause("\"", "\"", 2, oinkPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.ArchivedBy, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			ArchivedByOinks: related,
+		}
+	} else {
+		o.R.ArchivedByOinks = append(o.R.ArchivedByOinks, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &oinkR{
+				ArchivedByUser: o,
+			}
+		} else {
+			rel.R.ArchivedByUser = o
+		}
+	}
+	return nil
+}
+
+// SetArchivedByOinks removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.ArchivedByUser's ArchivedByOinks accordingly.
+// Replaces o.R.ArchivedByOinks with related.
+// Sets related.R.ArchivedByUser's ArchivedByOinks accordingly.
+func (o *User) SetArchivedByOinks(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Oink) error {
+	query := "update \"oinks\" set \"archived_by\" = null where \"archived_by\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.ArchivedByOinks {
+			queries.SetScanner(&rel.ArchivedBy, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.ArchivedByUser = nil
+		}
+		o.R.ArchivedByOinks = nil
+	}
+
+	return o.AddArchivedByOinks(ctx, exec, insert, related...)
+}
+
+// RemoveArchivedByOinks relationships from objects passed in.
+// Removes related items from R.ArchivedByOinks (uses pointer comparison, removal does not keep order)
+// Sets related.R.ArchivedByUser.
+func (o *User) RemoveArchivedByOinks(ctx context.Context, exec boil.ContextExecutor, related ...*Oink) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.ArchivedBy, nil)
+		if rel.R != nil {
+			rel.R.ArchivedByUser = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("archived_by")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.ArchivedByOinks {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.ArchivedByOinks)
+			if ln > 1 && i < ln-1 {
+				o.R.ArchivedByOinks[i] = o.R.ArchivedByOinks[ln-1]
+			}
+			o.R.ArchivedByOinks = o.R.ArchivedByOinks[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddCreatorOinks adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.CreatorOinks.
+// Sets related.R.CreatorUser appropriately.
+func (o *User) AddCreatorOinks(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Oink) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.Creator = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"oinks\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"creator"}),
+				strmangle.WhereClause("\"", "\"", 2, oinkPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.Creator = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			CreatorOinks: related,
+		}
+	} else {
+		o.R.CreatorOinks = append(o.R.CreatorOinks, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &oinkR{
+				CreatorUser: o,
+			}
+		} else {
+			rel.R.CreatorUser = o
+		}
+	}
+	return nil
+}
+
+// AddAuthorPosts adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.AuthorPosts.
+// Sets related.R.AuthorUser appropriately.
+func (o *User) AddAuthorPosts(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Post) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.Author = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"posts\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"author"}),
+				strmangle.WhereClause("\"", "\"", 2, postPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.Author = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			AuthorPosts: related,
+		}
+	} else {
+		o.R.AuthorPosts = append(o.R.AuthorPosts, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &postR{
+				AuthorUser: o,
+			}
+		} else {
+			rel.R.AuthorUser = o
 		}
 	}
 	return nil
@@ -0,0 +1,145 @@
+// Package images re-encodes uploaded images and makes thumbnails of them.
+package images
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/color"
+	_ "image/gif" // registers the GIF decoder
+	"image/jpeg"
+	"image/png"
+	"io"
+)
+
+var (
+	ErrUnsupported = errors.New("Image must be a PNG, JPEG or GIF")
+	ErrTooLarge    = errors.New("Image dimensions are too large")
+)
+
+// maxPixels bounds the decoded size of an image, so that a small file cannot
+// expand into an enormous bitmap.
+const maxPixels = 40_000_000
+
+// Variant is an encoded image.
+type Variant struct {
+	Data        []byte
+	ContentType string
+	Ext         string
+}
+
+// Processed holds an uploaded image re-encoded to fit its size and a
+// thumbnail of it.
+type Processed struct {
+	Full  Variant
+	Thumb Variant
+}
+
+// Process decodes the image in r and re-encodes it scaled down to fit in a
+// maxSize square, along with a thumbnail fitting a thumbSize square.
+// Re-encoding drops whatever metadata the upload carried. JPEGs stay JPEGs,
+// everything else becomes a PNG.
+func Process(r io.Reader, maxSize int, thumbSize int) (*Processed, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	config, format, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, ErrUnsupported
+	}
+	if config.Width*config.Height > maxPixels {
+		return nil, ErrTooLarge
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, ErrUnsupported
+	}
+
+	full, err := encode(Fit(img, maxSize), format)
+	if err != nil {
+		return nil, err
+	}
+
+	thumb, err := encode(Fit(img, thumbSize), format)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Processed{Full: *full, Thumb: *thumb}, nil
+}
+
+func encode(img image.Image, format string) (*Variant, error) {
+	var buf bytes.Buffer
+
+	if format == "jpeg" {
+		if err := jpeg.Encode(&buf, img, &jpeg.Options{Quality: 85}); err != nil {
+			return nil, err
+		}
+		return &Variant{Data: buf.Bytes(), ContentType: "image/jpeg", Ext: ".jpg"}, nil
+	}
+
+	if err := png.Encode(&buf, img); err != nil {
+		return nil, err
+	}
+	return &Variant{Data: buf.Bytes(), ContentType: "image/png", Ext: ".png"}, nil
+}
+
+// Fit scales img down, keeping its aspect ratio, so that neither side is
+// longer than size. Each pixel of the result averages the (premultiplied)
+// source pixels it covers. Images that already fit are returned as they are.
+func Fit(img image.Image, size int) image.Image {
+	b := img.Bounds()
+	w, h := b.Dx(), b.Dy()
+	if w <= size && h <= size {
+		return img
+	}
+
+	dw, dh := size, h*size/w
+	if h > w {
+		dw, dh = w*size/h, size
+	}
+	if dw < 1 {
+		dw = 1
+	}
+	if dh < 1 {
+		dh = 1
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, dw, dh))
+	for y := 0; y < dh; y++ {
+		y0, y1 := b.Min.Y+y*h/dh, b.Min.Y+(y+1)*h/dh
+		if y1 == y0 {
+			y1++
+		}
+		for x := 0; x < dw; x++ {
+			x0, x1 := b.Min.X+x*w/dw, b.Min.X+(x+1)*w/dw
+			if x1 == x0 {
+				x1++
+			}
+
+			var r, g, bl, a, n uint64
+			for sy := y0; sy < y1; sy++ {
+				for sx := x0; sx < x1; sx++ {
+					c := color.RGBA64Model.Convert(img.At(sx, sy)).(color.RGBA64)
+					r += uint64(c.R)
+					g += uint64(c.G)
+					bl += uint64(c.B)
+					a += uint64(c.A)
+					n++
+				}
+			}
+
+			dst.Set(x, y, color.RGBA64{
+				R: uint16(r / n),
+				G: uint16(g / n),
+				B: uint16(bl / n),
+				A: uint16(a / n),
+			})
+		}
+	}
+
+	return dst
+}
@@ -0,0 +1,88 @@
+package images
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"strings"
+	"testing"
+)
+
+func TestFit(t *testing.T) {
+	tests := []struct {
+		w, h, size int
+		wantW      int
+		wantH      int
+	}{
+		{100, 50, 200, 100, 50},
+		{400, 200, 100, 100, 50},
+		{200, 400, 100, 50, 100},
+		{1000, 1, 10, 10, 1},
+	}
+
+	for _, tt := range tests {
+		got := Fit(image.NewRGBA(image.Rect(0, 0, tt.w, tt.h)), tt.size).Bounds()
+		if got.Dx() != tt.wantW || got.Dy() != tt.wantH {
+			t.Errorf("Fit(%dx%d, %d) = %dx%d, want %dx%d", tt.w, tt.h, tt.size, got.Dx(), got.Dy(), tt.wantW, tt.wantH)
+		}
+	}
+}
+
+func TestFitAveragesPixels(t *testing.T) {
+	img := image.NewRGBA(image.Rect(0, 0, 2, 1))
+	img.Set(0, 0, color.RGBA{R: 255, A: 255})
+	img.Set(1, 0, color.RGBA{B: 255, A: 255})
+
+	r, g, b, _ := Fit(img, 1).At(0, 0).RGBA()
+	if r>>8 != 127 || g != 0 || b>>8 != 127 {
+		t.Fatalf("got %d,%d,%d, want the average of red and blue", r>>8, g>>8, b>>8)
+	}
+}
+
+func TestProcess(t *testing.T) {
+	src := image.NewRGBA(image.Rect(0, 0, 300, 150))
+
+	var pngData, jpegData bytes.Buffer
+	png.Encode(&pngData, src)
+	jpeg.Encode(&jpegData, src, nil)
+
+	tests := []struct {
+		name string
+		data []byte
+		ext  string
+	}{
+		{"png", pngData.Bytes(), ".png"},
+		{"jpeg", jpegData.Bytes(), ".jpg"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := Process(bytes.NewReader(tt.data), 200, 50)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if p.Full.Ext != tt.ext || p.Thumb.Ext != tt.ext {
+				t.Fatalf("got %s and %s, want %s", p.Full.Ext, p.Thumb.Ext, tt.ext)
+			}
+
+			full, _, err := image.Decode(bytes.NewReader(p.Full.Data))
+			if err != nil || full.Bounds().Dx() != 200 {
+				t.Fatalf("full variant is %v (%v), want 200 wide", full.Bounds(), err)
+			}
+			thumb, _, err := image.Decode(bytes.NewReader(p.Thumb.Data))
+			if err != nil || thumb.Bounds().Dx() != 50 {
+				t.Fatalf("thumbnail is %v (%v), want 50 wide", thumb.Bounds(), err)
+			}
+		})
+	}
+}
+
+func TestProcessRejectsNonImages(t *testing.T) {
+	_, err := Process(strings.NewReader("<svg></svg>"), 200, 50)
+	if !errors.Is(err, ErrUnsupported) {
+		t.Fatalf("got %v, want %v", err, ErrUnsupported)
+	}
+}
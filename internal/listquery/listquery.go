@@ -0,0 +1,309 @@
+// Package listquery parses the filtering, sorting and paging query parameters
+// of list endpoints and turns them into sqlboiler query mods.
+//
+// A Spec describes what an endpoint accepts:
+//
+//	created_after=2023-05-01&name_prefix=chel&sort=-created_at&cursor=...
+//
+// Sorting on a field prefixed with "-" sorts in descending order. Rows are
+// paged through with keyset cursors over the sort field and the id, so a page
+// never skips or repeats rows that were inserted while paging.
+package listquery
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// Kind is the type of the values of a field.
+type Kind int
+
+const (
+	String Kind = iota
+	Time
+)
+
+// Match is how a filter compares its column to the value it is given.
+type Match int
+
+const (
+	Equal Match = iota
+	Prefix
+	After
+	Before
+)
+
+// Filter narrows a listing down to the rows whose Column matches the value of
+// a query parameter.
+type Filter struct {
+	Column string
+	Kind   Kind
+	Match  Match
+}
+
+// Field is a column a listing may be sorted by.
+type Field struct {
+	Column string
+	Kind   Kind
+	// Value returns what row, one of the rows listed, holds in the column.
+	// The cursor of the page after a row is made from it.
+	Value func(row interface{}) interface{}
+}
+
+// Spec describes the query parameters a list endpoint accepts.
+type Spec struct {
+	// Table is the table that is listed. Its rows are identified by an "id"
+	// column.
+	Table string
+	// Filters are keyed by the name of their query parameter.
+	Filters map[string]Filter
+	// Sorts are keyed by the name clients sort by.
+	Sorts map[string]Field
+	// DefaultSort is used when the client does not ask for a sort, such as
+	// "-created_at".
+	DefaultSort string
+}
+
+// Error reports a query parameter that cannot be used.
+type Error struct {
+	Param   string
+	Message string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%s %s", e.Param, e.Message)
+}
+
+type condition struct {
+	Filter
+	value interface{}
+}
+
+// Query is a parsed set of query parameters.
+type Query struct {
+	spec       *Spec
+	conditions []condition
+	sort       string
+	desc       bool
+	after      *Cursor
+}
+
+// Parse reads the filters, the sort and the cursor from qs. Parameters the
+// spec does not know of are rejected, unless they are among the handled
+// parameters the endpoint reads itself.
+func (s *Spec) Parse(qs url.Values, handled ...string) (*Query, error) {
+	q := &Query{spec: s}
+
+	for _, param := range sortedKeys(qs) {
+		values := qs[param]
+
+		if param == "sort" || param == "cursor" {
+			if len(values) > 1 {
+				return nil, &Error{Param: param, Message: "must only be given once"}
+			}
+			continue
+		}
+
+		filter, ok := s.Filters[param]
+		if !ok {
+			if contains(handled, param) {
+				continue
+			}
+			return nil, &Error{Param: param, Message: "is not a supported parameter"}
+		}
+		if len(values) > 1 {
+			return nil, &Error{Param: param, Message: "must only be given once"}
+		}
+
+		value, err := parseValue(filter.Kind, values[0])
+		if err != nil {
+			return nil, &Error{Param: param, Message: err.Error()}
+		}
+		q.conditions = append(q.conditions, condition{Filter: filter, value: value})
+	}
+
+	sortBy := qs.Get("sort")
+	if sortBy == "" {
+		sortBy = s.DefaultSort
+	}
+	q.sort = strings.TrimPrefix(sortBy, "-")
+	q.desc = strings.HasPrefix(sortBy, "-")
+	if _, ok := s.Sorts[q.sort]; !ok {
+		return nil, &Error{Param: "sort", Message: fmt.Sprintf("must be one of %s, optionally prefixed with -", strings.Join(sortedKeys(s.Sorts), ", "))}
+	}
+
+	if cursor := qs.Get("cursor"); cursor != "" {
+		after, err := decodeCursor(cursor)
+		if err != nil || after.Sort != q.sortKey() {
+			return nil, &Error{Param: "cursor", Message: "is invalid"}
+		}
+		if _, err := parseValue(s.Sorts[q.sort].Kind, after.Value); err != nil {
+			return nil, &Error{Param: "cursor", Message: "is invalid"}
+		}
+		if _, err := uuid.Parse(after.ID); err != nil {
+			return nil, &Error{Param: "cursor", Message: "is invalid"}
+		}
+		q.after = after
+	}
+
+	return q, nil
+}
+
+func contains(params []string, param string) bool {
+	for _, p := range params {
+		if p == param {
+			return true
+		}
+	}
+	return false
+}
+
+// Sort returns the field the listing is sorted by and whether it runs in
+// descending order.
+func (q *Query) Sort() (string, bool) {
+	return q.sort, q.desc
+}
+
+func (q *Query) sortKey() string {
+	if q.desc {
+		return "-" + q.sort
+	}
+	return q.sort
+}
+
+func (q *Query) column(name string) string {
+	return fmt.Sprintf(`"%s"."%s"`, q.spec.Table, name)
+}
+
+// Where returns the mods restricting a query to the rows matching the filters.
+func (q *Query) Where() []qm.QueryMod {
+	mods := make([]qm.QueryMod, 0, len(q.conditions))
+	for _, c := range q.conditions {
+		column := q.column(c.Column)
+		switch c.Match {
+		case Equal:
+			mods = append(mods, qm.Where(column+" = ?", c.value))
+		case Prefix:
+			mods = append(mods, qm.Where(column+` ILIKE ?`, EscapeLike(c.value.(string))+"%"))
+		case After:
+			mods = append(mods, qm.Where(column+" > ?", c.value))
+		case Before:
+			mods = append(mods, qm.Where(column+" < ?", c.value))
+		}
+	}
+
+	return mods
+}
+
+// Page returns the mods sorting a query and restricting it to the limit rows
+// that follow the cursor it was given.
+func (q *Query) Page(limit int) []qm.QueryMod {
+	return append(q.Seek(), qm.Limit(limit))
+}
+
+// Seek returns the mods sorting a query and restricting it to every row that
+// follows the cursor it was given.
+func (q *Query) Seek() []qm.QueryMod {
+	direction, comparison := "asc", ">"
+	if q.desc {
+		direction, comparison = "desc", "<"
+	}
+
+	sortColumn := q.column(q.spec.Sorts[q.sort].Column)
+	idColumn := q.column("id")
+
+	mods := []qm.QueryMod{
+		qm.OrderBy(fmt.Sprintf("%s %s, %s %s", sortColumn, direction, idColumn, direction)),
+	}
+	if q.after != nil {
+		value, _ := parseValue(q.spec.Sorts[q.sort].Kind, q.after.Value)
+		mods = append(mods, qm.Where(fmt.Sprintf("(%s, %s) %s (?, ?)", sortColumn, idColumn, comparison), value, q.after.ID))
+	}
+
+	return mods
+}
+
+// Next returns the cursor of the page following row, whose id is id.
+func (q *Query) Next(row interface{}, id string) string {
+	var formatted string
+	switch v := q.spec.Sorts[q.sort].Value(row).(type) {
+	case time.Time:
+		formatted = v.UTC().Format(time.RFC3339Nano)
+	default:
+		formatted = fmt.Sprint(v)
+	}
+
+	return encodeCursor(Cursor{Sort: q.sortKey(), Value: formatted, ID: id})
+}
+
+// Cursor marks the last row of a page. It is handed to clients as an opaque
+// string.
+type Cursor struct {
+	Sort  string `json:"s"`
+	Value string `json:"v"`
+	ID    string `json:"i"`
+}
+
+func encodeCursor(cursor Cursor) string {
+	data, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodeCursor(cursor string) (*Cursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	var c Cursor
+	if err := json.Unmarshal(data, &c); err != nil {
+		return nil, err
+	}
+	if c.ID == "" {
+		return nil, fmt.Errorf("cursor without id")
+	}
+
+	return &c, nil
+}
+
+func parseValue(kind Kind, value string) (interface{}, error) {
+	switch kind {
+	case Time:
+		for _, layout := range []string{time.RFC3339Nano, "2006-01-02"} {
+			if t, err := time.Parse(layout, value); err == nil {
+				return t, nil
+			}
+		}
+		return nil, fmt.Errorf("must be an RFC 3339 timestamp or a date")
+	default:
+		if value == "" {
+			return nil, fmt.Errorf("must not be empty")
+		}
+		return value, nil
+	}
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// EscapeLike escapes the LIKE wildcards in s so user input only ever matches
+// literally.
+func EscapeLike(s string) string {
+	return likeEscaper.Replace(s)
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,100 @@
+package listquery
+
+import (
+	"errors"
+	"net/url"
+	"testing"
+	"time"
+)
+
+type oink struct {
+	name      string
+	createdAt time.Time
+}
+
+var spec = Spec{
+	Table: "oinks",
+	Filters: map[string]Filter{
+		"creator":       {Column: "creator", Match: Equal},
+		"created_after": {Column: "created_at", Kind: Time, Match: After},
+		"name_prefix":   {Column: "name", Match: Prefix},
+	},
+	Sorts: map[string]Field{
+		"created_at": {Column: "created_at", Kind: Time, Value: func(row interface{}) interface{} { return row.(oink).createdAt }},
+		"name":       {Column: "name", Value: func(row interface{}) interface{} { return row.(oink).name }},
+	},
+	DefaultSort: "-created_at",
+}
+
+func TestParse(t *testing.T) {
+	q, err := spec.Parse(url.Values{
+		"creator":       {"user-id"},
+		"created_after": {"2023-05-01"},
+		"name_prefix":   {"chel"},
+		"sort":          {"name"},
+		"tag":           {"football"},
+	}, "tag")
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+
+	if sortBy, desc := q.Sort(); sortBy != "name" || desc {
+		t.Fatalf("got sort %q desc %v, want name ascending", sortBy, desc)
+	}
+	if got := len(q.Where()); got != 3 {
+		t.Fatalf("got %d conditions, want 3", got)
+	}
+
+	q, err = spec.Parse(url.Values{})
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	if sortBy, desc := q.Sort(); sortBy != "created_at" || !desc {
+		t.Fatalf("got sort %q desc %v, want the default sort", sortBy, desc)
+	}
+}
+
+func TestParseRejects(t *testing.T) {
+	tests := map[string]url.Values{
+		"unknown parameter":    {"colour": {"pink"}},
+		"unknown sort":         {"sort": {"-colour"}},
+		"repeated filter":      {"creator": {"a", "b"}},
+		"malformed time":       {"created_after": {"yesterday"}},
+		"empty value":          {"name_prefix": {""}},
+		"malformed cursor":     {"cursor": {"not a cursor"}},
+		"cursor of a sort":     {"cursor": {encodeCursor(Cursor{Sort: "name", Value: "chelsea", ID: "5b9f4a1e-2c3d-4e5f-8a9b-0c1d2e3f4a5b"})}},
+		"cursor with a time":   {"cursor": {encodeCursor(Cursor{Sort: "-created_at", Value: "chelsea", ID: "5b9f4a1e-2c3d-4e5f-8a9b-0c1d2e3f4a5b"})}},
+		"cursor with a bad id": {"cursor": {encodeCursor(Cursor{Sort: "-created_at", Value: "2023-05-01T12:00:00Z", ID: "id'; --"})}},
+	}
+
+	for name, qs := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := spec.Parse(qs)
+			var queryErr *Error
+			if !errors.As(err, &queryErr) {
+				t.Fatalf("got %v, want an *Error", err)
+			}
+		})
+	}
+}
+
+func TestNextCursor(t *testing.T) {
+	q, err := spec.Parse(url.Values{})
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+
+	created := time.Date(2023, 5, 1, 12, 0, 0, 123456000, time.UTC)
+	next := q.Next(oink{name: "chelsea", createdAt: created}, "5b9f4a1e-2c3d-4e5f-8a9b-0c1d2e3f4a5b")
+
+	q, err = spec.Parse(url.Values{"cursor": {next}})
+	if err != nil {
+		t.Fatalf("Parse of the next cursor: %v", err)
+	}
+	if q.after.ID != "5b9f4a1e-2c3d-4e5f-8a9b-0c1d2e3f4a5b" || q.after.Value != "2023-05-01T12:00:00.123456Z" {
+		t.Fatalf("got cursor %+v", q.after)
+	}
+	if got := len(q.Page(10)); got != 3 {
+		t.Fatalf("got %d page mods, want order, limit and keyset", got)
+	}
+}
@@ -0,0 +1,34 @@
+// Package output prints the results of the command line tools, either as
+// indented JSON for scripts or as an aligned table for people.
+package output
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+)
+
+// The formats results are printed in.
+const (
+	Table = "table"
+	JSON  = "json"
+)
+
+// Print writes a result to w: v as JSON when format is JSON, header and rows
+// as a table otherwise.
+func Print(w io.Writer, format string, v interface{}, header []string, rows [][]string) error {
+	if format == JSON {
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(v)
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, strings.Join(header, "\t"))
+	for _, row := range rows {
+		fmt.Fprintln(tw, strings.Join(row, "\t"))
+	}
+	return tw.Flush()
+}
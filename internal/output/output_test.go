@@ -0,0 +1,28 @@
+package output
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestPrint(t *testing.T) {
+	v := struct {
+		Name string `json:"name"`
+	}{"chelsea"}
+	header := []string{"NAME", "MEMBERS"}
+	rows := [][]string{{"chelsea", "12"}, {"pigs", "3"}}
+
+	tests := map[string]string{
+		Table: "NAME     MEMBERS\nchelsea  12\npigs     3\n",
+		JSON:  "{\n  \"name\": \"chelsea\"\n}\n",
+	}
+	for format, want := range tests {
+		var buf bytes.Buffer
+		if err := Print(&buf, format, v, header, rows); err != nil {
+			t.Fatalf("Print as %s: %v", format, err)
+		}
+		if buf.String() != want {
+			t.Errorf("Print as %s = %q, want %q", format, buf.String(), want)
+		}
+	}
+}
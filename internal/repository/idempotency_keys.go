@@ -0,0 +1,143 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/mrityunjaygr8/go-oink/internal/services"
+	"github.com/rs/zerolog"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+var (
+	ErrIdempotencyKeyMismatch   = errors.New("Idempotency-Key was already used for a different request")
+	ErrIdempotencyKeyInProgress = errors.New("A request with this Idempotency-Key is still in progress")
+)
+
+// idempotencyKeyLease is how long a request holds its key before another
+// request may take it over, for when the one holding it never answered. It
+// outlasts the server's write timeout, so live requests keep their keys.
+const idempotencyKeyLease = time.Minute
+
+type IdempotencyKeyRepositoryInterface interface {
+	IdempotencyKeyBegin(ctx context.Context, userID string, key string, fingerprint string, ttl time.Duration) (*IdempotencyKey, error)
+	IdempotencyKeyComplete(ctx context.Context, userID string, key string, status int, headers map[string][]string, body []byte) error
+	IdempotencyKeyRelease(ctx context.Context, userID string, key string) error
+}
+
+type IdempotencyKeyRepository struct {
+	DB boil.ContextExecutor
+	l  zerolog.Logger
+}
+
+// IdempotencyKey is the stored response to a request made with an
+// Idempotency-Key header.
+type IdempotencyKey struct {
+	UserID      string
+	Key         string
+	Fingerprint string
+	Status      int
+	ContentType string
+	Headers     map[string][]string
+	Body        []byte
+	CreatedAt   time.Time
+	ExpiresAt   time.Time
+}
+
+func serviceToRepositoryIdempotencyKey(key services.IdempotencyKey) *IdempotencyKey {
+	return &IdempotencyKey{
+		UserID:      key.UserID,
+		Key:         key.Key,
+		Fingerprint: key.Fingerprint,
+		Status:      key.Status,
+		ContentType: key.ContentType,
+		Headers:     key.Headers,
+		Body:        key.Body,
+		CreatedAt:   key.CreatedAt,
+		ExpiresAt:   key.ExpiresAt,
+	}
+}
+
+// IdempotencyKeyBegin claims key for a request of userID identified by
+// fingerprint, for ttl. It returns nil when the request should go ahead, and
+// the stored response when the same request was already answered. A key used
+// for a different request yields ErrIdempotencyKeyMismatch, one whose
+// request has not been answered yet ErrIdempotencyKeyInProgress, until the
+// lease of that request runs out.
+func (i *IdempotencyKeyRepository) IdempotencyKeyBegin(ctx context.Context, userID string, key string, fingerprint string, ttl time.Duration) (*IdempotencyKey, error) {
+	service := services.New(i.DB, i.l)
+
+	err := service.IdempotencyKeyService.DeleteExpired(ctx, userID)
+	if err != nil {
+		i.l.Error().Err(err).Msg("repository-IdempotencyKeyBegin-DeleteExpired")
+		return nil, err
+	}
+
+	reserved, err := service.IdempotencyKeyService.Reserve(ctx, &services.IdempotencyKey{
+		UserID:      userID,
+		Key:         key,
+		Fingerprint: fingerprint,
+		ExpiresAt:   time.Now().Add(ttl),
+		LockedUntil: time.Now().Add(idempotencyKeyLease),
+	})
+	if err != nil {
+		i.l.Error().Err(err).Msg("repository-IdempotencyKeyBegin-Reserve")
+		return nil, err
+	}
+	if reserved {
+		return nil, nil
+	}
+
+	stored, err := service.IdempotencyKeyService.Retrieve(ctx, userID, key)
+	if err != nil {
+		// released by the request holding it in the meantime
+		if errors.Is(err, services.ErrIdempotencyKeyNotFound) {
+			return nil, ErrIdempotencyKeyInProgress
+		}
+		i.l.Error().Err(err).Msg("repository-IdempotencyKeyBegin-Retrieve")
+		return nil, err
+	}
+
+	if stored.Fingerprint != fingerprint {
+		return nil, ErrIdempotencyKeyMismatch
+	}
+	if stored.Status == 0 {
+		return nil, ErrIdempotencyKeyInProgress
+	}
+
+	return serviceToRepositoryIdempotencyKey(*stored), nil
+}
+
+// IdempotencyKeyComplete stores the response to the request that claimed key,
+// headers included.
+func (i *IdempotencyKeyRepository) IdempotencyKeyComplete(ctx context.Context, userID string, key string, status int, headers map[string][]string, body []byte) error {
+	service := services.New(i.DB, i.l)
+
+	err := service.IdempotencyKeyService.Complete(ctx, &services.IdempotencyKey{
+		UserID:  userID,
+		Key:     key,
+		Status:  status,
+		Headers: headers,
+		Body:    body,
+	})
+	if err != nil && !errors.Is(err, services.ErrIdempotencyKeyNotFound) {
+		i.l.Error().Err(err).Msg("repository-IdempotencyKeyComplete-Complete")
+		return err
+	}
+
+	return nil
+}
+
+// IdempotencyKeyRelease forgets key, so the request can be retried with it.
+func (i *IdempotencyKeyRepository) IdempotencyKeyRelease(ctx context.Context, userID string, key string) error {
+	service := services.New(i.DB, i.l)
+
+	err := service.IdempotencyKeyService.Delete(ctx, userID, key)
+	if err != nil {
+		i.l.Error().Err(err).Msg("repository-IdempotencyKeyRelease-Delete")
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,184 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/mrityunjaygr8/go-oink/internal/services"
+	"github.com/rs/zerolog"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+var ErrOinkInvitationNotFound = errors.New("No invitation or join request is pending for this user")
+
+// The kinds of pending admissions to a private oink.
+const (
+	OinkInvitationKindInvitation = services.OinkInvitationKindInvitation
+	OinkInvitationKindRequest    = services.OinkInvitationKindRequest
+)
+
+type OinkInvitationRepositoryInterface interface {
+	OinkInvitationList(ctx context.Context, oinkName string) (*[]OinkInvitation, error)
+	OinkInvite(ctx context.Context, oinkName string, userID string, invitedBy string) (*OinkInvitation, error)
+	OinkRequestApprove(ctx context.Context, oinkName string, userID string) (*OinkMember, error)
+	OinkInvitationDelete(ctx context.Context, oinkName string, userID string) error
+}
+
+type OinkInvitationRepository struct {
+	DB boil.ContextExecutor
+	l  zerolog.Logger
+}
+
+type OinkInvitation struct {
+	OinkID    string
+	UserID    string
+	Username  string
+	Kind      string
+	CreatedBy string
+	CreatedAt time.Time
+}
+
+func serviceToRepositoryOinkInvitation(invitation services.OinkInvitation) *OinkInvitation {
+	return &OinkInvitation{
+		OinkID:    invitation.OinkID,
+		UserID:    invitation.UserID,
+		Username:  invitation.Username,
+		Kind:      invitation.Kind,
+		CreatedBy: invitation.CreatedBy,
+		CreatedAt: invitation.CreatedAt,
+	}
+}
+
+// OinkInvitationList returns the invitations and join requests pending for
+// oinkName, oldest first.
+func (i *OinkInvitationRepository) OinkInvitationList(ctx context.Context, oinkName string) (*[]OinkInvitation, error) {
+	service := services.New(i.DB, i.l)
+
+	oink, err := retrieveOinkByName(ctx, service, oinkName)
+	if err != nil {
+		if !errors.Is(err, ErrOinkNotFound) {
+			i.l.Error().Err(err).Msg("repository-OinkInvitationList-retrieveOinkByName")
+		}
+		return nil, err
+	}
+
+	invitations, err := service.OinkInvitationService.List(ctx, oink.ID)
+	if err != nil {
+		i.l.Error().Err(err).Msg("repository-OinkInvitationList-List")
+		return nil, err
+	}
+
+	result := make([]OinkInvitation, 0)
+	for _, invitation := range *invitations {
+		result = append(result, *serviceToRepositoryOinkInvitation(invitation))
+	}
+
+	return &result, nil
+}
+
+// OinkInvite invites userID to oinkName. The invitation replaces a join
+// request userID may have made; accepting it is joining the oink.
+func (i *OinkInvitationRepository) OinkInvite(ctx context.Context, oinkName string, userID string, invitedBy string) (*OinkInvitation, error) {
+	service := services.New(i.DB, i.l)
+
+	oink, err := retrieveWritableOinkByName(ctx, service, oinkName)
+	if err != nil {
+		if !errors.Is(err, ErrOinkNotFound) && !errors.Is(err, ErrOinkArchived) {
+			i.l.Error().Err(err).Msg("repository-OinkInvite-retrieveWritableOinkByName")
+		}
+		return nil, err
+	}
+
+	_, err = service.UserService.GetByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, services.ErrUserNotFound) {
+			return nil, ErrUserNotFound
+		}
+		i.l.Error().Err(err).Msg("repository-OinkInvite-GetByID")
+		return nil, err
+	}
+
+	exists, err := service.OinkMemberService.Exists(ctx, oink.ID, userID)
+	if err != nil {
+		i.l.Error().Err(err).Msg("repository-OinkInvite-Exists")
+		return nil, err
+	}
+
+	if exists {
+		return nil, ErrOinkMemberExists
+	}
+
+	invitation := services.OinkInvitation{
+		OinkID:    oink.ID,
+		UserID:    userID,
+		Kind:      OinkInvitationKindInvitation,
+		CreatedBy: invitedBy,
+	}
+	err = service.OinkInvitationService.Upsert(ctx, &invitation)
+	if err != nil {
+		i.l.Error().Err(err).Msg("repository-OinkInvite-Upsert")
+		return nil, err
+	}
+
+	return serviceToRepositoryOinkInvitation(invitation), nil
+}
+
+// OinkRequestApprove admits userID to oinkName if they asked to join it.
+func (i *OinkInvitationRepository) OinkRequestApprove(ctx context.Context, oinkName string, userID string) (*OinkMember, error) {
+	service := services.New(i.DB, i.l)
+
+	oink, err := retrieveWritableOinkByName(ctx, service, oinkName)
+	if err != nil {
+		if !errors.Is(err, ErrOinkNotFound) && !errors.Is(err, ErrOinkArchived) {
+			i.l.Error().Err(err).Msg("repository-OinkRequestApprove-retrieveWritableOinkByName")
+		}
+		return nil, err
+	}
+
+	invitation, err := service.OinkInvitationService.Retrieve(ctx, oink.ID, userID)
+	if err != nil {
+		if errors.Is(err, services.ErrOinkInvitationNotFound) {
+			return nil, ErrOinkInvitationNotFound
+		}
+		i.l.Error().Err(err).Msg("repository-OinkRequestApprove-Retrieve")
+		return nil, err
+	}
+
+	if invitation.Kind != OinkInvitationKindRequest {
+		return nil, ErrOinkInvitationNotFound
+	}
+
+	member, err := admitOinkMember(ctx, service, oink.ID, userID)
+	if err != nil {
+		i.l.Error().Err(err).Msg("repository-OinkRequestApprove-admitOinkMember")
+		return nil, err
+	}
+
+	return member, nil
+}
+
+// OinkInvitationDelete withdraws the invitation of, or rejects the join
+// request by, userID.
+func (i *OinkInvitationRepository) OinkInvitationDelete(ctx context.Context, oinkName string, userID string) error {
+	service := services.New(i.DB, i.l)
+
+	oink, err := retrieveWritableOinkByName(ctx, service, oinkName)
+	if err != nil {
+		if !errors.Is(err, ErrOinkNotFound) && !errors.Is(err, ErrOinkArchived) {
+			i.l.Error().Err(err).Msg("repository-OinkInvitationDelete-retrieveWritableOinkByName")
+		}
+		return err
+	}
+
+	err = service.OinkInvitationService.Delete(ctx, oink.ID, userID)
+	if err != nil {
+		if errors.Is(err, services.ErrOinkInvitationNotFound) {
+			return ErrOinkInvitationNotFound
+		}
+		i.l.Error().Err(err).Msg("repository-OinkInvitationDelete-Delete")
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,274 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/mrityunjaygr8/go-oink/internal/services"
+	"github.com/rs/zerolog"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+var (
+	ErrOinkMemberNotFound = errors.New("User is not a member of this oink")
+	ErrOinkMemberExists   = errors.New("User is already a member of this oink")
+	ErrOinkOwnerRole      = errors.New("The owner's role can only change through an ownership transfer")
+
+	// ErrOinkJoinRequested is returned when joining a private oink without an
+	// invitation; a join request now waits for the oink's owner or moderators.
+	ErrOinkJoinRequested = errors.New("Join request sent to the oink's owner")
+)
+
+// The roles a member can hold in an oink.
+const (
+	OinkRoleOwner     = services.OinkRoleOwner
+	OinkRoleModerator = services.OinkRoleModerator
+	OinkRoleMember    = services.OinkRoleMember
+)
+
+type OinkMemberRepositoryInterface interface {
+	OinkMemberJoin(ctx context.Context, oinkName string, userID string) (*OinkMember, error)
+	OinkMemberLeave(ctx context.Context, oinkName string, userID string) error
+	OinkMemberRetrieve(ctx context.Context, oinkName string, userID string) (*OinkMember, error)
+	OinkMemberSetModerator(ctx context.Context, oinkName string, userID string, moderator bool) (*OinkMember, error)
+	OinkMemberList(ctx context.Context, oinkName string, limit int, offset int) (*[]OinkMember, int64, error)
+}
+
+type OinkMemberRepository struct {
+	DB boil.ContextExecutor
+	l  zerolog.Logger
+}
+
+type OinkMember struct {
+	OinkID    string
+	UserID    string
+	Username  string
+	Role      string
+	CreatedAt time.Time
+}
+
+func serviceToRepositoryOinkMember(member services.OinkMember) *OinkMember {
+	return &OinkMember{
+		OinkID:    member.OinkID,
+		UserID:    member.UserID,
+		Username:  member.Username,
+		Role:      member.Role,
+		CreatedAt: member.CreatedAt,
+	}
+}
+
+func serviceToRepositoryOinkMembers(m []services.OinkMember) *[]OinkMember {
+	members := make([]OinkMember, 0)
+	for _, member := range m {
+		members = append(members, *serviceToRepositoryOinkMember(member))
+	}
+
+	return &members
+}
+
+// OinkMemberJoin makes userID a member of oinkName. Private oinks can only be
+// joined with an invitation; otherwise a join request is recorded.
+func (m *OinkMemberRepository) OinkMemberJoin(ctx context.Context, oinkName string, userID string) (*OinkMember, error) {
+	service := services.New(m.DB, m.l)
+
+	oink, err := retrieveWritableOinkByName(ctx, service, oinkName)
+	if err != nil {
+		if !errors.Is(err, ErrOinkNotFound) && !errors.Is(err, ErrOinkArchived) {
+			m.l.Error().Err(err).Msg("repository-OinkMemberJoin-retrieveWritableOinkByName")
+		}
+		return nil, err
+	}
+
+	exists, err := service.OinkMemberService.Exists(ctx, oink.ID, userID)
+	if err != nil {
+		m.l.Error().Err(err).Msg("repository-OinkMemberJoin-Exists")
+		return nil, err
+	}
+
+	if exists {
+		return nil, ErrOinkMemberExists
+	}
+
+	if oink.Visibility == OinkVisibilityPrivate {
+		invitation, err := service.OinkInvitationService.Retrieve(ctx, oink.ID, userID)
+		if err != nil && !errors.Is(err, services.ErrOinkInvitationNotFound) {
+			m.l.Error().Err(err).Msg("repository-OinkMemberJoin-InvitationRetrieve")
+			return nil, err
+		}
+
+		if invitation == nil || invitation.Kind != OinkInvitationKindInvitation {
+			err = service.OinkInvitationService.Upsert(ctx, &services.OinkInvitation{
+				OinkID:    oink.ID,
+				UserID:    userID,
+				Kind:      OinkInvitationKindRequest,
+				CreatedBy: userID,
+			})
+			if err != nil {
+				m.l.Error().Err(err).Msg("repository-OinkMemberJoin-InvitationUpsert")
+				return nil, err
+			}
+
+			return nil, ErrOinkJoinRequested
+		}
+	}
+
+	member, err := admitOinkMember(ctx, service, oink.ID, userID)
+	if err != nil {
+		m.l.Error().Err(err).Msg("repository-OinkMemberJoin-admitOinkMember")
+		return nil, err
+	}
+
+	return member, nil
+}
+
+// admitOinkMember makes userID a member of oinkID, settling any invitation or
+// join request that was pending for them.
+func admitOinkMember(ctx context.Context, service *services.Services, oinkID string, userID string) (*OinkMember, error) {
+	member := services.OinkMember{
+		OinkID: oinkID,
+		UserID: userID,
+	}
+	err := service.OinkMemberService.Insert(ctx, &member)
+	if err != nil {
+		return nil, err
+	}
+
+	err = service.OinkInvitationService.Delete(ctx, oinkID, userID)
+	if err != nil && !errors.Is(err, services.ErrOinkInvitationNotFound) {
+		return nil, err
+	}
+
+	return serviceToRepositoryOinkMember(member), nil
+}
+
+func (m *OinkMemberRepository) OinkMemberLeave(ctx context.Context, oinkName string, userID string) error {
+	service := services.New(m.DB, m.l)
+
+	oink, err := retrieveWritableOinkByName(ctx, service, oinkName)
+	if err != nil {
+		if !errors.Is(err, ErrOinkNotFound) && !errors.Is(err, ErrOinkArchived) {
+			m.l.Error().Err(err).Msg("repository-OinkMemberLeave-retrieveWritableOinkByName")
+		}
+		return err
+	}
+
+	member, err := service.OinkMemberService.Retrieve(ctx, oink.ID, userID)
+	if err != nil {
+		if errors.Is(err, services.ErrOinkMemberNotFound) {
+			return ErrOinkMemberNotFound
+		}
+		m.l.Error().Err(err).Msg("repository-OinkMemberLeave-Retrieve")
+		return err
+	}
+
+	// an oink must never be left without an owner
+	if member.Role == OinkRoleOwner {
+		return ErrOinkOwnerRole
+	}
+
+	err = service.OinkMemberService.Delete(ctx, oink.ID, userID)
+	if err != nil {
+		if errors.Is(err, services.ErrOinkMemberNotFound) {
+			return ErrOinkMemberNotFound
+		}
+		m.l.Error().Err(err).Msg("repository-OinkMemberLeave-Delete")
+		return err
+	}
+
+	return nil
+}
+
+// OinkMemberList returns a page of the members of oinkName, in the order they
+// joined, along with the total number of members.
+func (m *OinkMemberRepository) OinkMemberList(ctx context.Context, oinkName string, limit int, offset int) (*[]OinkMember, int64, error) {
+	service := services.New(m.DB, m.l)
+
+	oink, err := retrieveOinkByName(ctx, service, oinkName)
+	if err != nil {
+		if !errors.Is(err, ErrOinkNotFound) {
+			m.l.Error().Err(err).Msg("repository-OinkMemberList-retrieveOinkByName")
+		}
+		return nil, 0, err
+	}
+
+	members, err := service.OinkMemberService.List(ctx, oink.ID, limit, offset)
+	if err != nil {
+		m.l.Error().Err(err).Msg("repository-OinkMemberList-List")
+		return nil, 0, err
+	}
+
+	total, err := service.OinkMemberService.Count(ctx, oink.ID)
+	if err != nil {
+		m.l.Error().Err(err).Msg("repository-OinkMemberList-Count")
+		return nil, 0, err
+	}
+
+	return serviceToRepositoryOinkMembers(*members), total, nil
+}
+
+func (m *OinkMemberRepository) OinkMemberRetrieve(ctx context.Context, oinkName string, userID string) (*OinkMember, error) {
+	service := services.New(m.DB, m.l)
+
+	oink, err := retrieveOinkByName(ctx, service, oinkName)
+	if err != nil {
+		if !errors.Is(err, ErrOinkNotFound) {
+			m.l.Error().Err(err).Msg("repository-OinkMemberRetrieve-retrieveOinkByName")
+		}
+		return nil, err
+	}
+
+	member, err := service.OinkMemberService.Retrieve(ctx, oink.ID, userID)
+	if err != nil {
+		if errors.Is(err, services.ErrOinkMemberNotFound) {
+			return nil, ErrOinkMemberNotFound
+		}
+		m.l.Error().Err(err).Msg("repository-OinkMemberRetrieve-Retrieve")
+		return nil, err
+	}
+
+	return serviceToRepositoryOinkMember(*member), nil
+}
+
+// OinkMemberSetModerator grants or revokes the moderator role of a member of
+// oinkName. The owner's role cannot be changed this way.
+func (m *OinkMemberRepository) OinkMemberSetModerator(ctx context.Context, oinkName string, userID string, moderator bool) (*OinkMember, error) {
+	service := services.New(m.DB, m.l)
+
+	oink, err := retrieveWritableOinkByName(ctx, service, oinkName)
+	if err != nil {
+		if !errors.Is(err, ErrOinkNotFound) && !errors.Is(err, ErrOinkArchived) {
+			m.l.Error().Err(err).Msg("repository-OinkMemberSetModerator-retrieveWritableOinkByName")
+		}
+		return nil, err
+	}
+
+	member, err := service.OinkMemberService.Retrieve(ctx, oink.ID, userID)
+	if err != nil {
+		if errors.Is(err, services.ErrOinkMemberNotFound) {
+			return nil, ErrOinkMemberNotFound
+		}
+		m.l.Error().Err(err).Msg("repository-OinkMemberSetModerator-Retrieve")
+		return nil, err
+	}
+
+	if member.Role == OinkRoleOwner {
+		return nil, ErrOinkOwnerRole
+	}
+
+	role := OinkRoleMember
+	if moderator {
+		role = OinkRoleModerator
+	}
+
+	if member.Role != role {
+		err = service.OinkMemberService.UpdateRole(ctx, oink.ID, userID, role)
+		if err != nil {
+			m.l.Error().Err(err).Msg("repository-OinkMemberSetModerator-UpdateRole")
+			return nil, err
+		}
+		member.Role = role
+	}
+
+	return serviceToRepositoryOinkMember(*member), nil
+}
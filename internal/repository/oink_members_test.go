@@ -0,0 +1,126 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func expectOinkByName(mock sqlmock.Sqlmock) {
+	mock.ExpectQuery(`(?i)from "oinks"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "creator"}).AddRow("oink-id", "chelsea", "user-id"))
+	mock.ExpectQuery(`(?i)from "users"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "username"}).AddRow("user-id", "im"))
+}
+
+func expectOinkMember(mock sqlmock.Sqlmock, role string) {
+	mock.ExpectQuery(`(?i)from "oink_members"`).
+		WillReturnRows(sqlmock.NewRows([]string{"oink", "user", "role"}).AddRow("oink-id", "user-id", role))
+	mock.ExpectQuery(`(?i)from "users"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "username"}).AddRow("user-id", "im"))
+}
+
+func TestOinkMemberRepositoryJoin(t *testing.T) {
+	t.Run("missing-oink", func(t *testing.T) {
+		r, mock := newMockRepository(t)
+		mock.ExpectQuery(`(?i)from "oinks"`).WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+		_, err := r.OinkMemberRepository.OinkMemberJoin(context.Background(), "chelsea", "user-id")
+		if !errors.Is(err, ErrOinkNotFound) {
+			t.Fatalf("got %v, want %v", err, ErrOinkNotFound)
+		}
+	})
+
+	t.Run("already-member", func(t *testing.T) {
+		r, mock := newMockRepository(t)
+		expectOinkByName(mock)
+		mock.ExpectQuery(`(?i)from "oink_members"`).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+
+		_, err := r.OinkMemberRepository.OinkMemberJoin(context.Background(), "chelsea", "user-id")
+		if !errors.Is(err, ErrOinkMemberExists) {
+			t.Fatalf("got %v, want %v", err, ErrOinkMemberExists)
+		}
+	})
+}
+
+func TestOinkMemberRepositoryJoinPrivate(t *testing.T) {
+	expectPrivateOink := func(mock sqlmock.Sqlmock) {
+		mock.ExpectQuery(`(?i)from "oinks"`).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "creator", "visibility"}).AddRow("oink-id", "chelsea", "user-id", OinkVisibilityPrivate))
+		mock.ExpectQuery(`(?i)from "users"`).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "username"}).AddRow("user-id", "im"))
+		mock.ExpectQuery(`(?i)from "oink_members"`).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+	}
+
+	t.Run("without-invitation", func(t *testing.T) {
+		r, mock := newMockRepository(t)
+		expectPrivateOink(mock)
+		mock.ExpectQuery(`(?i)from "oink_invitations"`).WillReturnRows(sqlmock.NewRows([]string{"oink"}))
+		mock.ExpectExec(`(?i)insert into "oink_invitations"`).WillReturnResult(sqlmock.NewResult(0, 1))
+
+		_, err := r.OinkMemberRepository.OinkMemberJoin(context.Background(), "chelsea", "fan-id")
+		if !errors.Is(err, ErrOinkJoinRequested) {
+			t.Fatalf("got %v, want %v", err, ErrOinkJoinRequested)
+		}
+	})
+
+	t.Run("db-error", func(t *testing.T) {
+		r, mock := newMockRepository(t)
+		expectPrivateOink(mock)
+		mock.ExpectQuery(`(?i)from "oink_invitations"`).WillReturnError(errDB)
+
+		_, err := r.OinkMemberRepository.OinkMemberJoin(context.Background(), "chelsea", "fan-id")
+		if !errors.Is(err, errDB) {
+			t.Fatalf("got %v, want %v", err, errDB)
+		}
+	})
+}
+
+func TestOinkMemberRepositoryLeave(t *testing.T) {
+	t.Run("not-a-member", func(t *testing.T) {
+		r, mock := newMockRepository(t)
+		expectOinkByName(mock)
+		mock.ExpectQuery(`(?i)from "oink_members"`).WillReturnRows(sqlmock.NewRows([]string{"oink"}))
+
+		err := r.OinkMemberRepository.OinkMemberLeave(context.Background(), "chelsea", "user-id")
+		if !errors.Is(err, ErrOinkMemberNotFound) {
+			t.Fatalf("got %v, want %v", err, ErrOinkMemberNotFound)
+		}
+	})
+
+	t.Run("owner", func(t *testing.T) {
+		r, mock := newMockRepository(t)
+		expectOinkByName(mock)
+		expectOinkMember(mock, OinkRoleOwner)
+
+		err := r.OinkMemberRepository.OinkMemberLeave(context.Background(), "chelsea", "user-id")
+		if !errors.Is(err, ErrOinkOwnerRole) {
+			t.Fatalf("got %v, want %v", err, ErrOinkOwnerRole)
+		}
+	})
+
+	t.Run("db-error", func(t *testing.T) {
+		r, mock := newMockRepository(t)
+		expectOinkByName(mock)
+		expectOinkMember(mock, OinkRoleMember)
+		mock.ExpectExec(`(?i)delete from "oink_members"`).WillReturnError(errDB)
+
+		err := r.OinkMemberRepository.OinkMemberLeave(context.Background(), "chelsea", "user-id")
+		if !errors.Is(err, errDB) {
+			t.Fatalf("got %v, want %v", err, errDB)
+		}
+	})
+}
+
+func TestOinkMemberRepositorySetModeratorKeepsOwner(t *testing.T) {
+	r, mock := newMockRepository(t)
+	expectOinkByName(mock)
+	expectOinkMember(mock, OinkRoleOwner)
+
+	_, err := r.OinkMemberRepository.OinkMemberSetModerator(context.Background(), "chelsea", "user-id", true)
+	if !errors.Is(err, ErrOinkOwnerRole) {
+		t.Fatalf("got %v, want %v", err, ErrOinkOwnerRole)
+	}
+}
@@ -0,0 +1,82 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/mrityunjaygr8/go-oink/internal/services"
+)
+
+// OinkStats sums up an oink: how many members and posts it has, and how many
+// joined or were written on each of the last Days days.
+type OinkStats struct {
+	OinkID     string
+	CreatedAt  time.Time
+	Members    int64
+	Posts      int64
+	Days       int
+	NewMembers int64
+	NewPosts   int64
+	Activity   []OinkActivity
+}
+
+// OinkActivity counts the members who joined and the posts written in an oink
+// on Day, a UTC date.
+type OinkActivity struct {
+	Day        time.Time
+	NewMembers int64
+	NewPosts   int64
+}
+
+// OinkStats returns the statistics of oinkName, with activity for the last
+// days days, today included.
+func (o *OinkRepository) OinkStats(ctx context.Context, oinkName string, days int) (*OinkStats, error) {
+	service := services.New(o.DB, o.l)
+
+	oink, err := retrieveOinkByName(ctx, service, oinkName)
+	if err != nil {
+		if !errors.Is(err, ErrOinkNotFound) {
+			o.l.Error().Err(err).Msg("repository-OinkStats-retrieveOinkByName")
+		}
+		return nil, err
+	}
+
+	members, err := service.OinkMemberService.Count(ctx, oink.ID)
+	if err != nil {
+		o.l.Error().Err(err).Msg("repository-OinkStats-MemberCount")
+		return nil, err
+	}
+
+	posts, err := service.PostService.Count(ctx, oink.ID)
+	if err != nil {
+		o.l.Error().Err(err).Msg("repository-OinkStats-PostCount")
+		return nil, err
+	}
+
+	activity, err := service.OinkService.Activity(ctx, oink.ID, days)
+	if err != nil {
+		o.l.Error().Err(err).Msg("repository-OinkStats-Activity")
+		return nil, err
+	}
+
+	stats := OinkStats{
+		OinkID:    oink.ID,
+		CreatedAt: oink.CreatedAt,
+		Members:   members,
+		Posts:     posts,
+		Days:      days,
+		Activity:  make([]OinkActivity, 0, len(*activity)),
+	}
+	for _, day := range *activity {
+		stats.NewMembers += day.NewMembers
+		stats.NewPosts += day.NewPosts
+		stats.Activity = append(stats.Activity, OinkActivity{
+			Day:        day.Day,
+			NewMembers: day.NewMembers,
+			NewPosts:   day.NewPosts,
+		})
+	}
+
+	return &stats, nil
+}
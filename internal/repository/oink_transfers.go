@@ -0,0 +1,270 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/mrityunjaygr8/go-oink/internal/services"
+	"github.com/rs/zerolog"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+var (
+	ErrOinkTransferNotFound = errors.New("No ownership transfer is pending for this user")
+	ErrOinkTransferToOwner  = errors.New("User already owns this oink")
+)
+
+type OinkTransferRepositoryInterface interface {
+	OinkTransferOffer(ctx context.Context, oinkName string, fromUserID string, toUserID string) (*OinkTransfer, error)
+	OinkTransferRetrieve(ctx context.Context, oinkName string) (*OinkTransfer, error)
+	OinkTransferAccept(ctx context.Context, oinkName string, userID string) (*OinkMember, error)
+	OinkTransferCancel(ctx context.Context, oinkName string) error
+	OinkTransferAssign(ctx context.Context, oinkName string, toUserID string) (*OinkMember, error)
+}
+
+type OinkTransferRepository struct {
+	DB boil.ContextExecutor
+	l  zerolog.Logger
+}
+
+type OinkTransfer struct {
+	OinkID     string
+	FromUserID string
+	ToUserID   string
+	CreatedAt  time.Time
+}
+
+func serviceToRepositoryOinkTransfer(transfer services.OinkTransfer) *OinkTransfer {
+	return &OinkTransfer{
+		OinkID:     transfer.OinkID,
+		FromUserID: transfer.FromUserID,
+		ToUserID:   transfer.ToUserID,
+		CreatedAt:  transfer.CreatedAt,
+	}
+}
+
+// OinkTransferOffer offers the ownership of oinkName to toUserID, who must
+// already be a member. The offer replaces any earlier one and takes effect
+// only once the recipient accepts it.
+func (t *OinkTransferRepository) OinkTransferOffer(ctx context.Context, oinkName string, fromUserID string, toUserID string) (*OinkTransfer, error) {
+	service := services.New(t.DB, t.l)
+
+	oink, err := retrieveWritableOinkByName(ctx, service, oinkName)
+	if err != nil {
+		if !errors.Is(err, ErrOinkNotFound) && !errors.Is(err, ErrOinkArchived) {
+			t.l.Error().Err(err).Msg("repository-OinkTransferOffer-retrieveWritableOinkByName")
+		}
+		return nil, err
+	}
+
+	recipient, err := service.OinkMemberService.Retrieve(ctx, oink.ID, toUserID)
+	if err != nil {
+		if errors.Is(err, services.ErrOinkMemberNotFound) {
+			return nil, ErrOinkMemberNotFound
+		}
+		t.l.Error().Err(err).Msg("repository-OinkTransferOffer-Retrieve")
+		return nil, err
+	}
+
+	if recipient.Role == OinkRoleOwner {
+		return nil, ErrOinkTransferToOwner
+	}
+
+	transfer := services.OinkTransfer{
+		OinkID:     oink.ID,
+		FromUserID: fromUserID,
+		ToUserID:   toUserID,
+	}
+	err = service.OinkTransferService.Upsert(ctx, &transfer)
+	if err != nil {
+		t.l.Error().Err(err).Msg("repository-OinkTransferOffer-Upsert")
+		return nil, err
+	}
+
+	return serviceToRepositoryOinkTransfer(transfer), nil
+}
+
+func (t *OinkTransferRepository) OinkTransferRetrieve(ctx context.Context, oinkName string) (*OinkTransfer, error) {
+	service := services.New(t.DB, t.l)
+
+	oink, err := retrieveOinkByName(ctx, service, oinkName)
+	if err != nil {
+		if !errors.Is(err, ErrOinkNotFound) {
+			t.l.Error().Err(err).Msg("repository-OinkTransferRetrieve-retrieveOinkByName")
+		}
+		return nil, err
+	}
+
+	transfer, err := service.OinkTransferService.Retrieve(ctx, oink.ID)
+	if err != nil {
+		if errors.Is(err, services.ErrOinkTransferNotFound) {
+			return nil, ErrOinkTransferNotFound
+		}
+		t.l.Error().Err(err).Msg("repository-OinkTransferRetrieve-Retrieve")
+		return nil, err
+	}
+
+	return serviceToRepositoryOinkTransfer(*transfer), nil
+}
+
+// OinkTransferAccept makes userID the owner of oinkName if a transfer to them
+// is pending. The previous owner stays on as a moderator. It should run in a
+// transaction.
+func (t *OinkTransferRepository) OinkTransferAccept(ctx context.Context, oinkName string, userID string) (*OinkMember, error) {
+	service := services.New(t.DB, t.l)
+
+	oink, err := retrieveWritableOinkByName(ctx, service, oinkName)
+	if err != nil {
+		if !errors.Is(err, ErrOinkNotFound) && !errors.Is(err, ErrOinkArchived) {
+			t.l.Error().Err(err).Msg("repository-OinkTransferAccept-retrieveWritableOinkByName")
+		}
+		return nil, err
+	}
+
+	transfer, err := service.OinkTransferService.Retrieve(ctx, oink.ID)
+	if err != nil {
+		if errors.Is(err, services.ErrOinkTransferNotFound) {
+			return nil, ErrOinkTransferNotFound
+		}
+		t.l.Error().Err(err).Msg("repository-OinkTransferAccept-Retrieve")
+		return nil, err
+	}
+
+	if transfer.ToUserID != userID {
+		return nil, ErrOinkTransferNotFound
+	}
+
+	recipient, err := service.OinkMemberService.Retrieve(ctx, oink.ID, userID)
+	if err != nil {
+		if errors.Is(err, services.ErrOinkMemberNotFound) {
+			return nil, ErrOinkMemberNotFound
+		}
+		t.l.Error().Err(err).Msg("repository-OinkTransferAccept-RetrieveRecipient")
+		return nil, err
+	}
+
+	owner, err := service.OinkMemberService.RetrieveOwner(ctx, oink.ID)
+	if err != nil && !errors.Is(err, services.ErrOinkMemberNotFound) {
+		t.l.Error().Err(err).Msg("repository-OinkTransferAccept-RetrieveOwner")
+		return nil, err
+	}
+
+	if owner != nil {
+		err = service.OinkMemberService.UpdateRole(ctx, oink.ID, owner.UserID, OinkRoleModerator)
+		if err != nil {
+			t.l.Error().Err(err).Msg("repository-OinkTransferAccept-demoteOwner")
+			return nil, err
+		}
+	}
+
+	err = service.OinkMemberService.UpdateRole(ctx, oink.ID, userID, OinkRoleOwner)
+	if err != nil {
+		t.l.Error().Err(err).Msg("repository-OinkTransferAccept-promoteRecipient")
+		return nil, err
+	}
+	recipient.Role = OinkRoleOwner
+
+	err = service.OinkTransferService.Delete(ctx, oink.ID)
+	if err != nil {
+		t.l.Error().Err(err).Msg("repository-OinkTransferAccept-Delete")
+		return nil, err
+	}
+
+	return serviceToRepositoryOinkMember(*recipient), nil
+}
+
+func (t *OinkTransferRepository) OinkTransferCancel(ctx context.Context, oinkName string) error {
+	service := services.New(t.DB, t.l)
+
+	oink, err := retrieveWritableOinkByName(ctx, service, oinkName)
+	if err != nil {
+		if !errors.Is(err, ErrOinkNotFound) && !errors.Is(err, ErrOinkArchived) {
+			t.l.Error().Err(err).Msg("repository-OinkTransferCancel-retrieveWritableOinkByName")
+		}
+		return err
+	}
+
+	err = service.OinkTransferService.Delete(ctx, oink.ID)
+	if err != nil {
+		if errors.Is(err, services.ErrOinkTransferNotFound) {
+			return ErrOinkTransferNotFound
+		}
+		t.l.Error().Err(err).Msg("repository-OinkTransferCancel-Delete")
+		return err
+	}
+
+	return nil
+}
+
+// OinkTransferAssign makes toUserID the owner of oinkName right away, without
+// an offer for them to accept, adding them as a member if they are not one.
+// The previous owner stays on as a moderator and any pending transfer is
+// dropped. It is meant for operators and should run in a transaction.
+func (t *OinkTransferRepository) OinkTransferAssign(ctx context.Context, oinkName string, toUserID string) (*OinkMember, error) {
+	service := services.New(t.DB, t.l)
+
+	oink, err := retrieveWritableOinkByName(ctx, service, oinkName)
+	if err != nil {
+		if !errors.Is(err, ErrOinkNotFound) && !errors.Is(err, ErrOinkArchived) {
+			t.l.Error().Err(err).Msg("repository-OinkTransferAssign-retrieveWritableOinkByName")
+		}
+		return nil, err
+	}
+
+	_, err = service.UserService.GetByID(ctx, toUserID)
+	if err != nil {
+		if errors.Is(err, services.ErrUserNotFound) {
+			return nil, ErrUserNotFound
+		}
+		t.l.Error().Err(err).Msg("repository-OinkTransferAssign-GetByID")
+		return nil, err
+	}
+
+	owner, err := service.OinkMemberService.RetrieveOwner(ctx, oink.ID)
+	if err != nil && !errors.Is(err, services.ErrOinkMemberNotFound) {
+		t.l.Error().Err(err).Msg("repository-OinkTransferAssign-RetrieveOwner")
+		return nil, err
+	}
+
+	if owner != nil {
+		if owner.UserID == toUserID {
+			return nil, ErrOinkTransferToOwner
+		}
+
+		err = service.OinkMemberService.UpdateRole(ctx, oink.ID, owner.UserID, OinkRoleModerator)
+		if err != nil {
+			t.l.Error().Err(err).Msg("repository-OinkTransferAssign-demoteOwner")
+			return nil, err
+		}
+	}
+
+	recipient, err := service.OinkMemberService.Retrieve(ctx, oink.ID, toUserID)
+	switch {
+	case err == nil:
+		err = service.OinkMemberService.UpdateRole(ctx, oink.ID, toUserID, OinkRoleOwner)
+		if err != nil {
+			t.l.Error().Err(err).Msg("repository-OinkTransferAssign-promoteRecipient")
+			return nil, err
+		}
+		recipient.Role = OinkRoleOwner
+	case errors.Is(err, services.ErrOinkMemberNotFound):
+		recipient = &services.OinkMember{OinkID: oink.ID, UserID: toUserID, Role: OinkRoleOwner}
+		err = service.OinkMemberService.Insert(ctx, recipient)
+		if err != nil {
+			t.l.Error().Err(err).Msg("repository-OinkTransferAssign-Insert")
+			return nil, err
+		}
+	default:
+		t.l.Error().Err(err).Msg("repository-OinkTransferAssign-RetrieveRecipient")
+		return nil, err
+	}
+
+	err = service.OinkTransferService.Delete(ctx, oink.ID)
+	if err != nil && !errors.Is(err, services.ErrOinkTransferNotFound) {
+		t.l.Error().Err(err).Msg("repository-OinkTransferAssign-Delete")
+		return nil, err
+	}
+
+	return serviceToRepositoryOinkMember(*recipient), nil
+}
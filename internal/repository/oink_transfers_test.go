@@ -0,0 +1,34 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestOinkTransferRepositoryAcceptRequiresRecipient(t *testing.T) {
+	r, mock := newMockRepository(t)
+	expectOinkByName(mock)
+	mock.ExpectQuery(`(?i)from "oink_transfers"`).
+		WillReturnRows(sqlmock.NewRows([]string{"oink", "from_user", "to_user"}).AddRow("oink-id", "user-id", "someone-else"))
+
+	_, err := r.OinkTransferRepository.OinkTransferAccept(context.Background(), "chelsea", "user-id")
+	if !errors.Is(err, ErrOinkTransferNotFound) {
+		t.Fatalf("got %v, want %v", err, ErrOinkTransferNotFound)
+	}
+}
+
+func TestOinkTransferRepositoryAssignToOwner(t *testing.T) {
+	r, mock := newMockRepository(t)
+	expectOinkByName(mock)
+	mock.ExpectQuery(`(?i)from "users"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "username"}).AddRow("user-id", "im"))
+	expectOinkMember(mock, OinkRoleOwner)
+
+	_, err := r.OinkTransferRepository.OinkTransferAssign(context.Background(), "chelsea", "user-id")
+	if !errors.Is(err, ErrOinkTransferToOwner) {
+		t.Fatalf("got %v, want %v", err, ErrOinkTransferToOwner)
+	}
+}
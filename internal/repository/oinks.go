@@ -2,27 +2,81 @@ package repository
 
 import (
 	"context"
-	"database/sql"
 	"errors"
 	"time"
 
+	"github.com/mrityunjaygr8/go-oink/internal/listquery"
 	"github.com/mrityunjaygr8/go-oink/internal/services"
 	"github.com/rs/zerolog"
 	"github.com/volatiletech/sqlboiler/v4/boil"
 )
 
 type OinkRepositoryInterface interface {
-	OinkList(context.Context) (*[]Oink, error)
+	OinkList(context.Context, string, []string, bool, *listquery.Query, int) (*[]Oink, string, error)
+	OinkStream(context.Context, string, []string, bool, *listquery.Query, func(*Oink) error) error
+	OinkListByCreator(context.Context, string) (*[]Oink, error)
+	OinkListByMember(context.Context, string) (*[]Oink, error)
 	OinkRetrieve(context.Context, string) (*Oink, error)
+	OinkLock(context.Context, string) (*Oink, error)
 	OinkDelete(context.Context, string) error
-	OinkInsert(context.Context, string, string, string) (*Oink, error)
+	OinkArchive(context.Context, string, string) (*Oink, error)
+	OinkSetImage(context.Context, string, OinkImage, string) (*Oink, string, error)
+	OinkUnarchive(context.Context, string) (*Oink, error)
+	OinkInsert(context.Context, string, string, string, string, []string, string) (*Oink, error)
+	OinkUpdate(context.Context, string, *string, *string, *string, *string, *[]string) (*Oink, error)
+	OinkSearch(context.Context, string, string, string, int, int) (*[]OinkSearchResult, int64, error)
+	OinkStats(context.Context, string, int) (*OinkStats, error)
+	OinkResolveAlias(context.Context, string) (*Oink, error)
 }
 
 var (
 	ErrOinkNotFound = errors.New("Oink does not exist")
 	ErrOinkExists   = errors.New("Oink with this name already exists")
+
+	ErrOinkVisibilityInvalid = errors.New("Visibility must be one of public, unlisted or private")
+	ErrOinkLanguageInvalid   = errors.New("Language is not a supported text search language")
+	ErrOinkArchived          = errors.New("Oink is archived and can no longer be changed")
+	ErrOinkNotArchived       = errors.New("Oink is not archived")
+	ErrOinkImageInvalid      = errors.New("Image must be one of avatar or banner")
+)
+
+// DefaultOinkLanguage is the text search language of oinks created without one.
+const DefaultOinkLanguage = "english"
+
+// oinkLanguages are the text search configurations Postgres ships with.
+var oinkLanguages = map[string]bool{
+	"simple": true, "arabic": true, "armenian": true, "basque": true, "catalan": true,
+	"danish": true, "dutch": true, "english": true, "finnish": true, "french": true,
+	"german": true, "greek": true, "hindi": true, "hungarian": true, "indonesian": true,
+	"irish": true, "italian": true, "lithuanian": true, "nepali": true, "norwegian": true,
+	"portuguese": true, "romanian": true, "russian": true, "serbian": true, "spanish": true,
+	"swedish": true, "tamil": true, "turkish": true, "yiddish": true,
+}
+
+// ValidOinkLanguage reports whether language is a supported text search
+// language.
+func ValidOinkLanguage(language string) bool {
+	return oinkLanguages[language]
+}
+
+// The visibilities an oink can have.
+const (
+	OinkVisibilityPublic   = services.OinkVisibilityPublic
+	OinkVisibilityUnlisted = services.OinkVisibilityUnlisted
+	OinkVisibilityPrivate  = services.OinkVisibilityPrivate
 )
 
+// OinkListSpec describes how oink listings may be filtered and sorted.
+var OinkListSpec = &services.OinkListSpec
+
+func validOinkVisibility(visibility string) bool {
+	switch visibility {
+	case OinkVisibilityPublic, OinkVisibilityUnlisted, OinkVisibilityPrivate:
+		return true
+	}
+	return false
+}
+
 type OinkRepository struct {
 	DB boil.ContextExecutor
 	l  zerolog.Logger
@@ -32,8 +86,16 @@ type Oink struct {
 	ID          string
 	CreatorID   string
 	Description string
+	Visibility  string
+	Language    string
+	Tags        []string
+	MemberCount int64
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
+	ArchivedAt  *time.Time
+	ArchivedBy  string
+	AvatarKey   string
+	BannerKey   string
 }
 
 func serviceToRepositoryOink(oink services.Oink) *Oink {
@@ -42,8 +104,14 @@ func serviceToRepositoryOink(oink services.Oink) *Oink {
 		CreatorID:   oink.Creator,
 		ID:          oink.ID,
 		Description: oink.Description,
+		Visibility:  oink.Visibility,
+		Language:    oink.Language,
 		CreatedAt:   oink.CreatedAt,
 		UpdatedAt:   oink.UpdatedAt,
+		ArchivedAt:  oink.ArchivedAt,
+		ArchivedBy:  oink.ArchivedBy,
+		AvatarKey:   oink.AvatarKey,
+		BannerKey:   oink.BannerKey,
 	}
 }
 
@@ -56,16 +124,196 @@ func serviceToRepositoryOinks(o []services.Oink) *[]Oink {
 	return &oinks
 }
 
-func (o *OinkRepository) OinkList(ctx context.Context) (*[]Oink, error) {
+// withOinkDetails fills in the MemberCount and the Tags of every oink in oinks.
+func withOinkDetails(ctx context.Context, service *services.Services, oinks *[]Oink) (*[]Oink, error) {
+	ids := make([]string, 0, len(*oinks))
+	for _, oink := range *oinks {
+		ids = append(ids, oink.ID)
+	}
+
+	counts, err := service.OinkMemberService.Counts(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	tags, err := service.TagService.ListForOinks(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range *oinks {
+		id := (*oinks)[i].ID
+		(*oinks)[i].MemberCount = counts[id]
+		(*oinks)[i].Tags = make([]string, 0, len(tags[id]))
+		(*oinks)[i].Tags = append((*oinks)[i].Tags, tags[id]...)
+	}
+
+	return oinks, nil
+}
+
+// withOinkDetail is withOinkDetails for a single oink.
+func withOinkDetail(ctx context.Context, service *services.Services, oink *Oink) (*Oink, error) {
+	oinks, err := withOinkDetails(ctx, service, &[]Oink{*oink})
+	if err != nil {
+		return nil, err
+	}
+
+	return &(*oinks)[0], nil
+}
+
+// retrieveOinkByName looks up oinkName for the repositories of things that
+// live inside an oink, translating the service's not-found error.
+func retrieveOinkByName(ctx context.Context, service *services.Services, oinkName string) (*services.Oink, error) {
+	oink, err := service.OinkService.RetrieveByName(ctx, oinkName)
+	if err != nil {
+		if errors.Is(err, services.ErrOinkNotFound) {
+			return nil, ErrOinkNotFound
+		}
+		return nil, err
+	}
+
+	return oink, nil
+}
+
+// retrieveWritableOinkByName is retrieveOinkByName for changes to an oink or
+// to what lives inside it, which archived oinks no longer accept.
+func retrieveWritableOinkByName(ctx context.Context, service *services.Services, oinkName string) (*services.Oink, error) {
+	oink, err := retrieveOinkByName(ctx, service, oinkName)
+	if err != nil {
+		return nil, err
+	}
+
+	if oink.ArchivedAt != nil {
+		return nil, ErrOinkArchived
+	}
+
+	return oink, nil
+}
+
+// OinkList returns the oinks listed for viewerID, which are the public ones
+// and those viewerID is a member of. An empty viewerID lists every oink. When
+// tags are given, only oinks carrying all of them are returned. Archived oinks
+// are only included when includeArchived is set.
+//
+// q narrows the oinks down, orders them and picks the page to return, limit
+// oinks at a time. The cursor of the page after it is returned along with the
+// oinks, and is empty on the last page.
+func (o *OinkRepository) OinkList(ctx context.Context, viewerID string, tags []string, includeArchived bool, q *listquery.Query, limit int) (*[]Oink, string, error) {
 	service := services.New(o.DB, o.l)
 
-	oinks, err := service.OinkService.List(ctx)
+	tags, err := normalizeTags(tags)
+	if err != nil {
+		return nil, "", err
+	}
+
+	// one oink past the page tells whether there is a next page
+	oinks, err := service.OinkService.List(ctx, viewerID, tags, includeArchived, q, limit+1)
 	if err != nil {
 		o.l.Error().Err(err).Msg("repository-OinkList-OinkList")
+		return nil, "", err
+	}
+
+	next := ""
+	page := *oinks
+	if len(page) > limit {
+		page = page[:limit]
+		last := page[limit-1]
+		next = q.Next(last, last.ID)
+	}
+
+	result, err := withOinkDetails(ctx, service, serviceToRepositoryOinks(page))
+	if err != nil {
+		o.l.Error().Err(err).Msg("repository-OinkList-withOinkDetails")
+		return nil, "", err
+	}
+
+	return result, next, nil
+}
+
+// oinkStreamBatch is how many oinks OinkStream looks the details up for at
+// once.
+const oinkStreamBatch = 100
+
+// OinkStream hands every oink OinkList would return, from the cursor of q on,
+// to fn one at a time, without holding more than a batch of them in memory.
+// The member counts and tags are looked up a batch at a time over another
+// connection while the oinks are still being read, so the repository must
+// not run in a transaction. fn must not hold on to the oinks it is handed.
+func (o *OinkRepository) OinkStream(ctx context.Context, viewerID string, tags []string, includeArchived bool, q *listquery.Query, fn func(*Oink) error) error {
+	service := services.New(o.DB, o.l)
+
+	tags, err := normalizeTags(tags)
+	if err != nil {
+		return err
+	}
+
+	batch := make([]Oink, 0, oinkStreamBatch)
+	flush := func() error {
+		oinks, err := withOinkDetails(ctx, service, &batch)
+		if err != nil {
+			o.l.Error().Err(err).Msg("repository-OinkStream-withOinkDetails")
+			return err
+		}
+		for i := range *oinks {
+			if err := fn(&(*oinks)[i]); err != nil {
+				return err
+			}
+		}
+		batch = batch[:0]
+		return nil
+	}
+
+	err = service.OinkService.Stream(ctx, viewerID, tags, includeArchived, q, func(oink *services.Oink) error {
+		batch = append(batch, *serviceToRepositoryOink(*oink))
+		if len(batch) < oinkStreamBatch {
+			return nil
+		}
+		return flush()
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(batch) == 0 {
+		return nil
+	}
+	return flush()
+}
+
+func (o *OinkRepository) OinkListByCreator(ctx context.Context, creatorID string) (*[]Oink, error) {
+	service := services.New(o.DB, o.l)
+
+	oinks, err := service.OinkService.ListByCreator(ctx, creatorID)
+	if err != nil {
+		o.l.Error().Err(err).Msg("repository-OinkListByCreator-ListByCreator")
 		return nil, err
 	}
 
-	return serviceToRepositoryOinks(*oinks), nil
+	result, err := withOinkDetails(ctx, service, serviceToRepositoryOinks(*oinks))
+	if err != nil {
+		o.l.Error().Err(err).Msg("repository-OinkListByCreator-withOinkDetails")
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (o *OinkRepository) OinkListByMember(ctx context.Context, userID string) (*[]Oink, error) {
+	service := services.New(o.DB, o.l)
+
+	oinks, err := service.OinkService.ListByMember(ctx, userID)
+	if err != nil {
+		o.l.Error().Err(err).Msg("repository-OinkListByMember-ListByMember")
+		return nil, err
+	}
+
+	result, err := withOinkDetails(ctx, service, serviceToRepositoryOinks(*oinks))
+	if err != nil {
+		o.l.Error().Err(err).Msg("repository-OinkListByMember-withOinkDetails")
+		return nil, err
+	}
+
+	return result, nil
 }
 
 func (o *OinkRepository) OinkRetrieve(ctx context.Context, oinkName string) (*Oink, error) {
@@ -73,13 +321,44 @@ func (o *OinkRepository) OinkRetrieve(ctx context.Context, oinkName string) (*Oi
 
 	oink, err := service.OinkService.RetrieveByName(ctx, oinkName)
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
+		if errors.Is(err, services.ErrOinkNotFound) {
 			return nil, ErrOinkNotFound
 		}
 		o.l.Error().Err(err).Msg("repository-OinkRetrieve-OinkRetrieve")
 		return nil, err
 	}
-	return serviceToRepositoryOink(*oink), nil
+
+	result, err := withOinkDetail(ctx, service, serviceToRepositoryOink(*oink))
+	if err != nil {
+		o.l.Error().Err(err).Msg("repository-OinkRetrieve-withOinkDetail")
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// OinkLock is OinkRetrieve, but keeps other transactions from changing the
+// oink until the one the repository runs in ends. Checking the version of an
+// oink against what a client last saw holds for the rest of the transaction.
+func (o *OinkRepository) OinkLock(ctx context.Context, oinkName string) (*Oink, error) {
+	service := services.New(o.DB, o.l)
+
+	oink, err := service.OinkService.RetrieveByNameForUpdate(ctx, oinkName)
+	if err != nil {
+		if errors.Is(err, services.ErrOinkNotFound) {
+			return nil, ErrOinkNotFound
+		}
+		o.l.Error().Err(err).Msg("repository-OinkLock-RetrieveByNameForUpdate")
+		return nil, err
+	}
+
+	result, err := withOinkDetail(ctx, service, serviceToRepositoryOink(*oink))
+	if err != nil {
+		o.l.Error().Err(err).Msg("repository-OinkLock-withOinkDetail")
+		return nil, err
+	}
+
+	return result, nil
 }
 
 func (o *OinkRepository) OinkDelete(ctx context.Context, oinkName string) error {
@@ -87,7 +366,7 @@ func (o *OinkRepository) OinkDelete(ctx context.Context, oinkName string) error
 
 	err := service.OinkService.Delete(ctx, oinkName)
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
+		if errors.Is(err, services.ErrOinkNotFound) {
 			return ErrOinkNotFound
 		}
 		o.l.Error().Err(err).Msg("repository-OinkDelete-OinkDelete")
@@ -96,22 +375,156 @@ func (o *OinkRepository) OinkDelete(ctx context.Context, oinkName string) error
 	return nil
 }
 
-func (o *OinkRepository) OinkInsert(ctx context.Context, name string, description string, creatorID string) (*Oink, error) {
+// OinkImage names one of the images an oink can have.
+type OinkImage string
+
+const (
+	OinkImageAvatar OinkImage = "avatar"
+	OinkImageBanner OinkImage = "banner"
+)
+
+// OinkSetImage records key as the blob key of the image of oinkName and
+// returns the key it replaced. An empty key removes the image.
+func (o *OinkRepository) OinkSetImage(ctx context.Context, oinkName string, image OinkImage, key string) (*Oink, string, error) {
 	service := services.New(o.DB, o.l)
 
-	exists, err := service.OinkService.Exists(ctx, name)
+	oink, err := retrieveWritableOinkByName(ctx, service, oinkName)
+	if err != nil {
+		if !errors.Is(err, ErrOinkNotFound) && !errors.Is(err, ErrOinkArchived) {
+			o.l.Error().Err(err).Msg("repository-OinkSetImage-retrieveWritableOinkByName")
+		}
+		return nil, "", err
+	}
+
+	var previous string
+	switch image {
+	case OinkImageAvatar:
+		previous, oink.AvatarKey = oink.AvatarKey, key
+	case OinkImageBanner:
+		previous, oink.BannerKey = oink.BannerKey, key
+	default:
+		return nil, "", ErrOinkImageInvalid
+	}
+
+	err = service.OinkService.UpdateImages(ctx, oink)
 	if err != nil {
-		o.l.Error().Err(err).Msg("repository-OinkInsert-Exists")
+		o.l.Error().Err(err).Msg("repository-OinkSetImage-UpdateImages")
+		return nil, "", err
+	}
+
+	result, err := withOinkDetail(ctx, service, serviceToRepositoryOink(*oink))
+	if err != nil {
+		o.l.Error().Err(err).Msg("repository-OinkSetImage-withOinkDetail")
+		return nil, "", err
+	}
+
+	return result, previous, nil
+}
+
+// OinkArchive makes oinkName read-only and hides it from listings, recording
+// actorID as the user who archived it.
+func (o *OinkRepository) OinkArchive(ctx context.Context, oinkName string, actorID string) (*Oink, error) {
+	service := services.New(o.DB, o.l)
+
+	oink, err := retrieveWritableOinkByName(ctx, service, oinkName)
+	if err != nil {
+		if !errors.Is(err, ErrOinkNotFound) && !errors.Is(err, ErrOinkArchived) {
+			o.l.Error().Err(err).Msg("repository-OinkArchive-retrieveWritableOinkByName")
+		}
 		return nil, err
 	}
 
-	if exists {
+	err = service.OinkService.Archive(ctx, oink, actorID)
+	if err != nil {
+		o.l.Error().Err(err).Msg("repository-OinkArchive-Archive")
+		return nil, err
+	}
+
+	result, err := withOinkDetail(ctx, service, serviceToRepositoryOink(*oink))
+	if err != nil {
+		o.l.Error().Err(err).Msg("repository-OinkArchive-withOinkDetail")
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// OinkUnarchive brings an archived oinkName back.
+func (o *OinkRepository) OinkUnarchive(ctx context.Context, oinkName string) (*Oink, error) {
+	service := services.New(o.DB, o.l)
+
+	oink, err := retrieveOinkByName(ctx, service, oinkName)
+	if err != nil {
+		if !errors.Is(err, ErrOinkNotFound) {
+			o.l.Error().Err(err).Msg("repository-OinkUnarchive-retrieveOinkByName")
+		}
+		return nil, err
+	}
+
+	if oink.ArchivedAt == nil {
+		return nil, ErrOinkNotArchived
+	}
+
+	err = service.OinkService.Unarchive(ctx, oink)
+	if err != nil {
+		o.l.Error().Err(err).Msg("repository-OinkUnarchive-Unarchive")
+		return nil, err
+	}
+
+	result, err := withOinkDetail(ctx, service, serviceToRepositoryOink(*oink))
+	if err != nil {
+		o.l.Error().Err(err).Msg("repository-OinkUnarchive-withOinkDetail")
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// OinkInsert creates an oink owned by creatorID and carrying tags. An empty
+// visibility makes the oink public, an empty language makes it English.
+func (o *OinkRepository) OinkInsert(ctx context.Context, name string, description string, visibility string, language string, tags []string, creatorID string) (*Oink, error) {
+	service := services.New(o.DB, o.l)
+
+	if language == "" {
+		language = DefaultOinkLanguage
+	}
+
+	if !ValidOinkLanguage(language) {
+		return nil, ErrOinkLanguageInvalid
+	}
+
+	tags, err := normalizeTags(tags)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(tags) > tagsMax {
+		return nil, ErrTagsTooMany
+	}
+
+	if visibility == "" {
+		visibility = OinkVisibilityPublic
+	}
+
+	if !validOinkVisibility(visibility) {
+		return nil, ErrOinkVisibilityInvalid
+	}
+
+	taken, err := o.nameTaken(ctx, service, name, "")
+	if err != nil {
+		o.l.Error().Err(err).Msg("repository-OinkInsert-nameTaken")
+		return nil, err
+	}
+
+	if taken {
 		return nil, ErrOinkExists
 	}
 
 	oink := services.Oink{
 		Name:        name,
 		Description: description,
+		Visibility:  visibility,
+		Language:    language,
 		Creator:     creatorID,
 	}
 	err = service.OinkService.Insert(ctx, &oink)
@@ -120,5 +533,220 @@ func (o *OinkRepository) OinkInsert(ctx context.Context, name string, descriptio
 		return nil, err
 	}
 
-	return serviceToRepositoryOink(oink), nil
+	// the creator is the first member, and the owner, of every oink
+	err = service.OinkMemberService.Insert(ctx, &services.OinkMember{OinkID: oink.ID, UserID: creatorID, Role: OinkRoleOwner})
+	if err != nil {
+		o.l.Error().Err(err).Msg("repository-oink-OinkInsert-memberInsert")
+		return nil, err
+	}
+
+	if len(tags) > 0 {
+		err = service.TagService.SetOinkTags(ctx, oink.ID, tags)
+		if err != nil {
+			o.l.Error().Err(err).Msg("repository-oink-OinkInsert-SetOinkTags")
+			return nil, err
+		}
+	}
+
+	result := serviceToRepositoryOink(oink)
+	result.MemberCount = 1
+	result.Tags = append(make([]string, 0, len(tags)), tags...)
+
+	return result, nil
+}
+
+// nameTaken reports whether name is used by an oink, or is an old name of an
+// oink, other than the oink with ID exceptOinkID.
+func (o *OinkRepository) nameTaken(ctx context.Context, service *services.Services, name string, exceptOinkID string) (bool, error) {
+	exists, err := service.OinkService.Exists(ctx, name)
+	if err != nil {
+		return false, err
+	}
+
+	if exists {
+		return true, nil
+	}
+
+	owner, err := service.OinkService.AliasOwner(ctx, name)
+	if err != nil {
+		if errors.Is(err, services.ErrOinkNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return owner != exceptOinkID, nil
+}
+
+// OinkUpdate changes the description, visibility, language, tags and/or the
+// name of the oink currently called oinkName. A renamed oink keeps its
+// previous name as an alias.
+func (o *OinkRepository) OinkUpdate(ctx context.Context, oinkName string, name *string, description *string, visibility *string, language *string, tags *[]string) (*Oink, error) {
+	service := services.New(o.DB, o.l)
+
+	if visibility != nil && !validOinkVisibility(*visibility) {
+		return nil, ErrOinkVisibilityInvalid
+	}
+
+	if language != nil && !ValidOinkLanguage(*language) {
+		return nil, ErrOinkLanguageInvalid
+	}
+
+	var newTags []string
+	if tags != nil {
+		var err error
+		newTags, err = normalizeTags(*tags)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(newTags) > tagsMax {
+			return nil, ErrTagsTooMany
+		}
+	}
+
+	oink, err := retrieveWritableOinkByName(ctx, service, oinkName)
+	if err != nil {
+		if !errors.Is(err, ErrOinkNotFound) && !errors.Is(err, ErrOinkArchived) {
+			o.l.Error().Err(err).Msg("repository-OinkUpdate-retrieveWritableOinkByName")
+		}
+		return nil, err
+	}
+
+	if name != nil && *name != oink.Name {
+		taken, err := o.nameTaken(ctx, service, *name, oink.ID)
+		if err != nil {
+			o.l.Error().Err(err).Msg("repository-OinkUpdate-nameTaken")
+			return nil, err
+		}
+
+		if taken {
+			return nil, ErrOinkExists
+		}
+
+		// taking back one of its own old names
+		err = service.OinkService.AliasDelete(ctx, *name)
+		if err != nil {
+			o.l.Error().Err(err).Msg("repository-OinkUpdate-AliasDelete")
+			return nil, err
+		}
+
+		err = service.OinkService.AliasInsert(ctx, oink.ID, oink.Name)
+		if err != nil {
+			o.l.Error().Err(err).Msg("repository-OinkUpdate-AliasInsert")
+			return nil, err
+		}
+
+		oink.Name = *name
+	}
+
+	if description != nil {
+		oink.Description = *description
+	}
+
+	if visibility != nil {
+		oink.Visibility = *visibility
+	}
+
+	if language != nil {
+		oink.Language = *language
+	}
+
+	err = service.OinkService.Update(ctx, oink)
+	if err != nil {
+		if errors.Is(err, services.ErrOinkNotFound) {
+			return nil, ErrOinkNotFound
+		}
+		o.l.Error().Err(err).Msg("repository-OinkUpdate-Update")
+		return nil, err
+	}
+
+	if tags != nil {
+		err = service.TagService.SetOinkTags(ctx, oink.ID, newTags)
+		if err != nil {
+			o.l.Error().Err(err).Msg("repository-OinkUpdate-SetOinkTags")
+			return nil, err
+		}
+	}
+
+	result, err := withOinkDetail(ctx, service, serviceToRepositoryOink(*oink))
+	if err != nil {
+		o.l.Error().Err(err).Msg("repository-OinkUpdate-withOinkDetail")
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// OinkSearchResult is an oink matching a full-text search.
+type OinkSearchResult struct {
+	Oink
+	Rank          float32
+	NameHighlight string
+	Snippet       string
+}
+
+// OinkSearch returns a page of the oinks listed for viewerID that match query
+// in the text search language, best match first, along with the total number
+// of matches. An empty viewerID searches every oink.
+func (o *OinkRepository) OinkSearch(ctx context.Context, viewerID string, language string, query string, limit int, offset int) (*[]OinkSearchResult, int64, error) {
+	service := services.New(o.DB, o.l)
+
+	if !ValidOinkLanguage(language) {
+		return nil, 0, ErrOinkLanguageInvalid
+	}
+
+	results, total, err := service.OinkService.Search(ctx, viewerID, language, query, limit, offset)
+	if err != nil {
+		o.l.Error().Err(err).Msg("repository-OinkSearch-Search")
+		return nil, 0, err
+	}
+
+	oinks := make([]Oink, 0, len(*results))
+	for _, result := range *results {
+		oinks = append(oinks, *serviceToRepositoryOink(result.Oink))
+	}
+
+	detailed, err := withOinkDetails(ctx, service, &oinks)
+	if err != nil {
+		o.l.Error().Err(err).Msg("repository-OinkSearch-withOinkDetails")
+		return nil, 0, err
+	}
+
+	matches := make([]OinkSearchResult, 0, len(*results))
+	for i, result := range *results {
+		matches = append(matches, OinkSearchResult{
+			Oink:          (*detailed)[i],
+			Rank:          result.Rank,
+			NameHighlight: result.NameHighlight,
+			Snippet:       result.Snippet,
+		})
+	}
+
+	return &matches, total, nil
+}
+
+// OinkResolveAlias returns the oink that used to be called aliasName.
+func (o *OinkRepository) OinkResolveAlias(ctx context.Context, aliasName string) (*Oink, error) {
+	service := services.New(o.DB, o.l)
+
+	oinkID, err := service.OinkService.AliasOwner(ctx, aliasName)
+	if err != nil {
+		if errors.Is(err, services.ErrOinkNotFound) {
+			return nil, ErrOinkNotFound
+		}
+		o.l.Error().Err(err).Msg("repository-OinkResolveAlias-AliasOwner")
+		return nil, err
+	}
+
+	oink, err := service.OinkService.Retrieve(ctx, oinkID)
+	if err != nil {
+		if errors.Is(err, services.ErrOinkNotFound) {
+			return nil, ErrOinkNotFound
+		}
+		o.l.Error().Err(err).Msg("repository-OinkResolveAlias-Retrieve")
+		return nil, err
+	}
+
+	return serviceToRepositoryOink(*oink), nil
 }
@@ -0,0 +1,150 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestOinkRepositoryLookupNotFound(t *testing.T) {
+	tests := []struct {
+		name   string
+		lookup func(context.Context, *Repository) error
+	}{
+		{"OinkRetrieve", func(ctx context.Context, r *Repository) error {
+			_, err := r.OinkRepository.OinkRetrieve(ctx, "chelsea")
+			return err
+		}},
+		{"OinkDelete", func(ctx context.Context, r *Repository) error {
+			return r.OinkRepository.OinkDelete(ctx, "chelsea")
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name+"/missing", func(t *testing.T) {
+			r, mock := newMockRepository(t)
+			mock.ExpectQuery(`(?i)from "oinks"`).WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+			err := tt.lookup(context.Background(), r)
+			if !errors.Is(err, ErrOinkNotFound) {
+				t.Fatalf("got %v, want %v", err, ErrOinkNotFound)
+			}
+		})
+
+		t.Run(tt.name+"/db-error", func(t *testing.T) {
+			r, mock := newMockRepository(t)
+			mock.ExpectQuery(`(?i)from "oinks"`).WillReturnError(errDB)
+
+			err := tt.lookup(context.Background(), r)
+			if !errors.Is(err, errDB) {
+				t.Fatalf("got %v, want %v", err, errDB)
+			}
+			if errors.Is(err, ErrOinkNotFound) {
+				t.Fatalf("database failure reported as %v", ErrOinkNotFound)
+			}
+		})
+	}
+}
+
+func TestOinkRepositoryOinkUpdateRenameConflicts(t *testing.T) {
+	tests := []struct {
+		name       string
+		nameExists int
+		aliasOwner *sqlmock.Rows
+	}{
+		{"oink-name", 1, nil},
+		{"other-oinks-alias", 0, sqlmock.NewRows([]string{"name", "oink"}).AddRow("blues", "other-oink-id")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, mock := newMockRepository(t)
+			mock.ExpectQuery(`(?i)from "oinks"`).
+				WillReturnRows(sqlmock.NewRows([]string{"id", "name", "creator"}).AddRow("oink-id", "chelsea", "user-id"))
+			mock.ExpectQuery(`(?i)from "users"`).
+				WillReturnRows(sqlmock.NewRows([]string{"id", "username"}).AddRow("user-id", "im"))
+			mock.ExpectQuery(`(?i)from "oinks"`).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(tt.nameExists))
+			if tt.aliasOwner != nil {
+				mock.ExpectQuery(`(?i)from "oink_aliases"`).WillReturnRows(tt.aliasOwner)
+			}
+
+			name := "blues"
+			_, err := r.OinkRepository.OinkUpdate(context.Background(), "chelsea", &name, nil, nil, nil, nil)
+			if !errors.Is(err, ErrOinkExists) {
+				t.Fatalf("got %v, want %v", err, ErrOinkExists)
+			}
+		})
+	}
+}
+
+func TestOinkRepositoryRejectsUnknownVisibility(t *testing.T) {
+	r, _ := newMockRepository(t)
+
+	_, err := r.OinkRepository.OinkInsert(context.Background(), "chelsea", "", "secret", "", nil, "user-id")
+	if !errors.Is(err, ErrOinkVisibilityInvalid) {
+		t.Fatalf("got %v, want %v", err, ErrOinkVisibilityInvalid)
+	}
+
+	visibility := "secret"
+	_, err = r.OinkRepository.OinkUpdate(context.Background(), "chelsea", nil, nil, &visibility, nil, nil)
+	if !errors.Is(err, ErrOinkVisibilityInvalid) {
+		t.Fatalf("got %v, want %v", err, ErrOinkVisibilityInvalid)
+	}
+}
+
+func TestOinkRepositoryRejectsUnknownLanguage(t *testing.T) {
+	r, _ := newMockRepository(t)
+
+	_, err := r.OinkRepository.OinkInsert(context.Background(), "chelsea", "", "", "klingon", nil, "user-id")
+	if !errors.Is(err, ErrOinkLanguageInvalid) {
+		t.Fatalf("got %v, want %v", err, ErrOinkLanguageInvalid)
+	}
+
+	_, _, err = r.OinkRepository.OinkSearch(context.Background(), "", "klingon", "blues", 20, 0)
+	if !errors.Is(err, ErrOinkLanguageInvalid) {
+		t.Fatalf("got %v, want %v", err, ErrOinkLanguageInvalid)
+	}
+}
+
+func TestOinkRepositoryArchivedOinksAreReadOnly(t *testing.T) {
+	archived := func(mock sqlmock.Sqlmock, archivedAt interface{}) {
+		mock.ExpectQuery(`(?i)from "oinks"`).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "creator", "archived_at"}).AddRow("oink-id", "chelsea", "user-id", archivedAt))
+		mock.ExpectQuery(`(?i)from "users"`).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "username"}).AddRow("user-id", "im"))
+	}
+
+	t.Run("update", func(t *testing.T) {
+		r, mock := newMockRepository(t)
+		archived(mock, time.Now())
+
+		description := "blues"
+		_, err := r.OinkRepository.OinkUpdate(context.Background(), "chelsea", nil, &description, nil, nil, nil)
+		if !errors.Is(err, ErrOinkArchived) {
+			t.Fatalf("got %v, want %v", err, ErrOinkArchived)
+		}
+	})
+
+	t.Run("post", func(t *testing.T) {
+		r, mock := newMockRepository(t)
+		archived(mock, time.Now())
+
+		_, err := r.PostRepository.PostInsert(context.Background(), "chelsea", "user-id", "up the blues")
+		if !errors.Is(err, ErrOinkArchived) {
+			t.Fatalf("got %v, want %v", err, ErrOinkArchived)
+		}
+	})
+
+	t.Run("unarchive-active", func(t *testing.T) {
+		r, mock := newMockRepository(t)
+		archived(mock, nil)
+
+		_, err := r.OinkRepository.OinkUnarchive(context.Background(), "chelsea")
+		if !errors.Is(err, ErrOinkNotArchived) {
+			t.Fatalf("got %v, want %v", err, ErrOinkNotArchived)
+		}
+	})
+}
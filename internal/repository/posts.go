@@ -0,0 +1,224 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/mrityunjaygr8/go-oink/internal/services"
+	"github.com/rs/zerolog"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+var ErrPostNotFound = errors.New("Post does not exist")
+
+type PostRepositoryInterface interface {
+	PostInsert(ctx context.Context, oinkName string, authorID string, body string) (*Post, error)
+	PostList(ctx context.Context, oinkName string, limit int, offset int) (*[]Post, int64, error)
+	PostRetrieve(ctx context.Context, oinkName string, postID string) (*Post, error)
+	PostLock(ctx context.Context, oinkName string, postID string) (*Post, error)
+	PostUpdate(ctx context.Context, oinkName string, postID string, body string) (*Post, error)
+	PostDelete(ctx context.Context, oinkName string, postID string) error
+}
+
+type PostRepository struct {
+	DB boil.ContextExecutor
+	l  zerolog.Logger
+}
+
+type Post struct {
+	ID             string
+	OinkID         string
+	OinkName       string
+	AuthorID       string
+	AuthorUsername string
+	Body           string
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+func serviceToRepositoryPost(post services.Post, oinkName string) *Post {
+	return &Post{
+		ID:             post.ID,
+		OinkID:         post.OinkID,
+		OinkName:       oinkName,
+		AuthorID:       post.AuthorID,
+		AuthorUsername: post.AuthorUsername,
+		Body:           post.Body,
+		CreatedAt:      post.CreatedAt,
+		UpdatedAt:      post.UpdatedAt,
+	}
+}
+
+func serviceToRepositoryPosts(p []services.Post, oinkName string) *[]Post {
+	posts := make([]Post, 0)
+	for _, post := range p {
+		posts = append(posts, *serviceToRepositoryPost(post, oinkName))
+	}
+
+	return &posts
+}
+
+// PostInsert adds a post by authorID to oinkName. Only members of the oink may
+// post in it; anyone else gets ErrOinkMemberNotFound.
+func (p *PostRepository) PostInsert(ctx context.Context, oinkName string, authorID string, body string) (*Post, error) {
+	service := services.New(p.DB, p.l)
+
+	oink, err := retrieveWritableOinkByName(ctx, service, oinkName)
+	if err != nil {
+		if !errors.Is(err, ErrOinkNotFound) && !errors.Is(err, ErrOinkArchived) {
+			p.l.Error().Err(err).Msg("repository-PostInsert-retrieveWritableOinkByName")
+		}
+		return nil, err
+	}
+
+	isMember, err := service.OinkMemberService.Exists(ctx, oink.ID, authorID)
+	if err != nil {
+		p.l.Error().Err(err).Msg("repository-PostInsert-OinkMemberExists")
+		return nil, err
+	}
+
+	if !isMember {
+		return nil, ErrOinkMemberNotFound
+	}
+
+	post := services.Post{
+		OinkID:   oink.ID,
+		AuthorID: authorID,
+		Body:     body,
+	}
+	err = service.PostService.Insert(ctx, &post)
+	if err != nil {
+		p.l.Error().Err(err).Msg("repository-PostInsert-Insert")
+		return nil, err
+	}
+
+	return p.PostRetrieve(ctx, oink.Name, post.ID)
+}
+
+// PostList returns a page of the posts in oinkName, newest first, along with
+// the total number of posts.
+func (p *PostRepository) PostList(ctx context.Context, oinkName string, limit int, offset int) (*[]Post, int64, error) {
+	service := services.New(p.DB, p.l)
+
+	oink, err := retrieveOinkByName(ctx, service, oinkName)
+	if err != nil {
+		if !errors.Is(err, ErrOinkNotFound) {
+			p.l.Error().Err(err).Msg("repository-PostList-retrieveOinkByName")
+		}
+		return nil, 0, err
+	}
+
+	posts, err := service.PostService.List(ctx, oink.ID, limit, offset)
+	if err != nil {
+		p.l.Error().Err(err).Msg("repository-PostList-List")
+		return nil, 0, err
+	}
+
+	total, err := service.PostService.Count(ctx, oink.ID)
+	if err != nil {
+		p.l.Error().Err(err).Msg("repository-PostList-Count")
+		return nil, 0, err
+	}
+
+	return serviceToRepositoryPosts(*posts, oink.Name), total, nil
+}
+
+func (p *PostRepository) PostRetrieve(ctx context.Context, oinkName string, postID string) (*Post, error) {
+	service := services.New(p.DB, p.l)
+
+	oink, err := retrieveOinkByName(ctx, service, oinkName)
+	if err != nil {
+		if !errors.Is(err, ErrOinkNotFound) {
+			p.l.Error().Err(err).Msg("repository-PostRetrieve-retrieveOinkByName")
+		}
+		return nil, err
+	}
+
+	post, err := service.PostService.Retrieve(ctx, oink.ID, postID)
+	if err != nil {
+		if errors.Is(err, services.ErrPostNotFound) {
+			return nil, ErrPostNotFound
+		}
+		p.l.Error().Err(err).Msg("repository-PostRetrieve-Retrieve")
+		return nil, err
+	}
+
+	return serviceToRepositoryPost(*post, oink.Name), nil
+}
+
+// PostLock is PostRetrieve, but locks the row of the post until the
+// transaction the repository runs in ends.
+func (p *PostRepository) PostLock(ctx context.Context, oinkName string, postID string) (*Post, error) {
+	service := services.New(p.DB, p.l)
+
+	oink, err := retrieveOinkByName(ctx, service, oinkName)
+	if err != nil {
+		if !errors.Is(err, ErrOinkNotFound) {
+			p.l.Error().Err(err).Msg("repository-PostLock-retrieveOinkByName")
+		}
+		return nil, err
+	}
+
+	post, err := service.PostService.RetrieveForUpdate(ctx, oink.ID, postID)
+	if err != nil {
+		if errors.Is(err, services.ErrPostNotFound) {
+			return nil, ErrPostNotFound
+		}
+		p.l.Error().Err(err).Msg("repository-PostLock-RetrieveForUpdate")
+		return nil, err
+	}
+
+	return serviceToRepositoryPost(*post, oink.Name), nil
+}
+
+func (p *PostRepository) PostUpdate(ctx context.Context, oinkName string, postID string, body string) (*Post, error) {
+	service := services.New(p.DB, p.l)
+
+	oink, err := retrieveWritableOinkByName(ctx, service, oinkName)
+	if err != nil {
+		if !errors.Is(err, ErrOinkNotFound) && !errors.Is(err, ErrOinkArchived) {
+			p.l.Error().Err(err).Msg("repository-PostUpdate-retrieveWritableOinkByName")
+		}
+		return nil, err
+	}
+
+	post := services.Post{
+		ID:     postID,
+		OinkID: oink.ID,
+		Body:   body,
+	}
+	err = service.PostService.Update(ctx, &post)
+	if err != nil {
+		if errors.Is(err, services.ErrPostNotFound) {
+			return nil, ErrPostNotFound
+		}
+		p.l.Error().Err(err).Msg("repository-PostUpdate-Update")
+		return nil, err
+	}
+
+	return p.PostRetrieve(ctx, oink.Name, postID)
+}
+
+func (p *PostRepository) PostDelete(ctx context.Context, oinkName string, postID string) error {
+	service := services.New(p.DB, p.l)
+
+	oink, err := retrieveWritableOinkByName(ctx, service, oinkName)
+	if err != nil {
+		if !errors.Is(err, ErrOinkNotFound) && !errors.Is(err, ErrOinkArchived) {
+			p.l.Error().Err(err).Msg("repository-PostDelete-retrieveWritableOinkByName")
+		}
+		return err
+	}
+
+	err = service.PostService.Delete(ctx, oink.ID, postID)
+	if err != nil {
+		if errors.Is(err, services.ErrPostNotFound) {
+			return ErrPostNotFound
+		}
+		p.l.Error().Err(err).Msg("repository-PostDelete-Delete")
+		return err
+	}
+
+	return nil
+}
@@ -9,6 +9,13 @@ type Repository struct {
 	UserRepository  UserRepositoryInterface
 	TokenRepository TokenRepositoryInterface
 	OinkRepository  OinkRepositoryInterface
+
+	OinkMemberRepository     OinkMemberRepositoryInterface
+	OinkTransferRepository   OinkTransferRepositoryInterface
+	OinkInvitationRepository OinkInvitationRepositoryInterface
+	PostRepository           PostRepositoryInterface
+	TagRepository            TagRepositoryInterface
+	IdempotencyKeyRepository IdempotencyKeyRepositoryInterface
 }
 
 func New(db boil.ContextExecutor, l zerolog.Logger) *Repository {
@@ -16,5 +23,12 @@ func New(db boil.ContextExecutor, l zerolog.Logger) *Repository {
 		UserRepository:  &UserRepository{DB: db, l: l},
 		TokenRepository: &TokenRepository{DB: db, l: l},
 		OinkRepository:  &OinkRepository{DB: db, l: l},
+
+		OinkMemberRepository:     &OinkMemberRepository{DB: db, l: l},
+		OinkTransferRepository:   &OinkTransferRepository{DB: db, l: l},
+		OinkInvitationRepository: &OinkInvitationRepository{DB: db, l: l},
+		PostRepository:           &PostRepository{DB: db, l: l},
+		TagRepository:            &TagRepository{DB: db, l: l},
+		IdempotencyKeyRepository: &IdempotencyKeyRepository{DB: db, l: l},
 	}
 }
@@ -0,0 +1,105 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+
+	"github.com/mrityunjaygr8/go-oink/internal/services"
+	"github.com/rs/zerolog"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+const (
+	tagMaxLength = 32
+	tagsMax      = 10
+)
+
+var (
+	ErrTagInvalid  = errors.New("Tags may only contain letters, digits and hyphens, up to 32 characters")
+	ErrTagsTooMany = errors.New("An oink can carry at most 10 tags")
+)
+
+type TagRepositoryInterface interface {
+	TagList(ctx context.Context) (*[]Tag, error)
+}
+
+type TagRepository struct {
+	DB boil.ContextExecutor
+	l  zerolog.Logger
+}
+
+type Tag struct {
+	Name      string
+	OinkCount int64
+}
+
+// normalizeTag lower-cases tag and turns runs of spaces, underscores and
+// hyphens into a single hyphen, so "Premier League" and "premier_league"
+// are both stored as "premier-league".
+func normalizeTag(tag string) (string, error) {
+	var b strings.Builder
+	pendingHyphen := false
+
+	for _, r := range strings.ToLower(strings.TrimSpace(tag)) {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9':
+			if pendingHyphen && b.Len() > 0 {
+				b.WriteByte('-')
+			}
+			pendingHyphen = false
+			b.WriteRune(r)
+		case r == ' ', r == '_', r == '-':
+			pendingHyphen = true
+		default:
+			return "", ErrTagInvalid
+		}
+	}
+
+	if b.Len() == 0 || b.Len() > tagMaxLength {
+		return "", ErrTagInvalid
+	}
+
+	return b.String(), nil
+}
+
+// normalizeTags normalizes every tag in tags and drops duplicates, returning
+// the result sorted by name.
+func normalizeTags(tags []string) ([]string, error) {
+	seen := make(map[string]bool)
+	result := make([]string, 0, len(tags))
+
+	for _, tag := range tags {
+		name, err := normalizeTag(tag)
+		if err != nil {
+			return nil, err
+		}
+		if !seen[name] {
+			seen[name] = true
+			result = append(result, name)
+		}
+	}
+
+	sort.Strings(result)
+	return result, nil
+}
+
+// TagList returns the tags carried by public oinks with their usage counts,
+// most used first.
+func (t *TagRepository) TagList(ctx context.Context) (*[]Tag, error) {
+	service := services.New(t.DB, t.l)
+
+	tags, err := service.TagService.List(ctx)
+	if err != nil {
+		t.l.Error().Err(err).Msg("repository-TagList-List")
+		return nil, err
+	}
+
+	result := make([]Tag, 0)
+	for _, tag := range *tags {
+		result = append(result, Tag{Name: tag.Name, OinkCount: tag.OinkCount})
+	}
+
+	return &result, nil
+}
@@ -0,0 +1,67 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestNormalizeTag(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+		err  error
+	}{
+		{"football", "football", nil},
+		{"  Premier League ", "premier-league", nil},
+		{"premier_league", "premier-league", nil},
+		{"--premier--league--", "premier-league", nil},
+		{"Top4", "top4", nil},
+		{"", "", ErrTagInvalid},
+		{" - ", "", ErrTagInvalid},
+		{"c#", "", ErrTagInvalid},
+		{strings.Repeat("a", tagMaxLength+1), "", ErrTagInvalid},
+	}
+
+	for _, tt := range tests {
+		got, err := normalizeTag(tt.in)
+		if !errors.Is(err, tt.err) {
+			t.Errorf("normalizeTag(%q): got error %v, want %v", tt.in, err, tt.err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("normalizeTag(%q): got %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestNormalizeTagsDropsDuplicates(t *testing.T) {
+	got, err := normalizeTags([]string{"Premier League", "football", "premier-league"})
+	if err != nil {
+		t.Fatalf("normalizeTags: %v", err)
+	}
+
+	if want := []string{"football", "premier-league"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+}
+
+func TestOinkRepositoryRejectsInvalidTags(t *testing.T) {
+	r, _ := newMockRepository(t)
+
+	_, err := r.OinkRepository.OinkInsert(context.Background(), "chelsea", "", "", "", []string{"c#"}, "user-id")
+	if !errors.Is(err, ErrTagInvalid) {
+		t.Fatalf("got %v, want %v", err, ErrTagInvalid)
+	}
+
+	tooMany := make([]string, 0, tagsMax+1)
+	for i := 0; i <= tagsMax; i++ {
+		tooMany = append(tooMany, strings.Repeat("a", i+1))
+	}
+	_, err = r.OinkRepository.OinkUpdate(context.Background(), "chelsea", nil, nil, nil, nil, &tooMany)
+	if !errors.Is(err, ErrTagsTooMany) {
+		t.Fatalf("got %v, want %v", err, ErrTagsTooMany)
+	}
+}
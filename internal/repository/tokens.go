@@ -2,7 +2,6 @@ package repository
 
 import (
 	"context"
-	"database/sql"
 	"errors"
 	"time"
 
@@ -91,6 +90,9 @@ func (t *TokenRepository) TokenLoginDelete(ctx context.Context, tokenID string,
 
 	err = service.TokenService.TokenDelete(ctx, tokenID)
 	if err != nil {
+		if errors.Is(err, services.ErrTokenNotFound) {
+			return ErrTokenNotFound
+		}
 		t.l.Error().Err(err).Msg("repository-TokenLoginDelete-TokenDelete")
 		return err
 	}
@@ -126,7 +128,7 @@ func (t *TokenRepository) TokenRetrieve(ctx context.Context, tokenID string) (*T
 
 	token, err := service.TokenService.TokenRetrieve(ctx, tokenID)
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
+		if errors.Is(err, services.ErrTokenNotFound) {
 			return nil, ErrTokenNotFound
 		}
 		t.l.Error().Err(err).Msg("repository-TokenRetrieve-TokenRetrieve")
@@ -0,0 +1,59 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestTokenRepositoryTokenRetrieveNotFound(t *testing.T) {
+	t.Run("missing", func(t *testing.T) {
+		r, mock := newMockRepository(t)
+		mock.ExpectQuery(`(?i)from "tokens"`).WillReturnRows(sqlmock.NewRows([]string{"token"}))
+
+		_, err := r.TokenRepository.TokenRetrieve(context.Background(), "token")
+		if !errors.Is(err, ErrTokenNotFound) {
+			t.Fatalf("got %v, want %v", err, ErrTokenNotFound)
+		}
+	})
+
+	t.Run("db-error", func(t *testing.T) {
+		r, mock := newMockRepository(t)
+		mock.ExpectQuery(`(?i)from "tokens"`).WillReturnError(errDB)
+
+		_, err := r.TokenRepository.TokenRetrieve(context.Background(), "token")
+		if !errors.Is(err, errDB) {
+			t.Fatalf("got %v, want %v", err, errDB)
+		}
+		if errors.Is(err, ErrTokenNotFound) {
+			t.Fatalf("database failure reported as %v", ErrTokenNotFound)
+		}
+	})
+}
+
+func TestTokenRepositoryTokenLoginDeleteNotFound(t *testing.T) {
+	t.Run("missing", func(t *testing.T) {
+		r, mock := newMockRepository(t)
+		mock.ExpectQuery(`(?i)from "tokens"`).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+
+		err := r.TokenRepository.TokenLoginDelete(context.Background(), "token", "user")
+		if !errors.Is(err, ErrTokenNotFound) {
+			t.Fatalf("got %v, want %v", err, ErrTokenNotFound)
+		}
+	})
+
+	t.Run("db-error", func(t *testing.T) {
+		r, mock := newMockRepository(t)
+		mock.ExpectQuery(`(?i)from "tokens"`).WillReturnError(errDB)
+
+		err := r.TokenRepository.TokenLoginDelete(context.Background(), "token", "user")
+		if !errors.Is(err, errDB) {
+			t.Fatalf("got %v, want %v", err, errDB)
+		}
+		if errors.Is(err, ErrTokenNotFound) {
+			t.Fatalf("database failure reported as %v", ErrTokenNotFound)
+		}
+	})
+}
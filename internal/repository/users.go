@@ -2,10 +2,10 @@ package repository
 
 import (
 	"context"
-	"database/sql"
 	"errors"
 	"time"
 
+	"github.com/mrityunjaygr8/go-oink/internal/listquery"
 	"github.com/mrityunjaygr8/go-oink/internal/services"
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/hlog"
@@ -17,21 +17,53 @@ var (
 	ErrUserExists       = errors.New("User with this email or username already exists")
 	ErrUserNotFound     = errors.New("User does not exists")
 	ErrUserCredsInvalid = errors.New("Invalid email/password")
+
+	ErrUserDeleteStrategyInvalid = errors.New("Strategy must be one of reassign, ghost or cascade")
+	ErrUserReassignTargetInvalid = errors.New("Oinks can only be reassigned to another existing user")
+	ErrUserGhost                 = errors.New("The ghost account cannot be changed or deleted")
+)
+
+// UserDeleteStrategy decides what happens to the oinks a deleted user created
+// or owns.
+type UserDeleteStrategy string
+
+const (
+	// UserDeleteReassign hands the oinks over to another user.
+	UserDeleteReassign UserDeleteStrategy = "reassign"
+	// UserDeleteGhost hands the oinks over to the ghost account.
+	UserDeleteGhost UserDeleteStrategy = "ghost"
+	// UserDeleteCascade deletes the oinks along with everything in them.
+	UserDeleteCascade UserDeleteStrategy = "cascade"
 )
 
+// UserListSpec describes how user listings may be filtered and sorted.
+var UserListSpec = &services.UserListSpec
+
+// GhostUserID is the system account that takes over the oinks of deleted
+// users.
+const GhostUserID = services.GhostUserID
+
 type UserRepositoryInterface interface {
 	UserCreate(ctx context.Context, email, password, username string) (*User, error)
 	UserUpdatePassword(ctx context.Context, userID string, password string) error
 	UserAuthenticate(ctx context.Context, email, password string) (*User, error)
 	UserRetrieve(ctx context.Context, userID string) (*User, error)
-	UsersList(ctx context.Context) (*[]User, error)
-	UserDelete(ctx context.Context, userID string) error
+	UserRetrieveByEmail(ctx context.Context, email string) (*User, error)
+	UserLock(ctx context.Context, userID string) (*User, error)
+	UsersList(ctx context.Context, q *listquery.Query, limit int) (*[]User, string, error)
+	UsersStream(ctx context.Context, q *listquery.Query, fn func(*User) error) error
+	UsersSearch(ctx context.Context, query string, limit int) (*[]User, error)
+	UserSetAdmin(ctx context.Context, userID string, isAdmin bool) error
+	UserDelete(ctx context.Context, userID string, strategy UserDeleteStrategy, reassignTo string) error
+	UserSetAvatar(ctx context.Context, userID string, avatarKey string) (*User, string, error)
 }
 type User struct {
 	Email     string
 	ID        string
 	Password  string
 	Username  string
+	IsAdmin   bool
+	AvatarKey string
 	CreatedAt time.Time
 	UpdatedAt time.Time
 }
@@ -42,6 +74,8 @@ func serviceToRepositoryUser(user services.User) *User {
 		ID:        user.ID,
 		Password:  user.Password,
 		Username:  user.Username,
+		IsAdmin:   user.IsAdmin,
+		AvatarKey: user.AvatarKey,
 		CreatedAt: user.CreatedAt,
 		UpdatedAt: user.UpdatedAt,
 	}
@@ -61,18 +95,52 @@ type UserRepository struct {
 	l  zerolog.Logger
 }
 
-func (u *UserRepository) UsersList(ctx context.Context) (*[]User, error) {
+// UsersList returns users limit at a time. q narrows them down, orders them
+// and picks the page to return. The cursor of the page after it is returned
+// along with the users, and is empty on the last page.
+func (u *UserRepository) UsersList(ctx context.Context, q *listquery.Query, limit int) (*[]User, string, error) {
 	_, _ = hlog.IDFromCtx(ctx)
 	service := services.New(u.DB, u.l)
 
-	serviceUsers, err := service.UserService.List(ctx)
+	// one user past the page tells whether there is a next page
+	serviceUsers, err := service.UserService.List(ctx, q, limit+1)
 	if err != nil {
-		return nil, err
+		return nil, "", err
+	}
+
+	next := ""
+	page := *serviceUsers
+	if len(page) > limit {
+		page = page[:limit]
+		last := page[limit-1]
+		next = q.Next(last, last.ID)
 	}
 
-	users := serviceToRepositoryUsers(*serviceUsers)
+	users := serviceToRepositoryUsers(page)
 
-	return users, nil
+	return users, next, nil
+}
+
+// UsersStream hands every user UsersList would return, from the cursor of q
+// on, to fn one at a time, reading them off the database as it goes.
+func (u *UserRepository) UsersStream(ctx context.Context, q *listquery.Query, fn func(*User) error) error {
+	service := services.New(u.DB, u.l)
+
+	return service.UserService.Stream(ctx, q, func(user *services.User) error {
+		return fn(serviceToRepositoryUser(*user))
+	})
+}
+
+func (u *UserRepository) UsersSearch(ctx context.Context, query string, limit int) (*[]User, error) {
+	service := services.New(u.DB, u.l)
+
+	serviceUsers, err := service.UserService.Search(ctx, query, limit)
+	if err != nil {
+		u.l.Error().Err(err).Msg("repository-user-UsersSearch-Search")
+		return nil, err
+	}
+
+	return serviceToRepositoryUsers(*serviceUsers), nil
 }
 
 func (u *UserRepository) UserCreate(ctx context.Context, email, password, username string) (*User, error) {
@@ -116,6 +184,10 @@ func (u *UserRepository) UserCreate(ctx context.Context, email, password, userna
 }
 
 func (u *UserRepository) UserUpdatePassword(ctx context.Context, userID string, password string) error {
+	if userID == GhostUserID {
+		return ErrUserGhost
+	}
+
 	service := services.New(u.DB, u.l)
 	exists, err := service.UserService.ExistsByID(ctx, userID)
 	if err != nil {
@@ -135,6 +207,9 @@ func (u *UserRepository) UserUpdatePassword(ctx context.Context, userID string,
 
 	err = service.UserService.UpdatePassword(ctx, userID, newPassword)
 	if err != nil {
+		if errors.Is(err, services.ErrUserNotFound) {
+			return ErrUserNotFound
+		}
 		u.l.Error().Err(err).Msg("repository-user-UserUpdatePassword-UpdatePassword")
 		return err
 	}
@@ -142,6 +217,25 @@ func (u *UserRepository) UserUpdatePassword(ctx context.Context, userID string,
 	return nil
 }
 
+func (u *UserRepository) UserSetAdmin(ctx context.Context, userID string, isAdmin bool) error {
+	if userID == GhostUserID {
+		return ErrUserGhost
+	}
+
+	service := services.New(u.DB, u.l)
+
+	err := service.UserService.UpdateAdmin(ctx, userID, isAdmin)
+	if err != nil {
+		if errors.Is(err, services.ErrUserNotFound) {
+			return ErrUserNotFound
+		}
+		u.l.Error().Err(err).Msg("repository-user-UserSetAdmin-UpdateAdmin")
+		return err
+	}
+
+	return nil
+}
+
 func getPasswordHash(raw string) (string, error) {
 	hashed, err := bcrypt.GenerateFromPassword([]byte(raw), bcrypt.DefaultCost)
 	if err != nil {
@@ -178,7 +272,7 @@ func (u *UserRepository) UserRetrieve(ctx context.Context, userID string) (*User
 	service := services.New(u.DB, u.l)
 	user, err := service.UserService.GetByID(ctx, userID)
 	if err != nil {
-		if err != sql.ErrNoRows {
+		if errors.Is(err, services.ErrUserNotFound) {
 			return nil, ErrUserNotFound
 		}
 		u.l.Error().Err(err).Msg("repository-user-UserRetrieve-GetByID")
@@ -188,22 +282,199 @@ func (u *UserRepository) UserRetrieve(ctx context.Context, userID string) (*User
 	return serviceToRepositoryUser(*user), nil
 }
 
-func (u *UserRepository) UserDelete(ctx context.Context, userID string) error {
+func (u *UserRepository) UserRetrieveByEmail(ctx context.Context, email string) (*User, error) {
+	service := services.New(u.DB, u.l)
+	user, err := service.UserService.GetByEmail(ctx, email)
+	if err != nil {
+		if errors.Is(err, services.ErrUserNotFound) {
+			return nil, ErrUserNotFound
+		}
+		u.l.Error().Err(err).Msg("repository-user-UserRetrieveByEmail-GetByEmail")
+		return nil, err
+	}
+
+	return serviceToRepositoryUser(*user), nil
+}
+
+// UserLock is UserRetrieve, but keeps other transactions from changing the
+// user until the one the repository runs in ends.
+func (u *UserRepository) UserLock(ctx context.Context, userID string) (*User, error) {
+	service := services.New(u.DB, u.l)
+	user, err := service.UserService.GetByIDForUpdate(ctx, userID)
+	if err != nil {
+		if errors.Is(err, services.ErrUserNotFound) {
+			return nil, ErrUserNotFound
+		}
+		u.l.Error().Err(err).Msg("repository-user-UserLock-GetByIDForUpdate")
+		return nil, err
+	}
+
+	return serviceToRepositoryUser(*user), nil
+}
+
+// UserDelete deletes userID, first settling the oinks they created or own
+// according to strategy. reassignTo names the new owner for UserDeleteReassign
+// and is ignored otherwise. The posts and invitations the user wrote in any
+// oink are deleted with UserDeleteCascade and credited to the ghost account
+// otherwise, and their pending transfers are withdrawn.
+func (u *UserRepository) UserDelete(ctx context.Context, userID string, strategy UserDeleteStrategy, reassignTo string) error {
 	service := services.New(u.DB, u.l)
+
+	switch strategy {
+	case UserDeleteReassign:
+		if reassignTo == "" || reassignTo == userID || reassignTo == GhostUserID {
+			return ErrUserReassignTargetInvalid
+		}
+	case UserDeleteGhost:
+		reassignTo = GhostUserID
+	case UserDeleteCascade:
+		reassignTo = ""
+	default:
+		return ErrUserDeleteStrategyInvalid
+	}
+
+	if userID == GhostUserID {
+		return ErrUserGhost
+	}
+
 	_, err := service.UserService.GetByID(ctx, userID)
 	if err != nil {
-		if err != sql.ErrNoRows {
+		if errors.Is(err, services.ErrUserNotFound) {
 			return ErrUserNotFound
 		}
-		u.l.Error().Err(err).Msg("repository-user-UserRetrieve-GetByID")
+		u.l.Error().Err(err).Msg("repository-user-UserDelete-GetByID")
+		return err
+	}
+
+	if strategy == UserDeleteReassign {
+		_, err = service.UserService.GetByID(ctx, reassignTo)
+		if err != nil {
+			if errors.Is(err, services.ErrUserNotFound) {
+				return ErrUserReassignTargetInvalid
+			}
+			u.l.Error().Err(err).Msg("repository-user-UserDelete-GetByIDTarget")
+			return err
+		}
+	}
+
+	err = service.OinkTransferService.DeleteByUser(ctx, userID)
+	if err != nil {
+		u.l.Error().Err(err).Msg("repository-user-UserDelete-OinkTransferDeleteByUser")
+		return err
+	}
+
+	oinks, err := service.OinkService.ListOwned(ctx, userID)
+	if err != nil {
+		u.l.Error().Err(err).Msg("repository-user-UserDelete-ListOwned")
+		return err
+	}
+
+	for _, oink := range *oinks {
+		if reassignTo == "" {
+			err = service.OinkService.Delete(ctx, oink.Name)
+			if err != nil {
+				u.l.Error().Err(err).Msg("repository-user-UserDelete-OinkDelete")
+				return err
+			}
+			continue
+		}
+
+		err = reassignOink(ctx, service, oink, userID, reassignTo)
+		if err != nil {
+			u.l.Error().Err(err).Msg("repository-user-UserDelete-reassignOink")
+			return err
+		}
+	}
+
+	if strategy == UserDeleteCascade {
+		err = service.PostService.DeleteByAuthor(ctx, userID)
+		if err == nil {
+			err = service.OinkInvitationService.DeleteByCreatedBy(ctx, userID)
+		}
+	} else {
+		err = service.PostService.UpdateAuthor(ctx, userID, GhostUserID)
+		if err == nil {
+			err = service.OinkInvitationService.UpdateCreatedBy(ctx, userID, GhostUserID)
+		}
+	}
+	if err != nil {
+		u.l.Error().Err(err).Msg("repository-user-UserDelete-authored")
 		return err
 	}
 
 	err = service.UserService.Delete(ctx, userID)
 	if err != nil {
+		if errors.Is(err, services.ErrUserNotFound) {
+			return ErrUserNotFound
+		}
 		u.l.Error().Err(err).Msg("repository-user-UserDelete-Delete")
 		return err
 	}
 
 	return nil
 }
+
+// reassignOink hands what fromUserID holds of oink, its creation and its
+// ownership, over to toUserID.
+func reassignOink(ctx context.Context, service *services.Services, oink services.Oink, fromUserID string, toUserID string) error {
+	if oink.Creator == fromUserID {
+		err := service.OinkService.UpdateCreator(ctx, &oink, toUserID)
+		if err != nil {
+			return err
+		}
+	}
+
+	owner, err := service.OinkMemberService.RetrieveOwner(ctx, oink.ID)
+	if err != nil && !errors.Is(err, services.ErrOinkMemberNotFound) {
+		return err
+	}
+
+	if owner != nil && owner.UserID != fromUserID {
+		return nil
+	}
+
+	exists, err := service.OinkMemberService.Exists(ctx, oink.ID, toUserID)
+	if err != nil {
+		return err
+	}
+
+	if exists {
+		return service.OinkMemberService.UpdateRole(ctx, oink.ID, toUserID, OinkRoleOwner)
+	}
+
+	return service.OinkMemberService.Insert(ctx, &services.OinkMember{
+		OinkID: oink.ID,
+		UserID: toUserID,
+		Role:   OinkRoleOwner,
+	})
+}
+
+// UserSetAvatar records avatarKey as the blob key of the avatar of userID and
+// returns the key it replaced. An empty avatarKey removes the avatar.
+func (u *UserRepository) UserSetAvatar(ctx context.Context, userID string, avatarKey string) (*User, string, error) {
+	if userID == GhostUserID {
+		return nil, "", ErrUserGhost
+	}
+
+	service := services.New(u.DB, u.l)
+
+	user, err := service.UserService.GetByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, services.ErrUserNotFound) {
+			return nil, "", ErrUserNotFound
+		}
+		u.l.Error().Err(err).Msg("repository-user-UserSetAvatar-GetByID")
+		return nil, "", err
+	}
+
+	previous := user.AvatarKey
+
+	err = service.UserService.UpdateAvatar(ctx, userID, avatarKey)
+	if err != nil {
+		u.l.Error().Err(err).Msg("repository-user-UserSetAvatar-UpdateAvatar")
+		return nil, "", err
+	}
+	user.AvatarKey = avatarKey
+
+	return serviceToRepositoryUser(*user), previous, nil
+}
@@ -0,0 +1,219 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestUserRepositoryLookupNotFound(t *testing.T) {
+	tests := []struct {
+		name   string
+		lookup func(context.Context, *Repository) error
+	}{
+		{"UserRetrieve", func(ctx context.Context, r *Repository) error {
+			_, err := r.UserRepository.UserRetrieve(ctx, "id")
+			return err
+		}},
+		{"UserRetrieveByEmail", func(ctx context.Context, r *Repository) error {
+			_, err := r.UserRepository.UserRetrieveByEmail(ctx, "im@oink.in")
+			return err
+		}},
+		{"UserDelete", func(ctx context.Context, r *Repository) error {
+			return r.UserRepository.UserDelete(ctx, "id", UserDeleteGhost, "")
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name+"/missing", func(t *testing.T) {
+			r, mock := newMockRepository(t)
+			mock.ExpectQuery(`(?i)from "users"`).WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+			err := tt.lookup(context.Background(), r)
+			if !errors.Is(err, ErrUserNotFound) {
+				t.Fatalf("got %v, want %v", err, ErrUserNotFound)
+			}
+		})
+
+		t.Run(tt.name+"/db-error", func(t *testing.T) {
+			r, mock := newMockRepository(t)
+			mock.ExpectQuery(`(?i)from "users"`).WillReturnError(errDB)
+
+			err := tt.lookup(context.Background(), r)
+			if !errors.Is(err, errDB) {
+				t.Fatalf("got %v, want %v", err, errDB)
+			}
+			if errors.Is(err, ErrUserNotFound) {
+				t.Fatalf("database failure reported as %v", ErrUserNotFound)
+			}
+		})
+	}
+}
+
+func TestUserRepositoryUserAuthenticateUnknownEmail(t *testing.T) {
+	t.Run("missing", func(t *testing.T) {
+		r, mock := newMockRepository(t)
+		mock.ExpectQuery(`(?i)from "users"`).WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+		_, err := r.UserRepository.UserAuthenticate(context.Background(), "im@oink.in", "password")
+		if !errors.Is(err, ErrUserCredsInvalid) {
+			t.Fatalf("got %v, want %v", err, ErrUserCredsInvalid)
+		}
+	})
+
+	t.Run("db-error", func(t *testing.T) {
+		r, mock := newMockRepository(t)
+		mock.ExpectQuery(`(?i)from "users"`).WillReturnError(errDB)
+
+		_, err := r.UserRepository.UserAuthenticate(context.Background(), "im@oink.in", "password")
+		if !errors.Is(err, errDB) {
+			t.Fatalf("got %v, want %v", err, errDB)
+		}
+	})
+}
+
+func TestUserRepositoryUserUpdatePasswordNotFound(t *testing.T) {
+	t.Run("missing", func(t *testing.T) {
+		r, mock := newMockRepository(t)
+		mock.ExpectQuery(`(?i)from "users"`).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+
+		err := r.UserRepository.UserUpdatePassword(context.Background(), "id", "password")
+		if !errors.Is(err, ErrUserNotFound) {
+			t.Fatalf("got %v, want %v", err, ErrUserNotFound)
+		}
+	})
+
+	t.Run("db-error", func(t *testing.T) {
+		r, mock := newMockRepository(t)
+		mock.ExpectQuery(`(?i)from "users"`).WillReturnError(errDB)
+
+		err := r.UserRepository.UserUpdatePassword(context.Background(), "id", "password")
+		if !errors.Is(err, errDB) {
+			t.Fatalf("got %v, want %v", err, errDB)
+		}
+		if errors.Is(err, ErrUserNotFound) {
+			t.Fatalf("database failure reported as %v", ErrUserNotFound)
+		}
+	})
+}
+
+func TestUserRepositoryUserDeleteStrategy(t *testing.T) {
+	tests := []struct {
+		name       string
+		userID     string
+		strategy   UserDeleteStrategy
+		reassignTo string
+		want       error
+	}{
+		{"unknown", "id", UserDeleteStrategy("orphan"), "", ErrUserDeleteStrategyInvalid},
+		{"reassign-missing-target", "id", UserDeleteReassign, "", ErrUserReassignTargetInvalid},
+		{"reassign-to-self", "id", UserDeleteReassign, "id", ErrUserReassignTargetInvalid},
+		{"reassign-to-ghost", "id", UserDeleteReassign, GhostUserID, ErrUserReassignTargetInvalid},
+		{"ghost", GhostUserID, UserDeleteCascade, "", ErrUserGhost},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, _ := newMockRepository(t)
+
+			err := r.UserRepository.UserDelete(context.Background(), tt.userID, tt.strategy, tt.reassignTo)
+			if !errors.Is(err, tt.want) {
+				t.Fatalf("got %v, want %v", err, tt.want)
+			}
+		})
+	}
+
+	t.Run("reassign-unknown-target", func(t *testing.T) {
+		r, mock := newMockRepository(t)
+		mock.ExpectQuery(`(?i)from "users"`).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("id"))
+		mock.ExpectQuery(`(?i)from "users"`).WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+		err := r.UserRepository.UserDelete(context.Background(), "id", UserDeleteReassign, "other-id")
+		if !errors.Is(err, ErrUserReassignTargetInvalid) {
+			t.Fatalf("got %v, want %v", err, ErrUserReassignTargetInvalid)
+		}
+	})
+}
+
+func TestUsersListPagination(t *testing.T) {
+	created := time.Date(2023, 5, 1, 12, 0, 0, 0, time.UTC)
+	const (
+		alice = "0b0e7d6a-1f52-4a3e-9c61-2f8d3b7a4c01"
+		bob   = "0b0e7d6a-1f52-4a3e-9c61-2f8d3b7a4c02"
+		carol = "0b0e7d6a-1f52-4a3e-9c61-2f8d3b7a4c03"
+	)
+
+	r, mock := newMockRepository(t)
+	mock.ExpectQuery(`(?i)from "users" where \("users"."id" != \$1\) and \("users"."username" ILIKE \$2\) order by "users"."username" asc, "users"."id" asc limit 3`).
+		WithArgs(GhostUserID, `b\_%`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "username", "created_at"}).
+			AddRow(alice, "b_alice", created).
+			AddRow(bob, "b_bob", created).
+			AddRow(carol, "b_carol", created))
+
+	q, err := UserListSpec.Parse(url.Values{"username_prefix": {"b_"}, "sort": {"username"}})
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+
+	users, next, err := r.UserRepository.UsersList(context.Background(), q, 2)
+	if err != nil {
+		t.Fatalf("UsersList: %v", err)
+	}
+	if len(*users) != 2 || (*users)[1].ID != bob {
+		t.Fatalf("got %+v, want the first two users", *users)
+	}
+	if next == "" {
+		t.Fatal("no cursor for the next page")
+	}
+
+	mock.ExpectQuery(`(?i)where \("users"."id" != \$1\) and \("users"."username" ILIKE \$2\) and \(\("users"."username", "users"."id"\) > \(\$3, \$4\)\)`).
+		WithArgs(GhostUserID, `b\_%`, "b_bob", bob).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "username", "created_at"}).AddRow(carol, "b_carol", created))
+
+	q, err = UserListSpec.Parse(url.Values{"username_prefix": {"b_"}, "sort": {"username"}, "cursor": {next}})
+	if err != nil {
+		t.Fatalf("Parse of the next cursor: %v", err)
+	}
+
+	users, next, err = r.UserRepository.UsersList(context.Background(), q, 2)
+	if err != nil {
+		t.Fatalf("UsersList: %v", err)
+	}
+	if len(*users) != 1 || next != "" {
+		t.Fatalf("got %d users and cursor %q on the last page", len(*users), next)
+	}
+}
+
+func TestUserRepositoryGhostIsReadOnly(t *testing.T) {
+	tests := []struct {
+		name   string
+		mutate func(context.Context, *Repository) error
+	}{
+		{"UserUpdatePassword", func(ctx context.Context, r *Repository) error {
+			return r.UserRepository.UserUpdatePassword(ctx, GhostUserID, "password")
+		}},
+		{"UserSetAdmin", func(ctx context.Context, r *Repository) error {
+			return r.UserRepository.UserSetAdmin(ctx, GhostUserID, true)
+		}},
+		{"UserSetAvatar", func(ctx context.Context, r *Repository) error {
+			_, _, err := r.UserRepository.UserSetAvatar(ctx, GhostUserID, "users/ghost/avatar.png")
+			return err
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, _ := newMockRepository(t)
+
+			err := tt.mutate(context.Background(), r)
+			if !errors.Is(err, ErrUserGhost) {
+				t.Fatalf("got %v, want %v", err, ErrUserGhost)
+			}
+		})
+	}
+}
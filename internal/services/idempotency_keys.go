@@ -0,0 +1,175 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"time"
+
+	dbmodels "github.com/mrityunjaygr8/go-oink/internal/db/models"
+	"github.com/rs/zerolog"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/types"
+)
+
+var ErrIdempotencyKeyNotFound = errors.New("Idempotency Key Not Found")
+
+type IdempotencyKeyServiceInterface interface {
+	Reserve(ctx context.Context, key *IdempotencyKey) (bool, error)
+	Retrieve(ctx context.Context, userID string, key string) (*IdempotencyKey, error)
+	Complete(ctx context.Context, key *IdempotencyKey) error
+	Delete(ctx context.Context, userID string, key string) error
+	DeleteExpired(ctx context.Context, userID string) error
+}
+
+type IdempotencyKeyService struct {
+	DB boil.ContextExecutor
+	l  zerolog.Logger
+}
+
+// IdempotencyKey records the response to a request made with an
+// Idempotency-Key header. Status is zero while the request is in progress,
+// which it is held to be until LockedUntil. ContentType is only set on keys
+// stored before their responses' headers were.
+type IdempotencyKey struct {
+	UserID      string
+	Key         string
+	Fingerprint string
+	Status      int
+	ContentType string
+	Headers     map[string][]string
+	Body        []byte
+	CreatedAt   time.Time
+	ExpiresAt   time.Time
+	LockedUntil time.Time
+}
+
+func dbToServiceIdempotencyKey(dbKey *dbmodels.IdempotencyKey) (*IdempotencyKey, error) {
+	var headers map[string][]string
+	if err := dbKey.Headers.Unmarshal(&headers); err != nil {
+		return nil, err
+	}
+
+	return &IdempotencyKey{
+		UserID:      dbKey.User,
+		Key:         dbKey.Key,
+		Fingerprint: dbKey.Fingerprint,
+		Status:      dbKey.Status,
+		ContentType: dbKey.ContentType,
+		Headers:     headers,
+		Body:        dbKey.Body,
+		CreatedAt:   dbKey.CreatedAt,
+		ExpiresAt:   dbKey.ExpiresAt,
+		LockedUntil: dbKey.LockedUntil,
+	}, nil
+}
+
+const reserveIdempotencyKeyQuery = `
+insert into "idempotency_keys" ("user", "key", "fingerprint", "created_at", "expires_at", "locked_until")
+values ($1, $2, $3, now(), $4, $5)
+on conflict ("user", "key") do update
+set "fingerprint" = excluded."fingerprint", "created_at" = excluded."created_at",
+	"expires_at" = excluded."expires_at", "locked_until" = excluded."locked_until"
+where "idempotency_keys"."status" = 0 and "idempotency_keys"."locked_until" < now()`
+
+// Reserve records key as in progress until key.LockedUntil. It reports false,
+// and changes nothing, when the user already used the key, unless the request
+// that used it never answered and its lock ran out, in which case the key is
+// taken over.
+func (i *IdempotencyKeyService) Reserve(ctx context.Context, key *IdempotencyKey) (bool, error) {
+	result, err := queries.Raw(reserveIdempotencyKeyQuery, key.UserID, key.Key, key.Fingerprint, key.ExpiresAt, key.LockedUntil).ExecContext(ctx, i.DB)
+	if err != nil {
+		i.l.Error().Err(err).Msg("service-idempotencyKey-Reserve")
+		return false, err
+	}
+
+	inserted, err := result.RowsAffected()
+	if err != nil {
+		i.l.Error().Err(err).Msg("service-idempotencyKey-Reserve-RowsAffected")
+		return false, err
+	}
+
+	return inserted == 1, nil
+}
+
+func (i *IdempotencyKeyService) Retrieve(ctx context.Context, userID string, key string) (*IdempotencyKey, error) {
+	dbKey, err := dbmodels.FindIdempotencyKey(ctx, i.DB, userID, key)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrIdempotencyKeyNotFound
+		}
+		i.l.Error().Err(err).Msg("service-idempotencyKey-Retrieve")
+		return nil, err
+	}
+
+	stored, err := dbToServiceIdempotencyKey(dbKey)
+	if err != nil {
+		i.l.Error().Err(err).Msg("service-idempotencyKey-Retrieve-headers")
+		return nil, err
+	}
+
+	return stored, nil
+}
+
+// Complete stores the response to the request made with key.
+func (i *IdempotencyKeyService) Complete(ctx context.Context, key *IdempotencyKey) error {
+	dbKey, err := dbmodels.FindIdempotencyKey(ctx, i.DB, key.UserID, key.Key)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrIdempotencyKeyNotFound
+		}
+		i.l.Error().Err(err).Msg("service-idempotencyKey-Complete-find")
+		return err
+	}
+
+	headers, err := json.Marshal(key.Headers)
+	if err != nil {
+		i.l.Error().Err(err).Msg("service-idempotencyKey-Complete-headers")
+		return err
+	}
+
+	dbKey.Status = key.Status
+	dbKey.Headers = types.JSON(headers)
+	dbKey.Body = key.Body
+
+	_, err = dbKey.Update(ctx, i.DB, boil.Whitelist(
+		dbmodels.IdempotencyKeyColumns.Status,
+		dbmodels.IdempotencyKeyColumns.Headers,
+		dbmodels.IdempotencyKeyColumns.Body,
+	))
+	if err != nil {
+		i.l.Error().Err(err).Msg("service-idempotencyKey-Complete-update")
+		return err
+	}
+
+	return nil
+}
+
+func (i *IdempotencyKeyService) Delete(ctx context.Context, userID string, key string) error {
+	_, err := dbmodels.IdempotencyKeys(
+		dbmodels.IdempotencyKeyWhere.User.EQ(userID),
+		dbmodels.IdempotencyKeyWhere.Key.EQ(key),
+	).DeleteAll(ctx, i.DB)
+	if err != nil {
+		i.l.Error().Err(err).Msg("service-idempotencyKey-Delete")
+		return err
+	}
+
+	return nil
+}
+
+// DeleteExpired removes the keys of userID that outlived their TTL.
+func (i *IdempotencyKeyService) DeleteExpired(ctx context.Context, userID string) error {
+	_, err := dbmodels.IdempotencyKeys(
+		dbmodels.IdempotencyKeyWhere.User.EQ(userID),
+		dbmodels.IdempotencyKeyWhere.ExpiresAt.LT(time.Now()),
+	).DeleteAll(ctx, i.DB)
+	if err != nil {
+		i.l.Error().Err(err).Msg("service-idempotencyKey-DeleteExpired")
+		return err
+	}
+
+	return nil
+}
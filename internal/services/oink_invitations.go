@@ -0,0 +1,156 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	dbmodels "github.com/mrityunjaygr8/go-oink/internal/db/models"
+	"github.com/rs/zerolog"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+var ErrOinkInvitationNotFound = errors.New("Oink Invitation Not Found")
+
+// The kinds of pending admissions to a private oink: an invitation waits for
+// the invited user, a request waits for the oink's owner or moderators.
+const (
+	OinkInvitationKindInvitation = "invitation"
+	OinkInvitationKindRequest    = "request"
+)
+
+type OinkInvitationServiceInterface interface {
+	Retrieve(ctx context.Context, oinkID string, userID string) (*OinkInvitation, error)
+	List(ctx context.Context, oinkID string) (*[]OinkInvitation, error)
+	Upsert(ctx context.Context, invitation *OinkInvitation) error
+	Delete(ctx context.Context, oinkID string, userID string) error
+	UpdateCreatedBy(ctx context.Context, fromUserID string, toUserID string) error
+	DeleteByCreatedBy(ctx context.Context, userID string) error
+}
+
+type OinkInvitationService struct {
+	DB boil.ContextExecutor
+	l  zerolog.Logger
+}
+
+type OinkInvitation struct {
+	OinkID    string
+	UserID    string
+	Username  string
+	Kind      string
+	CreatedBy string
+	CreatedAt time.Time
+}
+
+func dbToServiceOinkInvitation(dbInvitation dbmodels.OinkInvitation) *OinkInvitation {
+	invitation := &OinkInvitation{
+		OinkID:    dbInvitation.Oink,
+		UserID:    dbInvitation.User,
+		Kind:      dbInvitation.Kind,
+		CreatedBy: dbInvitation.CreatedBy,
+		CreatedAt: dbInvitation.CreatedAt,
+	}
+	if dbInvitation.R != nil && dbInvitation.R.OinkInvitationUser != nil {
+		invitation.Username = dbInvitation.R.OinkInvitationUser.Username
+	}
+
+	return invitation
+}
+
+func (i *OinkInvitationService) Retrieve(ctx context.Context, oinkID string, userID string) (*OinkInvitation, error) {
+	invitation, err := dbmodels.FindOinkInvitation(ctx, i.DB, oinkID, userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrOinkInvitationNotFound
+		}
+		i.l.Error().Err(err).Msg("service-OinkInvitationService-Retrieve")
+		return nil, err
+	}
+
+	return dbToServiceOinkInvitation(*invitation), nil
+}
+
+func (i *OinkInvitationService) List(ctx context.Context, oinkID string) (*[]OinkInvitation, error) {
+	invitationSlice, err := dbmodels.OinkInvitations(
+		qm.Load(dbmodels.OinkInvitationRels.OinkInvitationUser),
+		dbmodels.OinkInvitationWhere.Oink.EQ(oinkID),
+		qm.OrderBy(dbmodels.OinkInvitationColumns.CreatedAt),
+	).All(ctx, i.DB)
+	if err != nil {
+		i.l.Error().Err(err).Msg("service-OinkInvitationService-List")
+		return nil, err
+	}
+
+	invitations := make([]OinkInvitation, 0)
+	for _, invitation := range invitationSlice {
+		invitations = append(invitations, *dbToServiceOinkInvitation(*invitation))
+	}
+
+	return &invitations, nil
+}
+
+// Upsert records invitation, replacing whatever was pending for the same
+// user and oink.
+func (i *OinkInvitationService) Upsert(ctx context.Context, invitation *OinkInvitation) error {
+	dbInvitation := dbmodels.OinkInvitation{
+		Oink:      invitation.OinkID,
+		User:      invitation.UserID,
+		Kind:      invitation.Kind,
+		CreatedBy: invitation.CreatedBy,
+		CreatedAt: time.Now(),
+	}
+
+	err := dbInvitation.Upsert(ctx, i.DB, true,
+		[]string{dbmodels.OinkInvitationColumns.Oink, dbmodels.OinkInvitationColumns.User},
+		boil.Whitelist(dbmodels.OinkInvitationColumns.Kind, dbmodels.OinkInvitationColumns.CreatedBy, dbmodels.OinkInvitationColumns.CreatedAt),
+		boil.Infer(),
+	)
+	if err != nil {
+		i.l.Error().Err(err).Msg("service-OinkInvitationService-Upsert")
+		return err
+	}
+
+	invitation.CreatedAt = dbInvitation.CreatedAt
+	return nil
+}
+
+func (i *OinkInvitationService) Delete(ctx context.Context, oinkID string, userID string) error {
+	deleted, err := dbmodels.OinkInvitations(
+		dbmodels.OinkInvitationWhere.Oink.EQ(oinkID),
+		dbmodels.OinkInvitationWhere.User.EQ(userID),
+	).DeleteAll(ctx, i.DB)
+	if err != nil {
+		i.l.Error().Err(err).Msg("service-OinkInvitationService-Delete")
+		return err
+	}
+
+	if deleted == 0 {
+		return ErrOinkInvitationNotFound
+	}
+
+	return nil
+}
+
+// UpdateCreatedBy credits every invitation sent by fromUserID to toUserID.
+func (i *OinkInvitationService) UpdateCreatedBy(ctx context.Context, fromUserID string, toUserID string) error {
+	_, err := dbmodels.OinkInvitations(dbmodels.OinkInvitationWhere.CreatedBy.EQ(fromUserID)).
+		UpdateAll(ctx, i.DB, dbmodels.M{dbmodels.OinkInvitationColumns.CreatedBy: toUserID})
+	if err != nil {
+		i.l.Error().Err(err).Msg("service-OinkInvitationService-UpdateCreatedBy")
+		return err
+	}
+
+	return nil
+}
+
+func (i *OinkInvitationService) DeleteByCreatedBy(ctx context.Context, userID string) error {
+	_, err := dbmodels.OinkInvitations(dbmodels.OinkInvitationWhere.CreatedBy.EQ(userID)).DeleteAll(ctx, i.DB)
+	if err != nil {
+		i.l.Error().Err(err).Msg("service-OinkInvitationService-DeleteByCreatedBy")
+		return err
+	}
+
+	return nil
+}
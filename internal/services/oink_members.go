@@ -0,0 +1,220 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	dbmodels "github.com/mrityunjaygr8/go-oink/internal/db/models"
+	"github.com/rs/zerolog"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+var ErrOinkMemberNotFound = errors.New("Oink Member Not Found")
+
+// The roles a member can hold in an oink. Every oink has exactly one owner.
+const (
+	OinkRoleOwner     = "owner"
+	OinkRoleModerator = "moderator"
+	OinkRoleMember    = "member"
+)
+
+type OinkMemberServiceInterface interface {
+	Exists(ctx context.Context, oinkID string, userID string) (bool, error)
+	Retrieve(ctx context.Context, oinkID string, userID string) (*OinkMember, error)
+	RetrieveOwner(ctx context.Context, oinkID string) (*OinkMember, error)
+	UpdateRole(ctx context.Context, oinkID string, userID string, role string) error
+	Insert(ctx context.Context, member *OinkMember) error
+	Delete(ctx context.Context, oinkID string, userID string) error
+	List(ctx context.Context, oinkID string, limit int, offset int) (*[]OinkMember, error)
+	Count(ctx context.Context, oinkID string) (int64, error)
+	Counts(ctx context.Context, oinkIDs []string) (map[string]int64, error)
+}
+
+type OinkMemberService struct {
+	DB boil.ContextExecutor
+	l  zerolog.Logger
+}
+
+type OinkMember struct {
+	OinkID    string
+	UserID    string
+	Username  string
+	Role      string
+	CreatedAt time.Time
+}
+
+func dbToServiceOinkMember(dbMember dbmodels.OinkMember) *OinkMember {
+	member := &OinkMember{
+		OinkID:    dbMember.Oink,
+		UserID:    dbMember.User,
+		Role:      dbMember.Role,
+		CreatedAt: dbMember.CreatedAt,
+	}
+	if dbMember.R != nil && dbMember.R.OinkMemberUser != nil {
+		member.Username = dbMember.R.OinkMemberUser.Username
+	}
+
+	return member
+}
+
+func dbToServiceOinkMembers(dbMembers dbmodels.OinkMemberSlice) *[]OinkMember {
+	members := make([]OinkMember, 0)
+
+	for _, m := range dbMembers {
+		members = append(members, *dbToServiceOinkMember(*m))
+	}
+
+	return &members
+}
+
+func (m *OinkMemberService) Exists(ctx context.Context, oinkID string, userID string) (bool, error) {
+	exists, err := dbmodels.OinkMemberExists(ctx, m.DB, oinkID, userID)
+	if err != nil {
+		m.l.Error().Err(err).Msg("service-OinkMemberService-Exists")
+		return false, err
+	}
+
+	return exists, nil
+}
+
+func (m *OinkMemberService) Insert(ctx context.Context, member *OinkMember) error {
+	dbMember := dbmodels.OinkMember{}
+	dbMember.Oink = member.OinkID
+	dbMember.User = member.UserID
+	dbMember.Role = member.Role
+
+	err := dbMember.Insert(ctx, m.DB, boil.Infer())
+	if err != nil {
+		m.l.Error().Err(err).Msg("service-OinkMemberService-Insert")
+		return err
+	}
+
+	member.Role = dbMember.Role
+	member.CreatedAt = dbMember.CreatedAt
+	return nil
+}
+
+func (m *OinkMemberService) Retrieve(ctx context.Context, oinkID string, userID string) (*OinkMember, error) {
+	member, err := dbmodels.OinkMembers(
+		qm.Load(dbmodels.OinkMemberRels.OinkMemberUser),
+		dbmodels.OinkMemberWhere.Oink.EQ(oinkID),
+		dbmodels.OinkMemberWhere.User.EQ(userID),
+	).One(ctx, m.DB)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrOinkMemberNotFound
+		}
+		m.l.Error().Err(err).Msg("service-OinkMemberService-Retrieve")
+		return nil, err
+	}
+
+	return dbToServiceOinkMember(*member), nil
+}
+
+// RetrieveOwner returns the member holding the owner role of oinkID.
+func (m *OinkMemberService) RetrieveOwner(ctx context.Context, oinkID string) (*OinkMember, error) {
+	member, err := dbmodels.OinkMembers(
+		qm.Load(dbmodels.OinkMemberRels.OinkMemberUser),
+		dbmodels.OinkMemberWhere.Oink.EQ(oinkID),
+		dbmodels.OinkMemberWhere.Role.EQ(OinkRoleOwner),
+	).One(ctx, m.DB)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrOinkMemberNotFound
+		}
+		m.l.Error().Err(err).Msg("service-OinkMemberService-RetrieveOwner")
+		return nil, err
+	}
+
+	return dbToServiceOinkMember(*member), nil
+}
+
+func (m *OinkMemberService) UpdateRole(ctx context.Context, oinkID string, userID string, role string) error {
+	updated, err := dbmodels.OinkMembers(
+		dbmodels.OinkMemberWhere.Oink.EQ(oinkID),
+		dbmodels.OinkMemberWhere.User.EQ(userID),
+	).UpdateAll(ctx, m.DB, dbmodels.M{dbmodels.OinkMemberColumns.Role: role})
+	if err != nil {
+		m.l.Error().Err(err).Msg("service-OinkMemberService-UpdateRole")
+		return err
+	}
+
+	if updated == 0 {
+		return ErrOinkMemberNotFound
+	}
+
+	return nil
+}
+
+func (m *OinkMemberService) Delete(ctx context.Context, oinkID string, userID string) error {
+	deleted, err := dbmodels.OinkMembers(dbmodels.OinkMemberWhere.Oink.EQ(oinkID), dbmodels.OinkMemberWhere.User.EQ(userID)).DeleteAll(ctx, m.DB)
+	if err != nil {
+		m.l.Error().Err(err).Msg("service-OinkMemberService-Delete")
+		return err
+	}
+
+	if deleted == 0 {
+		return ErrOinkMemberNotFound
+	}
+
+	return nil
+}
+
+func (m *OinkMemberService) List(ctx context.Context, oinkID string, limit int, offset int) (*[]OinkMember, error) {
+	memberSlice, err := dbmodels.OinkMembers(
+		qm.Load(dbmodels.OinkMemberRels.OinkMemberUser),
+		dbmodels.OinkMemberWhere.Oink.EQ(oinkID),
+		qm.OrderBy(dbmodels.OinkMemberColumns.CreatedAt+", "+dbmodels.OinkMemberColumns.User),
+		qm.Limit(limit),
+		qm.Offset(offset),
+	).All(ctx, m.DB)
+	if err != nil {
+		m.l.Error().Err(err).Msg("service-OinkMemberService-List")
+		return nil, err
+	}
+
+	return dbToServiceOinkMembers(memberSlice), nil
+}
+
+func (m *OinkMemberService) Count(ctx context.Context, oinkID string) (int64, error) {
+	count, err := dbmodels.OinkMembers(dbmodels.OinkMemberWhere.Oink.EQ(oinkID)).Count(ctx, m.DB)
+	if err != nil {
+		m.l.Error().Err(err).Msg("service-OinkMemberService-Count")
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// Counts returns the number of members of every oink in oinkIDs. Oinks
+// without members are absent from the result.
+func (m *OinkMemberService) Counts(ctx context.Context, oinkIDs []string) (map[string]int64, error) {
+	counts := make(map[string]int64)
+	if len(oinkIDs) == 0 {
+		return counts, nil
+	}
+
+	var rows []struct {
+		Oink  string `boil:"oink"`
+		Count int64  `boil:"count"`
+	}
+
+	err := dbmodels.OinkMembers(
+		qm.Select(dbmodels.OinkMemberColumns.Oink, "count(*) as count"),
+		dbmodels.OinkMemberWhere.Oink.IN(oinkIDs),
+		qm.GroupBy(dbmodels.OinkMemberColumns.Oink),
+	).Bind(ctx, m.DB, &rows)
+	if err != nil {
+		m.l.Error().Err(err).Msg("service-OinkMemberService-Counts")
+		return nil, err
+	}
+
+	for _, row := range rows {
+		counts[row.Oink] = row.Count
+	}
+
+	return counts, nil
+}
@@ -0,0 +1,51 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/volatiletech/sqlboiler/v4/queries"
+)
+
+// OinkActivity counts what happened in an oink on a single (UTC) day.
+type OinkActivity struct {
+	Day        time.Time `boil:"day"`
+	NewMembers int64     `boil:"new_members"`
+	NewPosts   int64     `boil:"new_posts"`
+}
+
+// oinkActivityQuery spreads the members who joined and the posts written in
+// an oink over the last $2 days, today included. Days without activity are
+// filled in by generate_series.
+const oinkActivityQuery = `
+select "series"."day" as "day",
+	coalesce("members"."count", 0) as "new_members",
+	coalesce("posts"."count", 0) as "new_posts"
+from generate_series(
+	date_trunc('day', now() at time zone 'UTC') - ($2::int - 1) * interval '1 day',
+	date_trunc('day', now() at time zone 'UTC'),
+	interval '1 day'
+) as "series"("day")
+left join (
+	select date_trunc('day', "created_at" at time zone 'UTC') as "day", count(*) as "count"
+	from "oink_members" where "oink" = $1 group by 1
+) as "members" on "members"."day" = "series"."day"
+left join (
+	select date_trunc('day', "created_at" at time zone 'UTC') as "day", count(*) as "count"
+	from "posts" where "oink" = $1 group by 1
+) as "posts" on "posts"."day" = "series"."day"
+order by "series"."day"`
+
+// Activity returns the daily activity of oinkID over the last days days,
+// oldest first.
+func (o *OinkService) Activity(ctx context.Context, oinkID string, days int) (*[]OinkActivity, error) {
+	activity := make([]OinkActivity, 0, days)
+
+	err := queries.Raw(oinkActivityQuery, oinkID, days).Bind(ctx, o.DB, &activity)
+	if err != nil {
+		o.l.Error().Err(err).Msg("service-oinkService-Activity")
+		return nil, err
+	}
+
+	return &activity, nil
+}
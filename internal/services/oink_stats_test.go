@@ -0,0 +1,39 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestOinkServiceActivity(t *testing.T) {
+	t.Run("series", func(t *testing.T) {
+		s, mock := newMockServices(t)
+		today := time.Date(2024, 3, 2, 0, 0, 0, 0, time.UTC)
+		mock.ExpectQuery(`(?i)generate_series`).WithArgs("oink-id", 2).
+			WillReturnRows(sqlmock.NewRows([]string{"day", "new_members", "new_posts"}).
+				AddRow(today.AddDate(0, 0, -1), 0, 0).
+				AddRow(today, 2, 5))
+
+		activity, err := s.OinkService.Activity(context.Background(), "oink-id", 2)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(*activity) != 2 || (*activity)[1].NewPosts != 5 || !(*activity)[1].Day.Equal(today) {
+			t.Fatalf("unexpected activity %+v", *activity)
+		}
+	})
+
+	t.Run("db-error", func(t *testing.T) {
+		s, mock := newMockServices(t)
+		mock.ExpectQuery(`(?i)generate_series`).WillReturnError(errDB)
+
+		_, err := s.OinkService.Activity(context.Background(), "oink-id", 30)
+		if !errors.Is(err, errDB) {
+			t.Fatalf("got %v, want %v", err, errDB)
+		}
+	})
+}
@@ -0,0 +1,109 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	dbmodels "github.com/mrityunjaygr8/go-oink/internal/db/models"
+	"github.com/rs/zerolog"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+var ErrOinkTransferNotFound = errors.New("Oink Transfer Not Found")
+
+type OinkTransferServiceInterface interface {
+	Retrieve(ctx context.Context, oinkID string) (*OinkTransfer, error)
+	Upsert(ctx context.Context, transfer *OinkTransfer) error
+	Delete(ctx context.Context, oinkID string) error
+	DeleteByUser(ctx context.Context, userID string) error
+}
+
+type OinkTransferService struct {
+	DB boil.ContextExecutor
+	l  zerolog.Logger
+}
+
+// OinkTransfer is an offer of an oink's ownership that waits for the
+// recipient to accept it. An oink has at most one pending transfer.
+type OinkTransfer struct {
+	OinkID     string
+	FromUserID string
+	ToUserID   string
+	CreatedAt  time.Time
+}
+
+func dbToServiceOinkTransfer(dbTransfer dbmodels.OinkTransfer) *OinkTransfer {
+	return &OinkTransfer{
+		OinkID:     dbTransfer.Oink,
+		FromUserID: dbTransfer.FromUser,
+		ToUserID:   dbTransfer.ToUser,
+		CreatedAt:  dbTransfer.CreatedAt,
+	}
+}
+
+func (t *OinkTransferService) Retrieve(ctx context.Context, oinkID string) (*OinkTransfer, error) {
+	transfer, err := dbmodels.FindOinkTransfer(ctx, t.DB, oinkID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrOinkTransferNotFound
+		}
+		t.l.Error().Err(err).Msg("service-OinkTransferService-Retrieve")
+		return nil, err
+	}
+
+	return dbToServiceOinkTransfer(*transfer), nil
+}
+
+// Upsert records transfer, replacing any offer already pending for the oink.
+func (t *OinkTransferService) Upsert(ctx context.Context, transfer *OinkTransfer) error {
+	dbTransfer := dbmodels.OinkTransfer{
+		Oink:      transfer.OinkID,
+		FromUser:  transfer.FromUserID,
+		ToUser:    transfer.ToUserID,
+		CreatedAt: time.Now(),
+	}
+
+	err := dbTransfer.Upsert(ctx, t.DB, true,
+		[]string{dbmodels.OinkTransferColumns.Oink},
+		boil.Whitelist(dbmodels.OinkTransferColumns.FromUser, dbmodels.OinkTransferColumns.ToUser, dbmodels.OinkTransferColumns.CreatedAt),
+		boil.Infer(),
+	)
+	if err != nil {
+		t.l.Error().Err(err).Msg("service-OinkTransferService-Upsert")
+		return err
+	}
+
+	transfer.CreatedAt = dbTransfer.CreatedAt
+	return nil
+}
+
+func (t *OinkTransferService) Delete(ctx context.Context, oinkID string) error {
+	deleted, err := dbmodels.OinkTransfers(dbmodels.OinkTransferWhere.Oink.EQ(oinkID)).DeleteAll(ctx, t.DB)
+	if err != nil {
+		t.l.Error().Err(err).Msg("service-OinkTransferService-Delete")
+		return err
+	}
+
+	if deleted == 0 {
+		return ErrOinkTransferNotFound
+	}
+
+	return nil
+}
+
+// DeleteByUser withdraws every transfer offered by or to userID.
+func (t *OinkTransferService) DeleteByUser(ctx context.Context, userID string) error {
+	_, err := dbmodels.OinkTransfers(
+		dbmodels.OinkTransferWhere.FromUser.EQ(userID),
+		qm.Or2(dbmodels.OinkTransferWhere.ToUser.EQ(userID)),
+	).DeleteAll(ctx, t.DB)
+	if err != nil {
+		t.l.Error().Err(err).Msg("service-OinkTransferService-DeleteByUser")
+		return err
+	}
+
+	return nil
+}
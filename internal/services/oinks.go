@@ -2,13 +2,19 @@ package services
 
 import (
 	"context"
+	"database/sql"
+	"errors"
+	"html"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
 	dbmodels "github.com/mrityunjaygr8/go-oink/internal/db/models"
+	"github.com/mrityunjaygr8/go-oink/internal/listquery"
 	"github.com/rs/zerolog"
 	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 )
 
@@ -17,23 +23,55 @@ type OinkService struct {
 	l  zerolog.Logger
 }
 
+var ErrOinkNotFound = errors.New("Oink Not Found")
+
+// The visibilities an oink can have. Unlisted oinks are left out of listings
+// but open to anyone who knows their name; private oinks are hidden from
+// everybody but their members.
+const (
+	OinkVisibilityPublic   = "public"
+	OinkVisibilityUnlisted = "unlisted"
+	OinkVisibilityPrivate  = "private"
+)
+
 type OinksServiceInterface interface {
 	Exists(context.Context, string) (bool, error)
 	Insert(context.Context, *Oink) error
-	List(context.Context) (*[]Oink, error)
+	List(context.Context, string, []string, bool, *listquery.Query, int) (*[]Oink, error)
+	Stream(context.Context, string, []string, bool, *listquery.Query, func(*Oink) error) error
+	Search(context.Context, string, string, string, int, int) (*[]OinkSearchResult, int64, error)
+	Activity(context.Context, string, int) (*[]OinkActivity, error)
+	ListByCreator(context.Context, string) (*[]Oink, error)
+	ListByMember(context.Context, string) (*[]Oink, error)
+	ListOwned(context.Context, string) (*[]Oink, error)
 	Retrieve(context.Context, string) (*Oink, error)
 	RetrieveByName(context.Context, string) (*Oink, error)
+	RetrieveByNameForUpdate(context.Context, string) (*Oink, error)
 	Delete(context.Context, string) error
+	Update(context.Context, *Oink) error
+	UpdateCreator(context.Context, *Oink, string) error
+	UpdateImages(context.Context, *Oink) error
+	Archive(context.Context, *Oink, string) error
+	Unarchive(context.Context, *Oink) error
+	AliasOwner(context.Context, string) (string, error)
+	AliasInsert(context.Context, string, string) error
+	AliasDelete(context.Context, string) error
 }
 
 func dbToServiceOink(dbOink dbmodels.Oink) *Oink {
 	return &Oink{
-		Creator:     dbOink.R.CreatorUser.Username,
+		Creator:     dbOink.Creator,
 		Description: dbOink.Description.String,
 		Name:        dbOink.Name,
+		Visibility:  dbOink.Visibility,
+		Language:    dbOink.Language,
 		CreatedAt:   dbOink.CreatedAt,
 		UpdatedAt:   dbOink.UpdatedAt,
 		ID:          dbOink.ID,
+		ArchivedAt:  dbOink.ArchivedAt.Ptr(),
+		ArchivedBy:  dbOink.ArchivedBy.String,
+		AvatarKey:   dbOink.AvatarKey,
+		BannerKey:   dbOink.BannerKey,
 	}
 }
 
@@ -52,8 +90,14 @@ type Oink struct {
 	ID          string
 	Description string
 	Creator     string
+	Visibility  string
+	Language    string
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
+	ArchivedAt  *time.Time
+	ArchivedBy  string
+	AvatarKey   string
+	BannerKey   string
 }
 
 func (o *OinkService) Exists(ctx context.Context, oinkName string) (bool, error) {
@@ -72,19 +116,53 @@ func (o *OinkService) Insert(ctx context.Context, oink *Oink) error {
 	dbOink.Description = null.StringFrom(oink.Description)
 	dbOink.ID = uuid.New().String()
 	dbOink.Creator = oink.Creator
+	dbOink.Visibility = oink.Visibility
+	dbOink.Language = oink.Language
 	err := dbOink.Insert(ctx, o.DB, boil.Infer())
 	if err != nil {
 		o.l.Error().Err(err).Msg("services-OinksService-Insert")
 		return err
 	}
 	oink.ID = dbOink.ID
+	oink.Visibility = dbOink.Visibility
+	oink.Language = dbOink.Language
 	oink.CreatedAt = dbOink.CreatedAt
 	oink.UpdatedAt = dbOink.UpdatedAt
 	return nil
 }
 
-func (o *OinkService) List(ctx context.Context) (*[]Oink, error) {
-	oinkSlice, err := dbmodels.Oinks(qm.Load(dbmodels.OinkRels.CreatorUser)).All(ctx, o.DB)
+// OinkListSpec describes how oink listings may be filtered and sorted.
+var OinkListSpec = listquery.Spec{
+	Table: dbmodels.TableNames.Oinks,
+	Filters: map[string]listquery.Filter{
+		"creator":        {Column: dbmodels.OinkColumns.Creator, Match: listquery.Equal},
+		"created_after":  {Column: dbmodels.OinkColumns.CreatedAt, Kind: listquery.Time, Match: listquery.After},
+		"created_before": {Column: dbmodels.OinkColumns.CreatedAt, Kind: listquery.Time, Match: listquery.Before},
+		"name_prefix":    {Column: dbmodels.OinkColumns.Name, Match: listquery.Prefix},
+	},
+	Sorts: map[string]listquery.Field{
+		"created_at": {Column: dbmodels.OinkColumns.CreatedAt, Kind: listquery.Time, Value: func(row interface{}) interface{} {
+			return row.(Oink).CreatedAt
+		}},
+		"name": {Column: dbmodels.OinkColumns.Name, Value: func(row interface{}) interface{} {
+			return row.(Oink).Name
+		}},
+	},
+	DefaultSort: "-created_at",
+}
+
+// List returns a page of up to limit oinks listed for viewerID, narrowed down
+// and ordered by q: the public ones and those viewerID is a member of. An
+// empty viewerID lists every oink. When tags are given, only oinks carrying
+// all of them are returned. Archived oinks are left out unless
+// includeArchived is set.
+func (o *OinkService) List(ctx context.Context, viewerID string, tags []string, includeArchived bool, q *listquery.Query, limit int) (*[]Oink, error) {
+	mods := []qm.QueryMod{qm.Load(dbmodels.OinkRels.CreatorUser)}
+	mods = append(mods, q.Where()...)
+	mods = append(mods, q.Page(limit)...)
+	mods = append(mods, listMods(viewerID, tags, includeArchived)...)
+
+	oinkSlice, err := dbmodels.Oinks(mods...).All(ctx, o.DB)
 	if err != nil {
 		o.l.Error().Err(err).Msg("service-oinkService-List")
 		return nil, err
@@ -92,9 +170,202 @@ func (o *OinkService) List(ctx context.Context) (*[]Oink, error) {
 	return dbToServiceOinks(oinkSlice), nil
 }
 
+// Stream hands every oink List would return, from the cursor of q on, to fn
+// one at a time, reading them off the database as fn asks for more. It stops
+// at the first error fn returns, and when ctx is cancelled.
+func (o *OinkService) Stream(ctx context.Context, viewerID string, tags []string, includeArchived bool, q *listquery.Query, fn func(*Oink) error) error {
+	mods := append(q.Where(), q.Seek()...)
+	mods = append(mods, listMods(viewerID, tags, includeArchived)...)
+
+	rows, err := dbmodels.Oinks(mods...).QueryContext(ctx, o.DB)
+	if err != nil {
+		o.l.Error().Err(err).Msg("service-oinkService-Stream-query")
+		return err
+	}
+	defer rows.Close()
+
+	for {
+		var dbOink dbmodels.Oink
+		err := queries.Bind(rows, &dbOink)
+		if errors.Is(err, sql.ErrNoRows) {
+			break
+		}
+		if err != nil {
+			o.l.Error().Err(err).Msg("service-oinkService-Stream-bind")
+			return err
+		}
+
+		if err := fn(dbToServiceOink(dbOink)); err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
+
+// listMods narrows a listing of oinks down to the ones listed for viewerID,
+// carrying all of tags and, unless includeArchived is set, not archived.
+func listMods(viewerID string, tags []string, includeArchived bool) []qm.QueryMod {
+	var mods []qm.QueryMod
+	if viewerID != "" {
+		mods = append(mods, listedFor(viewerID))
+	}
+
+	if !includeArchived {
+		mods = append(mods, dbmodels.OinkWhere.ArchivedAt.IsNull())
+	}
+
+	if len(tags) > 0 {
+		args := make([]interface{}, 0, len(tags)+1)
+		for _, tag := range tags {
+			args = append(args, tag)
+		}
+		args = append(args, len(tags))
+		mods = append(mods, qm.Where(
+			`"oinks"."id" in (select "oink" from "oink_tags" where "tag" in (`+strings.Repeat("?,", len(tags)-1)+`?) group by "oink" having count(*) = ?)`,
+			args...,
+		))
+	}
+
+	return mods
+}
+
+// listedFor restricts a query to the oinks listed for viewerID: the public
+// ones and those viewerID is a member of.
+func listedFor(viewerID string) qm.QueryMod {
+	return qm.Expr(
+		dbmodels.OinkWhere.Visibility.EQ(OinkVisibilityPublic),
+		qm.Or(`"oinks"."id" in (select "oink" from "oink_members" where "user" = ?)`, viewerID),
+	)
+}
+
+// OinkSearchResult is an oink matching a full-text search, with its rank and
+// its name and description as HTML, escaped, with the matching parts wrapped
+// in <mark> tags.
+type OinkSearchResult struct {
+	Oink
+	Rank          float32
+	NameHighlight string
+	Snippet       string
+}
+
+// ts_headline marks matches with these control characters rather than with
+// <mark> tags, so the text around them can be escaped before they are swapped
+// for the tags.
+const (
+	highlightStart = "\x02"
+	highlightStop  = "\x03"
+)
+
+var highlightTags = strings.NewReplacer(highlightStart, "<mark>", highlightStop, "</mark>")
+
+// highlight turns text marked up by ts_headline into HTML.
+func highlight(text string) string {
+	return highlightTags.Replace(html.EscapeString(text))
+}
+
+// Search returns a page of the oinks listed for viewerID whose name or
+// description match query, best match first, and the total number of
+// matches. The query is parsed with websearch_to_tsquery in the text search
+// configuration language. An empty viewerID searches every oink. Archived
+// oinks never match.
+func (o *OinkService) Search(ctx context.Context, viewerID string, language string, query string, limit int, offset int) (*[]OinkSearchResult, int64, error) {
+	mods := []qm.QueryMod{
+		qm.InnerJoin(`(select websearch_to_tsquery(?::regconfig, ?) as "query", ?::regconfig as "config") as "search" on true`, language, query, language),
+		qm.Where(`"oinks"."search_vector" @@ "search"."query"`),
+		dbmodels.OinkWhere.ArchivedAt.IsNull(),
+	}
+	if viewerID != "" {
+		mods = append(mods, listedFor(viewerID))
+	}
+
+	total, err := dbmodels.Oinks(mods...).Count(ctx, o.DB)
+	if err != nil {
+		o.l.Error().Err(err).Msg("service-oinkService-Search-count")
+		return nil, 0, err
+	}
+
+	var rows []struct {
+		dbmodels.Oink `boil:",bind"`
+		Rank          float32 `boil:"rank"`
+		NameHighlight string  `boil:"name_highlight"`
+		Snippet       string  `boil:"snippet"`
+	}
+
+	mods = append(mods,
+		qm.Select(
+			`"oinks".*`,
+			`ts_rank_cd("oinks"."search_vector", "search"."query") as "rank"`,
+			`ts_headline("search"."config", "oinks"."name", "search"."query", E'StartSel=\x02, StopSel=\x03, HighlightAll=true') as "name_highlight"`,
+			`ts_headline("search"."config", coalesce("oinks"."description", ''), "search"."query", E'StartSel=\x02, StopSel=\x03, MaxFragments=2, MaxWords=20, MinWords=5') as "snippet"`,
+		),
+		qm.OrderBy(`"rank" desc, "oinks"."created_at" desc`),
+		qm.Limit(limit),
+		qm.Offset(offset),
+	)
+
+	err = dbmodels.Oinks(mods...).Bind(ctx, o.DB, &rows)
+	if err != nil {
+		o.l.Error().Err(err).Msg("service-oinkService-Search-bind")
+		return nil, 0, err
+	}
+
+	results := make([]OinkSearchResult, 0)
+	for _, row := range rows {
+		results = append(results, OinkSearchResult{
+			Oink:          *dbToServiceOink(row.Oink),
+			Rank:          row.Rank,
+			NameHighlight: highlight(row.NameHighlight),
+			Snippet:       highlight(row.Snippet),
+		})
+	}
+
+	return &results, total, nil
+}
+
+func (o *OinkService) ListByCreator(ctx context.Context, creatorID string) (*[]Oink, error) {
+	oinkSlice, err := dbmodels.Oinks(qm.Load(dbmodels.OinkRels.CreatorUser), dbmodels.OinkWhere.Creator.EQ(creatorID), qm.OrderBy(dbmodels.OinkColumns.CreatedAt)).All(ctx, o.DB)
+	if err != nil {
+		o.l.Error().Err(err).Msg("service-oinkService-ListByCreator")
+		return nil, err
+	}
+	return dbToServiceOinks(oinkSlice), nil
+}
+
+func (o *OinkService) ListByMember(ctx context.Context, userID string) (*[]Oink, error) {
+	oinkSlice, err := dbmodels.Oinks(
+		qm.Load(dbmodels.OinkRels.CreatorUser),
+		qm.InnerJoin(`"oink_members" on "oink_members"."oink" = "oinks"."id"`),
+		qm.Where(`"oink_members"."user" = ?`, userID),
+		qm.OrderBy(`"oink_members"."created_at"`),
+	).All(ctx, o.DB)
+	if err != nil {
+		o.l.Error().Err(err).Msg("service-oinkService-ListByMember")
+		return nil, err
+	}
+	return dbToServiceOinks(oinkSlice), nil
+}
+
+// ListOwned returns the oinks userID created or currently owns.
+func (o *OinkService) ListOwned(ctx context.Context, userID string) (*[]Oink, error) {
+	oinkSlice, err := dbmodels.Oinks(
+		dbmodels.OinkWhere.Creator.EQ(userID),
+		qm.Or(`"oinks"."id" in (select "oink" from "oink_members" where "user" = ? and "role" = ?)`, userID, OinkRoleOwner),
+		qm.OrderBy(dbmodels.OinkColumns.CreatedAt),
+	).All(ctx, o.DB)
+	if err != nil {
+		o.l.Error().Err(err).Msg("service-oinkService-ListOwned")
+		return nil, err
+	}
+	return dbToServiceOinks(oinkSlice), nil
+}
+
 func (o *OinkService) Retrieve(ctx context.Context, oinkID string) (*Oink, error) {
 	oink, err := dbmodels.Oinks(qm.Load(dbmodels.OinkRels.CreatorUser), dbmodels.OinkWhere.ID.EQ(oinkID)).One(ctx, o.DB)
 	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrOinkNotFound
+		}
 		o.l.Error().Err(err).Msg("service-OinkRetrieve-bind")
 		return nil, err
 	}
@@ -104,8 +375,10 @@ func (o *OinkService) Retrieve(ctx context.Context, oinkID string) (*Oink, error
 
 func (o *OinkService) RetrieveByName(ctx context.Context, oinkName string) (*Oink, error) {
 	oink, err := dbmodels.Oinks(qm.Load(dbmodels.OinkRels.CreatorUser), dbmodels.OinkWhere.Name.EQ(oinkName)).One(ctx, o.DB)
-	o.l.Info().Str("oinkName", oinkName).Any("oink", oink).Err(err).Msg("asdfkjh")
 	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrOinkNotFound
+		}
 		o.l.Error().Err(err).Msg("service-OinkRetrieveByName-bind")
 		return nil, err
 	}
@@ -113,9 +386,27 @@ func (o *OinkService) RetrieveByName(ctx context.Context, oinkName string) (*Oin
 	return dbToServiceOink(*oink), nil
 }
 
+// RetrieveByNameForUpdate is RetrieveByName, but locks the row of the oink
+// until the transaction it runs in ends.
+func (o *OinkService) RetrieveByNameForUpdate(ctx context.Context, oinkName string) (*Oink, error) {
+	oink, err := dbmodels.Oinks(qm.Load(dbmodels.OinkRels.CreatorUser), dbmodels.OinkWhere.Name.EQ(oinkName), qm.For("update")).One(ctx, o.DB)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrOinkNotFound
+		}
+		o.l.Error().Err(err).Msg("service-OinkRetrieveByNameForUpdate-bind")
+		return nil, err
+	}
+
+	return dbToServiceOink(*oink), nil
+}
+
 func (o *OinkService) Delete(ctx context.Context, oinkName string) error {
 	oink, err := dbmodels.Oinks(dbmodels.OinkWhere.Name.EQ(oinkName)).One(ctx, o.DB)
 	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrOinkNotFound
+		}
 		o.l.Error().Err(err).Msg("service-oink-delete-findOinks")
 		return err
 	}
@@ -128,3 +419,167 @@ func (o *OinkService) Delete(ctx context.Context, oinkName string) error {
 
 	return nil
 }
+
+func (o *OinkService) Update(ctx context.Context, oink *Oink) error {
+	dbOink, err := dbmodels.FindOink(ctx, o.DB, oink.ID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrOinkNotFound
+		}
+		o.l.Error().Err(err).Msg("service-oink-update-findOink")
+		return err
+	}
+
+	dbOink.Name = oink.Name
+	dbOink.Description = null.StringFrom(oink.Description)
+	dbOink.Visibility = oink.Visibility
+	dbOink.Language = oink.Language
+
+	_, err = dbOink.Update(ctx, o.DB, boil.Whitelist(
+		dbmodels.OinkColumns.Name,
+		dbmodels.OinkColumns.Description,
+		dbmodels.OinkColumns.Visibility,
+		dbmodels.OinkColumns.Language,
+		dbmodels.OinkColumns.UpdatedAt,
+	))
+	if err != nil {
+		o.l.Error().Err(err).Msg("service-oink-update-update")
+		return err
+	}
+
+	oink.UpdatedAt = dbOink.UpdatedAt
+	return nil
+}
+
+// UpdateCreator records creatorID as the creator of oink.
+func (o *OinkService) UpdateCreator(ctx context.Context, oink *Oink, creatorID string) error {
+	dbOink, err := dbmodels.FindOink(ctx, o.DB, oink.ID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrOinkNotFound
+		}
+		o.l.Error().Err(err).Msg("service-oink-UpdateCreator-findOink")
+		return err
+	}
+
+	dbOink.Creator = creatorID
+
+	_, err = dbOink.Update(ctx, o.DB, boil.Whitelist(
+		dbmodels.OinkColumns.Creator,
+		dbmodels.OinkColumns.UpdatedAt,
+	))
+	if err != nil {
+		o.l.Error().Err(err).Msg("service-oink-UpdateCreator-update")
+		return err
+	}
+
+	oink.Creator = dbOink.Creator
+	oink.UpdatedAt = dbOink.UpdatedAt
+	return nil
+}
+
+// UpdateImages records the blob keys of the avatar and the banner of oink.
+func (o *OinkService) UpdateImages(ctx context.Context, oink *Oink) error {
+	dbOink, err := dbmodels.FindOink(ctx, o.DB, oink.ID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrOinkNotFound
+		}
+		o.l.Error().Err(err).Msg("service-oink-UpdateImages-findOink")
+		return err
+	}
+
+	dbOink.AvatarKey = oink.AvatarKey
+	dbOink.BannerKey = oink.BannerKey
+
+	_, err = dbOink.Update(ctx, o.DB, boil.Whitelist(
+		dbmodels.OinkColumns.AvatarKey,
+		dbmodels.OinkColumns.BannerKey,
+		dbmodels.OinkColumns.UpdatedAt,
+	))
+	if err != nil {
+		o.l.Error().Err(err).Msg("service-oink-UpdateImages-update")
+		return err
+	}
+
+	oink.UpdatedAt = dbOink.UpdatedAt
+	return nil
+}
+
+// Archive marks oink as archived by actorID.
+func (o *OinkService) Archive(ctx context.Context, oink *Oink, actorID string) error {
+	return o.setArchived(ctx, oink, null.TimeFrom(time.Now()), null.StringFrom(actorID))
+}
+
+// Unarchive clears the archived state of oink.
+func (o *OinkService) Unarchive(ctx context.Context, oink *Oink) error {
+	return o.setArchived(ctx, oink, null.Time{}, null.String{})
+}
+
+func (o *OinkService) setArchived(ctx context.Context, oink *Oink, archivedAt null.Time, archivedBy null.String) error {
+	dbOink, err := dbmodels.FindOink(ctx, o.DB, oink.ID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrOinkNotFound
+		}
+		o.l.Error().Err(err).Msg("service-oink-setArchived-findOink")
+		return err
+	}
+
+	dbOink.ArchivedAt = archivedAt
+	dbOink.ArchivedBy = archivedBy
+
+	_, err = dbOink.Update(ctx, o.DB, boil.Whitelist(
+		dbmodels.OinkColumns.ArchivedAt,
+		dbmodels.OinkColumns.ArchivedBy,
+		dbmodels.OinkColumns.UpdatedAt,
+	))
+	if err != nil {
+		o.l.Error().Err(err).Msg("service-oink-setArchived-update")
+		return err
+	}
+
+	oink.ArchivedAt = dbOink.ArchivedAt.Ptr()
+	oink.ArchivedBy = dbOink.ArchivedBy.String
+	oink.UpdatedAt = dbOink.UpdatedAt
+	return nil
+}
+
+// AliasOwner returns the ID of the oink that used to be called aliasName.
+func (o *OinkService) AliasOwner(ctx context.Context, aliasName string) (string, error) {
+	alias, err := dbmodels.FindOinkAlias(ctx, o.DB, aliasName)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", ErrOinkNotFound
+		}
+		o.l.Error().Err(err).Msg("service-oink-AliasOwner-findOinkAlias")
+		return "", err
+	}
+
+	return alias.Oink, nil
+}
+
+func (o *OinkService) AliasInsert(ctx context.Context, oinkID string, aliasName string) error {
+	alias := dbmodels.OinkAlias{
+		Name: aliasName,
+		Oink: oinkID,
+	}
+
+	err := alias.Insert(ctx, o.DB, boil.Infer())
+	if err != nil {
+		o.l.Error().Err(err).Msg("service-oink-AliasInsert-insert")
+		return err
+	}
+
+	return nil
+}
+
+func (o *OinkService) AliasDelete(ctx context.Context, aliasName string) error {
+	_, err := dbmodels.OinkAliases(dbmodels.OinkAliasWhere.Name.EQ(aliasName)).DeleteAll(ctx, o.DB)
+	if err != nil {
+		o.l.Error().Err(err).Msg("service-oink-AliasDelete-deleteAll")
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,94 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestOinkServiceLookupNotFound(t *testing.T) {
+	tests := []struct {
+		name   string
+		lookup func(context.Context, *Services) error
+	}{
+		{"Retrieve", func(ctx context.Context, s *Services) error {
+			_, err := s.OinkService.Retrieve(ctx, "id")
+			return err
+		}},
+		{"RetrieveByName", func(ctx context.Context, s *Services) error {
+			_, err := s.OinkService.RetrieveByName(ctx, "chelsea")
+			return err
+		}},
+		{"Delete", func(ctx context.Context, s *Services) error {
+			return s.OinkService.Delete(ctx, "chelsea")
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name+"/missing", func(t *testing.T) {
+			s, mock := newMockServices(t)
+			mock.ExpectQuery(`(?i)from "oinks"`).WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+			err := tt.lookup(context.Background(), s)
+			if !errors.Is(err, ErrOinkNotFound) {
+				t.Fatalf("got %v, want %v", err, ErrOinkNotFound)
+			}
+		})
+
+		t.Run(tt.name+"/db-error", func(t *testing.T) {
+			s, mock := newMockServices(t)
+			mock.ExpectQuery(`(?i)from "oinks"`).WillReturnError(errDB)
+
+			err := tt.lookup(context.Background(), s)
+			if !errors.Is(err, errDB) {
+				t.Fatalf("got %v, want %v", err, errDB)
+			}
+			if errors.Is(err, ErrOinkNotFound) {
+				t.Fatalf("database failure reported as %v", ErrOinkNotFound)
+			}
+		})
+	}
+}
+
+func TestOinkServiceSearch(t *testing.T) {
+	s, mock := newMockServices(t)
+	mock.ExpectQuery(`(?i)count.*websearch_to_tsquery`).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	mock.ExpectQuery(`(?i)ts_rank_cd.*from "oinks".*websearch_to_tsquery`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "description", "creator", "rank", "name_highlight", "snippet"}).
+			AddRow("oink-id", "chelsea", "blues fans", "user-id", 0.5, "chelsea", "\x02blues\x03 fans"))
+
+	results, total, err := s.OinkService.Search(context.Background(), "", "english", "blues", 20, 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if total != 1 || len(*results) != 1 {
+		t.Fatalf("got %d results of %d, want 1 of 1", len(*results), total)
+	}
+	if got := (*results)[0]; got.Name != "chelsea" || got.Snippet != "<mark>blues</mark> fans" || got.Rank != 0.5 {
+		t.Fatalf("unexpected result %+v", got)
+	}
+}
+
+func TestOinkServiceSearchEscapesHighlights(t *testing.T) {
+	description := `<script>alert("blues")</script>`
+	s, mock := newMockServices(t)
+	mock.ExpectQuery(`(?i)count.*websearch_to_tsquery`).WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	mock.ExpectQuery(`(?i)ts_rank_cd.*from "oinks".*websearch_to_tsquery`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "description", "creator", "rank", "name_highlight", "snippet"}).
+			AddRow("oink-id", "chelsea", description, "user-id", 0.5, "chelsea", "<script>alert(\"\x02blues\x03\")</script>"))
+
+	results, _, err := s.OinkService.Search(context.Background(), "", "english", "blues", 20, 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	got := (*results)[0]
+	if want := "&lt;script&gt;alert(&#34;<mark>blues</mark>&#34;)&lt;/script&gt;"; got.Snippet != want {
+		t.Fatalf("got snippet %q, want %q", got.Snippet, want)
+	}
+	if got.Description != description {
+		t.Fatalf("got description %q, want it unchanged", got.Description)
+	}
+}
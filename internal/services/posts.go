@@ -0,0 +1,220 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	dbmodels "github.com/mrityunjaygr8/go-oink/internal/db/models"
+	"github.com/rs/zerolog"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+var ErrPostNotFound = errors.New("Post Not Found")
+
+type PostServiceInterface interface {
+	Insert(ctx context.Context, post *Post) error
+	List(ctx context.Context, oinkID string, limit int, offset int) (*[]Post, error)
+	Count(ctx context.Context, oinkID string) (int64, error)
+	Retrieve(ctx context.Context, oinkID string, postID string) (*Post, error)
+	RetrieveForUpdate(ctx context.Context, oinkID string, postID string) (*Post, error)
+	Update(ctx context.Context, post *Post) error
+	Delete(ctx context.Context, oinkID string, postID string) error
+	UpdateAuthor(ctx context.Context, fromUserID string, toUserID string) error
+	DeleteByAuthor(ctx context.Context, userID string) error
+}
+
+type PostService struct {
+	DB boil.ContextExecutor
+	l  zerolog.Logger
+}
+
+type Post struct {
+	ID             string
+	OinkID         string
+	AuthorID       string
+	AuthorUsername string
+	Body           string
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+func dbToServicePost(dbPost dbmodels.Post) *Post {
+	post := &Post{
+		ID:        dbPost.ID,
+		OinkID:    dbPost.Oink,
+		AuthorID:  dbPost.Author,
+		Body:      dbPost.Body,
+		CreatedAt: dbPost.CreatedAt,
+		UpdatedAt: dbPost.UpdatedAt,
+	}
+	if dbPost.R != nil && dbPost.R.AuthorUser != nil {
+		post.AuthorUsername = dbPost.R.AuthorUser.Username
+	}
+
+	return post
+}
+
+func dbToServicePosts(dbPosts dbmodels.PostSlice) *[]Post {
+	posts := make([]Post, 0)
+
+	for _, p := range dbPosts {
+		posts = append(posts, *dbToServicePost(*p))
+	}
+
+	return &posts
+}
+
+// findPost looks postID up within oinkID, so a post can never be reached
+// through an oink it does not belong to.
+func (p *PostService) findPost(ctx context.Context, oinkID string, postID string, mods ...qm.QueryMod) (*dbmodels.Post, error) {
+	if _, err := uuid.Parse(postID); err != nil {
+		return nil, ErrPostNotFound
+	}
+
+	mods = append(mods, dbmodels.PostWhere.ID.EQ(postID), dbmodels.PostWhere.Oink.EQ(oinkID))
+	post, err := dbmodels.Posts(mods...).One(ctx, p.DB)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrPostNotFound
+		}
+		return nil, err
+	}
+
+	return post, nil
+}
+
+func (p *PostService) Insert(ctx context.Context, post *Post) error {
+	dbPost := dbmodels.Post{}
+	dbPost.ID = uuid.New().String()
+	dbPost.Oink = post.OinkID
+	dbPost.Author = post.AuthorID
+	dbPost.Body = post.Body
+
+	err := dbPost.Insert(ctx, p.DB, boil.Infer())
+	if err != nil {
+		p.l.Error().Err(err).Msg("service-PostService-Insert")
+		return err
+	}
+
+	post.ID = dbPost.ID
+	post.CreatedAt = dbPost.CreatedAt
+	post.UpdatedAt = dbPost.UpdatedAt
+	return nil
+}
+
+// List returns a page of the posts in oinkID, newest first.
+func (p *PostService) List(ctx context.Context, oinkID string, limit int, offset int) (*[]Post, error) {
+	postSlice, err := dbmodels.Posts(
+		qm.Load(dbmodels.PostRels.AuthorUser),
+		dbmodels.PostWhere.Oink.EQ(oinkID),
+		qm.OrderBy(dbmodels.PostColumns.CreatedAt+" DESC, "+dbmodels.PostColumns.ID+" DESC"),
+		qm.Limit(limit),
+		qm.Offset(offset),
+	).All(ctx, p.DB)
+	if err != nil {
+		p.l.Error().Err(err).Msg("service-PostService-List")
+		return nil, err
+	}
+
+	return dbToServicePosts(postSlice), nil
+}
+
+func (p *PostService) Count(ctx context.Context, oinkID string) (int64, error) {
+	count, err := dbmodels.Posts(dbmodels.PostWhere.Oink.EQ(oinkID)).Count(ctx, p.DB)
+	if err != nil {
+		p.l.Error().Err(err).Msg("service-PostService-Count")
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (p *PostService) Retrieve(ctx context.Context, oinkID string, postID string) (*Post, error) {
+	post, err := p.findPost(ctx, oinkID, postID, qm.Load(dbmodels.PostRels.AuthorUser))
+	if err != nil {
+		if !errors.Is(err, ErrPostNotFound) {
+			p.l.Error().Err(err).Msg("service-PostService-Retrieve")
+		}
+		return nil, err
+	}
+
+	return dbToServicePost(*post), nil
+}
+
+// RetrieveForUpdate is Retrieve, but locks the row of the post until the
+// transaction it runs in ends.
+func (p *PostService) RetrieveForUpdate(ctx context.Context, oinkID string, postID string) (*Post, error) {
+	post, err := p.findPost(ctx, oinkID, postID, qm.Load(dbmodels.PostRels.AuthorUser), qm.For("update"))
+	if err != nil {
+		if !errors.Is(err, ErrPostNotFound) {
+			p.l.Error().Err(err).Msg("service-PostService-RetrieveForUpdate")
+		}
+		return nil, err
+	}
+
+	return dbToServicePost(*post), nil
+}
+
+func (p *PostService) Update(ctx context.Context, post *Post) error {
+	dbPost, err := p.findPost(ctx, post.OinkID, post.ID)
+	if err != nil {
+		if !errors.Is(err, ErrPostNotFound) {
+			p.l.Error().Err(err).Msg("service-PostService-Update-findPost")
+		}
+		return err
+	}
+
+	dbPost.Body = post.Body
+
+	_, err = dbPost.Update(ctx, p.DB, boil.Whitelist(dbmodels.PostColumns.Body, dbmodels.PostColumns.UpdatedAt))
+	if err != nil {
+		p.l.Error().Err(err).Msg("service-PostService-Update-update")
+		return err
+	}
+
+	post.UpdatedAt = dbPost.UpdatedAt
+	return nil
+}
+
+func (p *PostService) Delete(ctx context.Context, oinkID string, postID string) error {
+	dbPost, err := p.findPost(ctx, oinkID, postID)
+	if err != nil {
+		if !errors.Is(err, ErrPostNotFound) {
+			p.l.Error().Err(err).Msg("service-PostService-Delete-findPost")
+		}
+		return err
+	}
+
+	_, err = dbPost.Delete(ctx, p.DB)
+	if err != nil {
+		p.l.Error().Err(err).Msg("service-PostService-Delete-delete")
+		return err
+	}
+
+	return nil
+}
+
+// UpdateAuthor credits every post of fromUserID to toUserID.
+func (p *PostService) UpdateAuthor(ctx context.Context, fromUserID string, toUserID string) error {
+	_, err := dbmodels.Posts(dbmodels.PostWhere.Author.EQ(fromUserID)).UpdateAll(ctx, p.DB, dbmodels.M{dbmodels.PostColumns.Author: toUserID})
+	if err != nil {
+		p.l.Error().Err(err).Msg("service-PostService-UpdateAuthor")
+		return err
+	}
+
+	return nil
+}
+
+func (p *PostService) DeleteByAuthor(ctx context.Context, userID string) error {
+	_, err := dbmodels.Posts(dbmodels.PostWhere.Author.EQ(userID)).DeleteAll(ctx, p.DB)
+	if err != nil {
+		p.l.Error().Err(err).Msg("service-PostService-DeleteByAuthor")
+		return err
+	}
+
+	return nil
+}
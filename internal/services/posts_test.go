@@ -0,0 +1,63 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+const testPostID = "7a6f3c1e-3f4e-4a43-9a8e-4a1c2f0c9b1d"
+
+func TestPostServiceLookupNotFound(t *testing.T) {
+	tests := []struct {
+		name   string
+		lookup func(context.Context, *Services, string) error
+	}{
+		{"Retrieve", func(ctx context.Context, s *Services, postID string) error {
+			_, err := s.PostService.Retrieve(ctx, "oink-id", postID)
+			return err
+		}},
+		{"Update", func(ctx context.Context, s *Services, postID string) error {
+			return s.PostService.Update(ctx, &Post{ID: postID, OinkID: "oink-id", Body: "up the blues"})
+		}},
+		{"Delete", func(ctx context.Context, s *Services, postID string) error {
+			return s.PostService.Delete(ctx, "oink-id", postID)
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name+"/missing", func(t *testing.T) {
+			s, mock := newMockServices(t)
+			mock.ExpectQuery(`(?i)from "posts"`).WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+			err := tt.lookup(context.Background(), s, testPostID)
+			if !errors.Is(err, ErrPostNotFound) {
+				t.Fatalf("got %v, want %v", err, ErrPostNotFound)
+			}
+		})
+
+		t.Run(tt.name+"/malformed-id", func(t *testing.T) {
+			s, _ := newMockServices(t)
+
+			err := tt.lookup(context.Background(), s, "not-a-uuid")
+			if !errors.Is(err, ErrPostNotFound) {
+				t.Fatalf("got %v, want %v", err, ErrPostNotFound)
+			}
+		})
+
+		t.Run(tt.name+"/db-error", func(t *testing.T) {
+			s, mock := newMockServices(t)
+			mock.ExpectQuery(`(?i)from "posts"`).WillReturnError(errDB)
+
+			err := tt.lookup(context.Background(), s, testPostID)
+			if !errors.Is(err, errDB) {
+				t.Fatalf("got %v, want %v", err, errDB)
+			}
+			if errors.Is(err, ErrPostNotFound) {
+				t.Fatalf("database failure reported as %v", ErrPostNotFound)
+			}
+		})
+	}
+}
@@ -9,6 +9,13 @@ type Services struct {
 	UserService  UserServiceInterface
 	TokenService TokenServiceInterface
 	OinkService  OinksServiceInterface
+
+	OinkMemberService     OinkMemberServiceInterface
+	OinkTransferService   OinkTransferServiceInterface
+	OinkInvitationService OinkInvitationServiceInterface
+	PostService           PostServiceInterface
+	TagService            TagServiceInterface
+	IdempotencyKeyService IdempotencyKeyServiceInterface
 }
 
 func New(db boil.ContextExecutor, logger zerolog.Logger) *Services {
@@ -16,5 +23,12 @@ func New(db boil.ContextExecutor, logger zerolog.Logger) *Services {
 		UserService:  &UserService{l: logger, DB: db},
 		TokenService: &TokenService{l: logger, DB: db},
 		OinkService:  &OinkService{l: logger, DB: db},
+
+		OinkMemberService:     &OinkMemberService{l: logger, DB: db},
+		OinkTransferService:   &OinkTransferService{l: logger, DB: db},
+		OinkInvitationService: &OinkInvitationService{l: logger, DB: db},
+		PostService:           &PostService{l: logger, DB: db},
+		TagService:            &TagService{l: logger, DB: db},
+		IdempotencyKeyService: &IdempotencyKeyService{l: logger, DB: db},
 	}
 }
@@ -0,0 +1,116 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	dbmodels "github.com/mrityunjaygr8/go-oink/internal/db/models"
+	"github.com/rs/zerolog"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+type TagServiceInterface interface {
+	List(ctx context.Context) (*[]Tag, error)
+	ListForOinks(ctx context.Context, oinkIDs []string) (map[string][]string, error)
+	SetOinkTags(ctx context.Context, oinkID string, names []string) error
+}
+
+type TagService struct {
+	DB boil.ContextExecutor
+	l  zerolog.Logger
+}
+
+type Tag struct {
+	Name      string
+	OinkCount int64
+}
+
+// List returns every tag carried by at least one public oink, along with the
+// number of public oinks carrying it, most used first.
+func (t *TagService) List(ctx context.Context) (*[]Tag, error) {
+	var rows []struct {
+		Name  string `boil:"name"`
+		Count int64  `boil:"count"`
+	}
+
+	err := dbmodels.NewQuery(
+		qm.Select(`"oink_tags"."tag" as name`, `count(*) as count`),
+		qm.From(`"oink_tags"`),
+		qm.InnerJoin(`"oinks" on "oinks"."id" = "oink_tags"."oink"`),
+		dbmodels.OinkWhere.Visibility.EQ(OinkVisibilityPublic),
+		qm.GroupBy(`"oink_tags"."tag"`),
+		qm.OrderBy(`count desc, name`),
+	).Bind(ctx, t.DB, &rows)
+	if err != nil {
+		t.l.Error().Err(err).Msg("service-TagService-List")
+		return nil, err
+	}
+
+	tags := make([]Tag, 0)
+	for _, row := range rows {
+		tags = append(tags, Tag{Name: row.Name, OinkCount: row.Count})
+	}
+
+	return &tags, nil
+}
+
+// ListForOinks returns the tags of every oink in oinkIDs, sorted by name.
+// Oinks without tags are absent from the result.
+func (t *TagService) ListForOinks(ctx context.Context, oinkIDs []string) (map[string][]string, error) {
+	tags := make(map[string][]string)
+	if len(oinkIDs) == 0 {
+		return tags, nil
+	}
+
+	var rows []struct {
+		Oink string `boil:"oink"`
+		Tag  string `boil:"tag"`
+	}
+
+	ids := make([]interface{}, 0, len(oinkIDs))
+	for _, id := range oinkIDs {
+		ids = append(ids, id)
+	}
+
+	err := dbmodels.NewQuery(
+		qm.Select(`"oink"`, `"tag"`),
+		qm.From(`"oink_tags"`),
+		qm.WhereIn(`"oink" in ?`, ids...),
+		qm.OrderBy(`"tag"`),
+	).Bind(ctx, t.DB, &rows)
+	if err != nil {
+		t.l.Error().Err(err).Msg("service-TagService-ListForOinks")
+		return nil, err
+	}
+
+	for _, row := range rows {
+		tags[row.Oink] = append(tags[row.Oink], row.Tag)
+	}
+
+	return tags, nil
+}
+
+// SetOinkTags replaces the tags of oinkID with names, creating the tags that
+// do not exist yet.
+func (t *TagService) SetOinkTags(ctx context.Context, oinkID string, names []string) error {
+	related := make([]*dbmodels.Tag, 0, len(names))
+	for _, name := range names {
+		tag := &dbmodels.Tag{Name: name, CreatedAt: time.Now()}
+		err := tag.Upsert(ctx, t.DB, false, []string{dbmodels.TagColumns.Name}, boil.None(), boil.Infer())
+		if err != nil {
+			t.l.Error().Err(err).Msg("service-TagService-SetOinkTags-upsert")
+			return err
+		}
+		related = append(related, tag)
+	}
+
+	dbOink := &dbmodels.Oink{ID: oinkID}
+	err := dbOink.SetTags(ctx, t.DB, false, related...)
+	if err != nil {
+		t.l.Error().Err(err).Msg("service-TagService-SetOinkTags-setTags")
+		return err
+	}
+
+	return nil
+}
@@ -2,6 +2,8 @@ package services
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"time"
 
 	"github.com/google/uuid"
@@ -11,6 +13,8 @@ import (
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 )
 
+var ErrTokenNotFound = errors.New("Token Not Found")
+
 type TokenServiceInterface interface {
 	TokenListUser(ctx context.Context, userID string) (*[]Token, error)
 	TokenListUserType(ctx context.Context, userID string, tokenType string) (*[]Token, error)
@@ -93,6 +97,9 @@ func (t *TokenService) TokenListUserType(ctx context.Context, userID string, tok
 func (t *TokenService) TokenRetrieve(ctx context.Context, tokenID string) (*Token, error) {
 	token, err := dbmodels.Tokens(qm.Load(dbmodels.TokenRels.TokenUser), dbmodels.TokenWhere.Token.EQ(tokenID)).One(ctx, t.DB)
 	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrTokenNotFound
+		}
 		t.l.Error().Err(err).Msg("service-TokenRetrieve-bind")
 		return nil, err
 	}
@@ -103,6 +110,9 @@ func (t *TokenService) TokenRetrieve(ctx context.Context, tokenID string) (*Toke
 func (t *TokenService) TokenDelete(ctx context.Context, tokenID string) error {
 	token, err := dbmodels.FindToken(ctx, t.DB, tokenID)
 	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrTokenNotFound
+		}
 		t.l.Error().Err(err).Msg("service-TokenDelete-findToken")
 		return err
 	}
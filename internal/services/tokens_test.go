@@ -0,0 +1,49 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestTokenServiceLookupNotFound(t *testing.T) {
+	tests := []struct {
+		name   string
+		lookup func(context.Context, *Services) error
+	}{
+		{"TokenRetrieve", func(ctx context.Context, s *Services) error {
+			_, err := s.TokenService.TokenRetrieve(ctx, "token")
+			return err
+		}},
+		{"TokenDelete", func(ctx context.Context, s *Services) error {
+			return s.TokenService.TokenDelete(ctx, "token")
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name+"/missing", func(t *testing.T) {
+			s, mock := newMockServices(t)
+			mock.ExpectQuery(`(?i)from "tokens"`).WillReturnRows(sqlmock.NewRows([]string{"token"}))
+
+			err := tt.lookup(context.Background(), s)
+			if !errors.Is(err, ErrTokenNotFound) {
+				t.Fatalf("got %v, want %v", err, ErrTokenNotFound)
+			}
+		})
+
+		t.Run(tt.name+"/db-error", func(t *testing.T) {
+			s, mock := newMockServices(t)
+			mock.ExpectQuery(`(?i)from "tokens"`).WillReturnError(errDB)
+
+			err := tt.lookup(context.Background(), s)
+			if !errors.Is(err, errDB) {
+				t.Fatalf("got %v, want %v", err, errDB)
+			}
+			if errors.Is(err, ErrTokenNotFound) {
+				t.Fatalf("database failure reported as %v", ErrTokenNotFound)
+			}
+		})
+	}
+}
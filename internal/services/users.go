@@ -9,8 +9,10 @@ import (
 
 	"github.com/google/uuid"
 	dbmodels "github.com/mrityunjaygr8/go-oink/internal/db/models"
+	"github.com/mrityunjaygr8/go-oink/internal/listquery"
 	"github.com/rs/zerolog"
 	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 )
 
@@ -21,15 +23,27 @@ type UserService struct {
 
 var ErrUserNotFound = errors.New("User Not Found")
 
+// GhostUserID is the system account that takes over the oinks of deleted
+// users. It is created by the migrations and cannot log in.
+const GhostUserID = "00000000-0000-0000-0000-000000000000"
+
+// notGhost keeps the ghost account out of the users listed and searched.
+var notGhost = dbmodels.UserWhere.ID.NEQ(GhostUserID)
+
 type UserServiceInterface interface {
-	List(context.Context) (*[]User, error)
+	List(ctx context.Context, q *listquery.Query, limit int) (*[]User, error)
+	Stream(ctx context.Context, q *listquery.Query, fn func(*User) error) error
 	Insert(context.Context, *User) error
 	Exists(ctx context.Context, query string) (bool, error)
 	ExistsByID(ctx context.Context, query string) (bool, error)
 	ExistsByEmail(ctx context.Context, query string) (bool, error)
 	ExistsByUsername(ctx context.Context, query string) (bool, error)
 	UpdatePassword(ctx context.Context, userID string, password string) error
+	UpdateAdmin(ctx context.Context, userID string, isAdmin bool) error
+	UpdateAvatar(ctx context.Context, userID string, avatarKey string) error
+	Search(ctx context.Context, query string, limit int) (*[]User, error)
 	GetByID(ctx context.Context, userID string) (*User, error)
+	GetByIDForUpdate(ctx context.Context, userID string) (*User, error)
 	GetByEmail(ctx context.Context, email string) (*User, error)
 	GetByUsername(ctx context.Context, username string) (*User, error)
 	Delete(ctx context.Context, userID string) error
@@ -40,6 +54,8 @@ type User struct {
 	ID        string
 	Password  string
 	Username  string
+	IsAdmin   bool
+	AvatarKey string
 	CreatedAt time.Time
 	UpdatedAt time.Time
 }
@@ -50,6 +66,7 @@ func (u *UserService) Insert(ctx context.Context, user *User) error {
 	dbUser.Email = user.Email
 	dbUser.ID = uuid.New().String()
 	dbUser.Password = user.Password
+	dbUser.IsAdmin = user.IsAdmin
 
 	err := dbUser.Insert(ctx, u.DB, boil.Infer())
 	if err != nil {
@@ -65,10 +82,32 @@ func (u *UserService) Insert(ctx context.Context, user *User) error {
 	return nil
 }
 
-func (u *UserService) List(ctx context.Context) (*[]User, error) {
+// UserListSpec describes how user listings may be filtered and sorted.
+var UserListSpec = listquery.Spec{
+	Table: dbmodels.TableNames.Users,
+	Filters: map[string]listquery.Filter{
+		"created_after":   {Column: dbmodels.UserColumns.CreatedAt, Kind: listquery.Time, Match: listquery.After},
+		"created_before":  {Column: dbmodels.UserColumns.CreatedAt, Kind: listquery.Time, Match: listquery.Before},
+		"username_prefix": {Column: dbmodels.UserColumns.Username, Match: listquery.Prefix},
+	},
+	Sorts: map[string]listquery.Field{
+		"created_at": {Column: dbmodels.UserColumns.CreatedAt, Kind: listquery.Time, Value: func(row interface{}) interface{} {
+			return row.(User).CreatedAt
+		}},
+		"username": {Column: dbmodels.UserColumns.Username, Value: func(row interface{}) interface{} {
+			return row.(User).Username
+		}},
+	},
+	DefaultSort: "-created_at",
+}
+
+// List returns a page of up to limit users, narrowed down and ordered by q.
+// The ghost account is never listed.
+func (u *UserService) List(ctx context.Context, q *listquery.Query, limit int) (*[]User, error) {
 	var users []User
 
-	err := dbmodels.Users().Bind(ctx, u.DB, &users)
+	mods := append([]qm.QueryMod{notGhost}, q.Where()...)
+	err := dbmodels.Users(append(mods, q.Page(limit)...)...).Bind(ctx, u.DB, &users)
 	if err != nil {
 		u.l.Error().Err(err).Msg("in-list-erro")
 		return nil, err
@@ -76,6 +115,37 @@ func (u *UserService) List(ctx context.Context) (*[]User, error) {
 	return &users, nil
 }
 
+// Stream hands every user List would return, from the cursor of q on, to fn
+// one at a time, reading them off the database as fn asks for more. It stops
+// at the first error fn returns, and when ctx is cancelled.
+func (u *UserService) Stream(ctx context.Context, q *listquery.Query, fn func(*User) error) error {
+	mods := append([]qm.QueryMod{notGhost}, q.Where()...)
+	rows, err := dbmodels.Users(append(mods, q.Seek()...)...).QueryContext(ctx, u.DB)
+	if err != nil {
+		u.l.Error().Err(err).Msg("service-user-stream-query")
+		return err
+	}
+	defer rows.Close()
+
+	for {
+		var user User
+		err := queries.Bind(rows, &user)
+		if errors.Is(err, sql.ErrNoRows) {
+			break
+		}
+		if err != nil {
+			u.l.Error().Err(err).Msg("service-user-stream-bind")
+			return err
+		}
+
+		if err := fn(&user); err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
+
 func (u *UserService) Exists(ctx context.Context, query string) (bool, error) {
 	exists, err := dbmodels.Users(qm.Expr(dbmodels.UserWhere.Email.EQ(query), qm.Or2(dbmodels.UserWhere.Username.EQ(query)))).Exists(ctx, u.DB)
 	if err != nil {
@@ -126,6 +196,23 @@ func (u *UserService) GetByID(ctx context.Context, userID string) (*User, error)
 
 	return &user, nil
 }
+
+// GetByIDForUpdate is GetByID, but locks the row of the user until the
+// transaction it runs in ends.
+func (u *UserService) GetByIDForUpdate(ctx context.Context, userID string) (*User, error) {
+	var user User
+	err := dbmodels.Users(dbmodels.UserWhere.ID.EQ(userID), qm.For("update")).Bind(ctx, u.DB, &user)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrUserNotFound
+		}
+		u.l.Error().Err(err).Msg("service-user-getByIDForUpdate")
+		return nil, err
+	}
+
+	return &user, nil
+}
+
 func (u *UserService) GetByEmail(ctx context.Context, email string) (*User, error) {
 	var user User
 	err := dbmodels.Users(dbmodels.UserWhere.Email.EQ(email)).Bind(ctx, u.DB, &user)
@@ -156,6 +243,9 @@ func (u *UserService) GetByUsername(ctx context.Context, username string) (*User
 func (u *UserService) UpdatePassword(ctx context.Context, userID string, password string) error {
 	user, err := dbmodels.FindUser(ctx, u.DB, userID)
 	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrUserNotFound
+		}
 		u.l.Error().Err(err).Msg("service-user-UpdatePassword-findUser")
 		return err
 	}
@@ -171,9 +261,77 @@ func (u *UserService) UpdatePassword(ctx context.Context, userID string, passwor
 	return nil
 }
 
+func (u *UserService) UpdateAdmin(ctx context.Context, userID string, isAdmin bool) error {
+	user, err := dbmodels.FindUser(ctx, u.DB, userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrUserNotFound
+		}
+		u.l.Error().Err(err).Msg("service-user-UpdateAdmin-findUser")
+		return err
+	}
+
+	user.IsAdmin = isAdmin
+
+	_, err = user.Update(ctx, u.DB, boil.Whitelist("is_admin", "updated_at"))
+	if err != nil {
+		u.l.Error().Err(err).Msg("service-user-UpdateAdmin-update")
+		return err
+	}
+
+	return nil
+}
+
+// UpdateAvatar records the blob key of the avatar of userID; an empty key
+// removes the avatar.
+func (u *UserService) UpdateAvatar(ctx context.Context, userID string, avatarKey string) error {
+	user, err := dbmodels.FindUser(ctx, u.DB, userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrUserNotFound
+		}
+		u.l.Error().Err(err).Msg("service-user-UpdateAvatar-findUser")
+		return err
+	}
+
+	user.AvatarKey = avatarKey
+
+	_, err = user.Update(ctx, u.DB, boil.Whitelist(dbmodels.UserColumns.AvatarKey, dbmodels.UserColumns.UpdatedAt))
+	if err != nil {
+		u.l.Error().Err(err).Msg("service-user-UpdateAvatar-update")
+		return err
+	}
+
+	return nil
+}
+
+// Search matches query against username and email prefixes, falling back to
+// pg_trgm fuzzy matching. Prefix matches are ranked first, then by similarity.
+// The ghost account never matches.
+func (u *UserService) Search(ctx context.Context, query string, limit int) (*[]User, error) {
+	var users []User
+
+	prefix := listquery.EscapeLike(query) + "%"
+	err := dbmodels.Users(
+		qm.Where("username ILIKE ? OR email ILIKE ? OR username % ? OR email % ?", prefix, prefix, query, query),
+		notGhost,
+		qm.OrderBy("(username ILIKE ? OR email ILIKE ?) desc, greatest(similarity(username, ?), similarity(email, ?)) desc, username", prefix, prefix, query, query),
+		qm.Limit(limit),
+	).Bind(ctx, u.DB, &users)
+	if err != nil {
+		u.l.Error().Err(err).Msg("service-user-search")
+		return nil, err
+	}
+
+	return &users, nil
+}
+
 func (u *UserService) Delete(ctx context.Context, userID string) error {
 	user, err := dbmodels.FindUser(ctx, u.DB, userID)
 	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrUserNotFound
+		}
 		u.l.Error().Err(err).Msg("service-user-delete-findUser")
 		return err
 	}
@@ -0,0 +1,75 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestUserServiceLookupNotFound(t *testing.T) {
+	tests := []struct {
+		name   string
+		lookup func(context.Context, *Services) error
+	}{
+		{"GetByID", func(ctx context.Context, s *Services) error {
+			_, err := s.UserService.GetByID(ctx, "id")
+			return err
+		}},
+		{"GetByEmail", func(ctx context.Context, s *Services) error {
+			_, err := s.UserService.GetByEmail(ctx, "im@oink.in")
+			return err
+		}},
+		{"GetByUsername", func(ctx context.Context, s *Services) error {
+			_, err := s.UserService.GetByUsername(ctx, "im")
+			return err
+		}},
+		{"UpdatePassword", func(ctx context.Context, s *Services) error {
+			return s.UserService.UpdatePassword(ctx, "id", "hash")
+		}},
+		{"Delete", func(ctx context.Context, s *Services) error {
+			return s.UserService.Delete(ctx, "id")
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name+"/missing", func(t *testing.T) {
+			s, mock := newMockServices(t)
+			mock.ExpectQuery(`(?i)from "users"`).WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+			err := tt.lookup(context.Background(), s)
+			if !errors.Is(err, ErrUserNotFound) {
+				t.Fatalf("got %v, want %v", err, ErrUserNotFound)
+			}
+		})
+
+		t.Run(tt.name+"/db-error", func(t *testing.T) {
+			s, mock := newMockServices(t)
+			mock.ExpectQuery(`(?i)from "users"`).WillReturnError(errDB)
+
+			err := tt.lookup(context.Background(), s)
+			if !errors.Is(err, errDB) {
+				t.Fatalf("got %v, want %v", err, errDB)
+			}
+			if errors.Is(err, ErrUserNotFound) {
+				t.Fatalf("database failure reported as %v", ErrUserNotFound)
+			}
+		})
+	}
+}
+
+func TestUserServiceSearchSkipsGhost(t *testing.T) {
+	s, mock := newMockServices(t)
+	mock.ExpectQuery(`(?i)from "users" where \(username ILIKE .*\) and \("users"."id" != \$5\)`).
+		WithArgs("im%", "im%", "im", "im", GhostUserID, "im%", "im%", "im", "im").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "username"}).AddRow("user-id", "im"))
+
+	users, err := s.UserService.Search(context.Background(), "im", 10)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(*users) != 1 {
+		t.Fatalf("got %d users, want 1", len(*users))
+	}
+}
@@ -0,0 +1,32 @@
+package validator
+
+import "fmt"
+
+// Limits on the fields of user accounts, the same for every way of creating
+// or changing one.
+const (
+	EmailMaxChars    = 254
+	UsernameMinChars = 3
+	UsernameMaxChars = 32
+	PasswordMinChars = 8
+	PasswordMaxBytes = 72 // bcrypt ignores anything longer
+)
+
+func ValidateEmail(v *Validator, field string, email string) {
+	v.Check(NotBlank(email), field, "must be provided")
+	v.Check(MaxChars(email, EmailMaxChars), field, fmt.Sprintf("must not be more than %d characters long", EmailMaxChars))
+	v.Check(Matches(email, EmailRX), field, "must be a valid email address")
+}
+
+func ValidateUsername(v *Validator, field string, username string) {
+	v.Check(NotBlank(username), field, "must be provided")
+	v.Check(MinChars(username, UsernameMinChars), field, fmt.Sprintf("must be at least %d characters long", UsernameMinChars))
+	v.Check(MaxChars(username, UsernameMaxChars), field, fmt.Sprintf("must not be more than %d characters long", UsernameMaxChars))
+	v.Check(Matches(username, SlugRX), field, "must only contain lowercase letters and digits, separated by single hyphens or underscores")
+}
+
+func ValidatePassword(v *Validator, field string, password string) {
+	v.Check(password != "", field, "must be provided")
+	v.Check(MinChars(password, PasswordMinChars), field, fmt.Sprintf("must be at least %d characters long", PasswordMinChars))
+	v.Check(len(password) <= PasswordMaxBytes, field, fmt.Sprintf("must not be more than %d bytes long", PasswordMaxBytes))
+}
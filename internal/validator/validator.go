@@ -0,0 +1,70 @@
+// Package validator collects field-level problems with user input.
+package validator
+
+import (
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+var (
+	// EmailRX matches the addresses the WHATWG HTML specification accepts in
+	// email inputs.
+	EmailRX = regexp.MustCompile("^[a-zA-Z0-9.!#$%&'*+\\/=?^_`{|}~-]+@[a-zA-Z0-9](?:[a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?(?:\\.[a-zA-Z0-9](?:[a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?)*$")
+
+	// SlugRX matches lowercase words of letters and digits joined by single
+	// hyphens or underscores.
+	SlugRX = regexp.MustCompile(`^[a-z0-9]+(?:[-_][a-z0-9]+)*$`)
+)
+
+// Validator gathers the messages of every failed check, keyed by field.
+type Validator struct {
+	Errors map[string][]string
+}
+
+func New() *Validator {
+	return &Validator{Errors: make(map[string][]string)}
+}
+
+// Valid reports whether no check has failed.
+func (v *Validator) Valid() bool {
+	return len(v.Errors) == 0
+}
+
+func (v *Validator) AddError(field, message string) {
+	v.Errors[field] = append(v.Errors[field], message)
+}
+
+// Check adds message to field when ok is false.
+func (v *Validator) Check(ok bool, field, message string) {
+	if !ok {
+		v.AddError(field, message)
+	}
+}
+
+func NotBlank(value string) bool {
+	return strings.TrimSpace(value) != ""
+}
+
+// MinChars reports whether value holds at least n characters.
+func MinChars(value string, n int) bool {
+	return utf8.RuneCountInString(value) >= n
+}
+
+// MaxChars reports whether value holds at most n characters.
+func MaxChars(value string, n int) bool {
+	return utf8.RuneCountInString(value) <= n
+}
+
+func Matches(value string, rx *regexp.Regexp) bool {
+	return rx.MatchString(value)
+}
+
+func PermittedValue[T comparable](value T, permitted ...T) bool {
+	for _, p := range permitted {
+		if value == p {
+			return true
+		}
+	}
+	return false
+}
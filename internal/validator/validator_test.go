@@ -0,0 +1,63 @@
+package validator
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestValidatorCollectsMessagesPerField(t *testing.T) {
+	v := New()
+	v.Check(NotBlank(" "), "name", "must be provided")
+	v.Check(MinChars("ab", 3), "name", "must be at least 3 characters long")
+	v.Check(MaxChars("ab", 3), "name", "must not be more than 3 characters long")
+	v.Check(Matches("im@oink.in", EmailRX), "email", "must be a valid email address")
+
+	if v.Valid() {
+		t.Fatal("validator with failed checks reported valid")
+	}
+
+	want := map[string][]string{"name": {"must be provided", "must be at least 3 characters long"}}
+	if !reflect.DeepEqual(v.Errors, want) {
+		t.Fatalf("got %v, want %v", v.Errors, want)
+	}
+}
+
+func TestRegexps(t *testing.T) {
+	tests := []struct {
+		value string
+		rx    string
+		want  bool
+	}{
+		{"im@oink.in", "email", true},
+		{"im.oink.in", "email", false},
+		{"im@", "email", false},
+		{"blues-fans", "slug", true},
+		{"blues_fans2", "slug", true},
+		{"Blues", "slug", false},
+		{"-blues", "slug", false},
+		{"blues--fans", "slug", false},
+		{"blues fans", "slug", false},
+	}
+
+	for _, tt := range tests {
+		rx := SlugRX
+		if tt.rx == "email" {
+			rx = EmailRX
+		}
+		if got := Matches(tt.value, rx); got != tt.want {
+			t.Errorf("Matches(%q, %s) = %v, want %v", tt.value, tt.rx, got, tt.want)
+		}
+	}
+}
+
+func TestValidateEmail(t *testing.T) {
+	long := strings.Repeat("i", EmailMaxChars) + "@oink.in"
+	for email, want := range map[string]bool{"im@oink.in": true, "": false, "im.oink.in": false, long: false} {
+		v := New()
+		ValidateEmail(v, "email", email)
+		if v.Valid() != want {
+			t.Errorf("ValidateEmail(%q) valid = %t, want %t", email, v.Valid(), want)
+		}
+	}
+}
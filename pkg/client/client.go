@@ -0,0 +1,247 @@
+// Package client is a Go client of the go-oink HTTP API.
+package client
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Config points a Client at a go-oink server.
+type Config struct {
+	// BaseURL is the URL the server is reached at, e.g. http://localhost:8080.
+	BaseURL string
+	// Token is the bearer token requests are made with. Login sets it as well.
+	Token string
+	// HTTPClient sends the requests. It defaults to a client with a 30 second
+	// timeout.
+	HTTPClient *http.Client
+	// MaxRetries is how many times a request failing with a network error or
+	// a 5xx status is retried. It defaults to 3; a negative value disables
+	// retries.
+	MaxRetries int
+	// MinBackoff and MaxBackoff bound the wait before a retry, which doubles
+	// from MinBackoff with every attempt. They default to 100ms and 5s.
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+}
+
+// Client calls the endpoints of the go-oink API. It is safe for concurrent
+// use.
+type Client struct {
+	config Config
+
+	mu    sync.RWMutex
+	token string
+}
+
+func New(config Config) *Client {
+	config.BaseURL = strings.TrimSuffix(config.BaseURL, "/")
+	if config.HTTPClient == nil {
+		config.HTTPClient = &http.Client{Timeout: 30 * time.Second}
+	}
+	if config.MaxRetries == 0 {
+		config.MaxRetries = 3
+	}
+	if config.MaxRetries < 0 {
+		config.MaxRetries = 0
+	}
+	if config.MinBackoff <= 0 {
+		config.MinBackoff = 100 * time.Millisecond
+	}
+	if config.MaxBackoff <= 0 {
+		config.MaxBackoff = 5 * time.Second
+	}
+
+	return &Client{config: config, token: config.Token}
+}
+
+// Token returns the bearer token requests are made with.
+func (c *Client) Token() string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.token
+}
+
+// SetToken changes the bearer token requests are made with. An empty token
+// makes them anonymous.
+func (c *Client) SetToken(token string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.token = token
+}
+
+// request describes a call to the API.
+type request struct {
+	method string
+	// path is relative to /api/v1.
+	path        string
+	query       url.Values
+	header      http.Header
+	body        []byte
+	contentType string
+	// anonymous requests are sent without the token.
+	anonymous bool
+}
+
+// jsonRequest is a request with v encoded as its JSON body.
+func jsonRequest(method string, path string, v interface{}) (*request, error) {
+	body, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	return &request{method: method, path: path, body: body, contentType: "application/json"}, nil
+}
+
+// pathf formats a path, escaping each of args as a path segment.
+func pathf(format string, args ...string) string {
+	escaped := make([]interface{}, len(args))
+	for i, arg := range args {
+		escaped[i] = url.PathEscape(arg)
+	}
+	return fmt.Sprintf(format, escaped...)
+}
+
+// do sends req, retrying it on network errors and 5xx statuses, and returns
+// the response when its status is one of ok. Any other status is returned as
+// an *Error. The caller closes the body of the response.
+//
+// POST requests are sent with an Idempotency-Key, which is kept across the
+// retries so the server runs them at most once.
+func (c *Client) do(ctx context.Context, req *request, ok ...int) (*http.Response, error) {
+	if req.method == http.MethodPost {
+		if req.header == nil {
+			req.header = http.Header{}
+		}
+		if req.header.Get("Idempotency-Key") == "" {
+			key, err := idempotencyKey()
+			if err != nil {
+				return nil, err
+			}
+			req.header.Set("Idempotency-Key", key)
+		}
+	}
+
+	for attempt := 0; ; attempt++ {
+		res, err := c.send(ctx, req)
+		retry := err != nil || res.StatusCode >= http.StatusInternalServerError
+		if !retry || attempt >= c.config.MaxRetries || ctx.Err() != nil {
+			if err != nil {
+				return nil, err
+			}
+			return checkStatus(res, ok)
+		}
+
+		if res != nil {
+			io.Copy(io.Discard, res.Body)
+			res.Body.Close()
+		}
+
+		timer := time.NewTimer(c.backoff(attempt))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+func (c *Client) send(ctx context.Context, req *request) (*http.Response, error) {
+	target := c.config.BaseURL + "/api/v1" + req.path
+	if len(req.query) > 0 {
+		target += "?" + req.query.Encode()
+	}
+
+	var body io.Reader
+	if req.body != nil {
+		body = bytes.NewReader(req.body)
+	}
+
+	r, err := http.NewRequestWithContext(ctx, req.method, target, body)
+	if err != nil {
+		return nil, err
+	}
+	for key, values := range req.header {
+		r.Header[key] = values
+	}
+	if r.Header.Get("Accept") == "" {
+		r.Header.Set("Accept", "application/json")
+	}
+	if req.contentType != "" {
+		r.Header.Set("Content-Type", req.contentType)
+	}
+	if token := c.Token(); token != "" && !req.anonymous {
+		r.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	return c.config.HTTPClient.Do(r)
+}
+
+// backoff returns how long to wait before retrying attempt: a random duration
+// of up to twice the previous wait, bounded by MinBackoff and MaxBackoff.
+func (c *Client) backoff(attempt int) time.Duration {
+	ceiling := c.config.MinBackoff
+	for i := 0; i < attempt && ceiling < c.config.MaxBackoff; i++ {
+		ceiling *= 2
+	}
+	if ceiling > c.config.MaxBackoff {
+		ceiling = c.config.MaxBackoff
+	}
+
+	jitter, err := rand.Int(rand.Reader, big.NewInt(int64(ceiling-c.config.MinBackoff)+1))
+	if err != nil {
+		return ceiling
+	}
+	return c.config.MinBackoff + time.Duration(jitter.Int64())
+}
+
+func idempotencyKey() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// checkStatus returns res when its status is one of ok, and the error it
+// carries otherwise.
+func checkStatus(res *http.Response, ok []int) (*http.Response, error) {
+	for _, status := range ok {
+		if res.StatusCode == status {
+			return res, nil
+		}
+	}
+
+	defer res.Body.Close()
+	return nil, decodeError(res)
+}
+
+// call sends req and decodes the JSON body of the response into out, unless
+// out is nil.
+func (c *Client) call(ctx context.Context, req *request, out interface{}, ok ...int) (*http.Response, error) {
+	res, err := c.do(ctx, req, ok...)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if out == nil {
+		io.Copy(io.Discard, res.Body)
+		return res, nil
+	}
+	if err := json.NewDecoder(res.Body).Decode(out); err != nil {
+		return nil, fmt.Errorf("decoding response: %w", err)
+	}
+	return res, nil
+}
@@ -0,0 +1,290 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/mrityunjaygr8/go-oink/internal/repository"
+)
+
+// newTestClient returns a client of a server answering with handler.
+func newTestClient(t *testing.T, handler http.HandlerFunc) *Client {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	return New(Config{BaseURL: server.URL, Token: "secret", MinBackoff: time.Millisecond, MaxBackoff: 2 * time.Millisecond})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func TestErrorsMatchRepository(t *testing.T) {
+	pairs := []struct {
+		client, repository error
+	}{
+		{ErrUserExists, repository.ErrUserExists},
+		{ErrUserNotFound, repository.ErrUserNotFound},
+		{ErrUserCredsInvalid, repository.ErrUserCredsInvalid},
+		{ErrUserDeleteStrategyInvalid, repository.ErrUserDeleteStrategyInvalid},
+		{ErrUserReassignTargetInvalid, repository.ErrUserReassignTargetInvalid},
+		{ErrUserGhost, repository.ErrUserGhost},
+		{ErrOinkNotFound, repository.ErrOinkNotFound},
+		{ErrOinkExists, repository.ErrOinkExists},
+		{ErrOinkVisibilityInvalid, repository.ErrOinkVisibilityInvalid},
+		{ErrOinkLanguageInvalid, repository.ErrOinkLanguageInvalid},
+		{ErrOinkArchived, repository.ErrOinkArchived},
+		{ErrOinkNotArchived, repository.ErrOinkNotArchived},
+		{ErrOinkImageInvalid, repository.ErrOinkImageInvalid},
+		{ErrOinkMemberNotFound, repository.ErrOinkMemberNotFound},
+		{ErrOinkMemberExists, repository.ErrOinkMemberExists},
+		{ErrOinkOwnerRole, repository.ErrOinkOwnerRole},
+		{ErrOinkJoinRequested, repository.ErrOinkJoinRequested},
+		{ErrOinkInvitationNotFound, repository.ErrOinkInvitationNotFound},
+		{ErrOinkTransferNotFound, repository.ErrOinkTransferNotFound},
+		{ErrOinkTransferToOwner, repository.ErrOinkTransferToOwner},
+		{ErrPostNotFound, repository.ErrPostNotFound},
+		{ErrTagInvalid, repository.ErrTagInvalid},
+		{ErrTagsTooMany, repository.ErrTagsTooMany},
+		{ErrIdempotencyKeyMismatch, repository.ErrIdempotencyKeyMismatch},
+		{ErrIdempotencyKeyInProgress, repository.ErrIdempotencyKeyInProgress},
+	}
+
+	for _, pair := range pairs {
+		if pair.client.Error() != pair.repository.Error() {
+			t.Errorf("client error %q does not match repository error %q", pair.client, pair.repository)
+		}
+	}
+}
+
+func TestLogin(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/auth/login":
+			if got := r.Header.Get("Authorization"); got != "" {
+				t.Errorf("login sent Authorization %q", got)
+			}
+			writeJSON(w, http.StatusOK, map[string]interface{}{"token": map[string]string{"token": "fresh", "userID": "u1"}})
+		case "/api/v1/auth/me":
+			if got := r.Header.Get("Authorization"); got != "Bearer fresh" {
+				t.Errorf("Authorization = %q, want %q", got, "Bearer fresh")
+			}
+			w.Header().Set("ETag", `"v1"`)
+			writeJSON(w, http.StatusOK, map[string]interface{}{"user": map[string]string{"id": "u1", "username": "piggy"}})
+		default:
+			http.NotFound(w, r)
+		}
+	})
+
+	token, err := c.Login(context.Background(), "piggy@example.com", "password")
+	if err != nil {
+		t.Fatalf("Login: %v", err)
+	}
+	if token.Token != "fresh" || c.Token() != "fresh" {
+		t.Fatalf("token = %q, client token = %q, want fresh", token.Token, c.Token())
+	}
+
+	user, err := c.Me(context.Background())
+	if err != nil {
+		t.Fatalf("Me: %v", err)
+	}
+	if user.Username != "piggy" || user.ETag != `"v1"` {
+		t.Errorf("user = %+v", user)
+	}
+}
+
+func TestRetries(t *testing.T) {
+	t.Run("server-error", func(t *testing.T) {
+		var mu sync.Mutex
+		var keys []string
+		c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			mu.Lock()
+			defer mu.Unlock()
+
+			keys = append(keys, r.Header.Get("Idempotency-Key"))
+			body, _ := io.ReadAll(r.Body)
+			if string(body) != `{"name":"pigs"}` {
+				t.Errorf("body = %s", body)
+			}
+			if len(keys) < 3 {
+				writeJSON(w, http.StatusServiceUnavailable, map[string]string{"error": "Service Unavailable"})
+				return
+			}
+			writeJSON(w, http.StatusCreated, map[string]interface{}{"oink": map[string]string{"name": "pigs"}})
+		})
+
+		oink, err := c.CreateOink(context.Background(), OinkCreate{Name: "pigs"})
+		if err != nil {
+			t.Fatalf("CreateOink: %v", err)
+		}
+		if oink.Name != "pigs" {
+			t.Errorf("name = %q, want pigs", oink.Name)
+		}
+		if len(keys) != 3 {
+			t.Fatalf("attempts = %d, want 3", len(keys))
+		}
+		if keys[0] == "" || keys[1] != keys[0] || keys[2] != keys[0] {
+			t.Errorf("Idempotency-Key changed across retries: %q", keys)
+		}
+	})
+
+	t.Run("gives-up", func(t *testing.T) {
+		attempts := 0
+		c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			attempts++
+			writeJSON(w, http.StatusInternalServerError, map[string]string{"error": "Internal Server Error"})
+		})
+
+		_, err := c.ListTags(context.Background())
+		var apiErr *Error
+		if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusInternalServerError {
+			t.Fatalf("err = %v, want a 500 *Error", err)
+		}
+		if attempts != 4 {
+			t.Errorf("attempts = %d, want 4", attempts)
+		}
+	})
+
+	t.Run("client-error", func(t *testing.T) {
+		attempts := 0
+		c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			attempts++
+			writeJSON(w, http.StatusNotFound, map[string]string{"error": "Oink does not exist"})
+		})
+
+		if _, err := c.GetOink(context.Background(), "pigs"); err == nil {
+			t.Fatal("GetOink succeeded")
+		}
+		if attempts != 1 {
+			t.Errorf("attempts = %d, want 1", attempts)
+		}
+	})
+
+	t.Run("cancelled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		attempts := 0
+		c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			attempts++
+			cancel()
+			writeJSON(w, http.StatusBadGateway, map[string]string{"error": "Bad Gateway"})
+		})
+
+		if _, err := c.ListTags(ctx); err == nil {
+			t.Fatal("ListTags succeeded")
+		}
+		if attempts != 1 {
+			t.Errorf("attempts = %d, want 1", attempts)
+		}
+	})
+}
+
+func TestErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		status int
+		body   string
+		want   []error
+	}{
+		{"repository-error", http.StatusBadRequest, `{"error":"Oink with this name already exists"}`, []error{ErrOinkExists}},
+		{"not-found", http.StatusNotFound, `{"error":"Oink does not exist"}`, []error{ErrOinkNotFound, ErrNotFound}},
+		{"precondition", http.StatusPreconditionFailed, `{"error":"Resource has changed since it was retrieved"}`, []error{ErrPreconditionFailed}},
+		{"validation", http.StatusUnprocessableEntity, `{"error":{"name":["name is required"]}}`, []error{ErrInvalid}},
+		{"no-body", http.StatusForbidden, ``, []error{ErrForbidden}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+				io.WriteString(w, tt.body)
+			})
+
+			_, err := c.UpdateOink(context.Background(), "pigs", `"v1"`, OinkUpdate{})
+			for _, want := range tt.want {
+				if !errors.Is(err, want) {
+					t.Errorf("errors.Is(%v, %v) = false", err, want)
+				}
+			}
+
+			var apiErr *Error
+			if !errors.As(err, &apiErr) || apiErr.StatusCode != tt.status {
+				t.Fatalf("err = %v, want an *Error with status %d", err, tt.status)
+			}
+			if tt.name == "validation" && apiErr.Fields["name"][0] != "name is required" {
+				t.Errorf("fields = %v", apiErr.Fields)
+			}
+		})
+	}
+}
+
+func TestOinkPreconditions(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			w.Header().Set("ETag", `"v1"`)
+			writeJSON(w, http.StatusOK, map[string]interface{}{"oink": map[string]string{"name": "pigs"}})
+		case http.MethodPatch:
+			if got := r.Header.Get("If-Match"); got != `"v1"` {
+				t.Errorf("If-Match = %q, want %q", got, `"v1"`)
+			}
+			w.Header().Set("ETag", `"v2"`)
+			writeJSON(w, http.StatusOK, map[string]interface{}{"oink": map[string]string{"name": "pigs", "description": "oink"}})
+		}
+	})
+
+	oink, err := c.GetOink(context.Background(), "pigs")
+	if err != nil {
+		t.Fatalf("GetOink: %v", err)
+	}
+
+	description := "oink"
+	updated, err := c.UpdateOink(context.Background(), oink.Name, oink.ETag, OinkUpdate{Description: &description})
+	if err != nil {
+		t.Fatalf("UpdateOink: %v", err)
+	}
+	if updated.ETag != `"v2"` || updated.Description != "oink" {
+		t.Errorf("updated = %+v", updated)
+	}
+}
+
+func TestJoinOinkRequested(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/oinks/secret pigs/members/me" {
+			t.Errorf("path = %q", r.URL.Path)
+		}
+		writeJSON(w, http.StatusAccepted, map[string]string{"message": "Join request sent to the oink's owner"})
+	})
+
+	member, err := c.JoinOink(context.Background(), "secret pigs")
+	if !errors.Is(err, ErrOinkJoinRequested) || member != nil {
+		t.Errorf("JoinOink = %v, %v; want ErrOinkJoinRequested", member, err)
+	}
+}
+
+func TestBulkCreateUsersAtomicFailure(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusUnprocessableEntity, map[string]interface{}{
+			"results":  []map[string]interface{}{{"row": 1, "email": "im@oink.in", "username": "im", "status": BulkStatusFailed, "error": "User already exists"}},
+			"metadata": map[string]interface{}{"mode": BulkModeAtomic, "created": 0, "failed": 1},
+		})
+	})
+
+	report, err := c.BulkCreateUsers(context.Background(), []BulkUser{{Email: "im@oink.in", Username: "im"}}, BulkModeAtomic, false)
+	if err != nil {
+		t.Fatalf("BulkCreateUsers: %v", err)
+	}
+	if report.Metadata.Failed != 1 || report.Results[0].Status != BulkStatusFailed {
+		t.Errorf("BulkCreateUsers = %+v, want the failed row reported", report)
+	}
+}
@@ -0,0 +1,134 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// The errors the server reports. They carry the messages of their
+// counterparts in the server's repository package, which is how responses
+// are matched to them, so errors.Is(err, client.ErrOinkExists) holds for an
+// *Error the server answered with repository.ErrOinkExists.
+var (
+	ErrUserExists                = errors.New("User with this email or username already exists")
+	ErrUserNotFound              = errors.New("User does not exists")
+	ErrUserCredsInvalid          = errors.New("Invalid email/password")
+	ErrUserDeleteStrategyInvalid = errors.New("Strategy must be one of reassign, ghost or cascade")
+	ErrUserReassignTargetInvalid = errors.New("Oinks can only be reassigned to another existing user")
+	ErrUserGhost                 = errors.New("The ghost account cannot be changed or deleted")
+
+	ErrOinkNotFound          = errors.New("Oink does not exist")
+	ErrOinkExists            = errors.New("Oink with this name already exists")
+	ErrOinkVisibilityInvalid = errors.New("Visibility must be one of public, unlisted or private")
+	ErrOinkLanguageInvalid   = errors.New("Language is not a supported text search language")
+	ErrOinkArchived          = errors.New("Oink is archived and can no longer be changed")
+	ErrOinkNotArchived       = errors.New("Oink is not archived")
+	ErrOinkImageInvalid      = errors.New("Image must be one of avatar or banner")
+
+	ErrOinkMemberNotFound     = errors.New("User is not a member of this oink")
+	ErrOinkMemberExists       = errors.New("User is already a member of this oink")
+	ErrOinkOwnerRole          = errors.New("The owner's role can only change through an ownership transfer")
+	ErrOinkInvitationNotFound = errors.New("No invitation or join request is pending for this user")
+	ErrOinkTransferNotFound   = errors.New("No ownership transfer is pending for this user")
+	ErrOinkTransferToOwner    = errors.New("User already owns this oink")
+
+	ErrPostNotFound = errors.New("Post does not exist")
+
+	ErrTagInvalid  = errors.New("Tags may only contain letters, digits and hyphens, up to 32 characters")
+	ErrTagsTooMany = errors.New("An oink can carry at most 10 tags")
+
+	ErrIdempotencyKeyMismatch   = errors.New("Idempotency-Key was already used for a different request")
+	ErrIdempotencyKeyInProgress = errors.New("A request with this Idempotency-Key is still in progress")
+)
+
+// ErrOinkJoinRequested is returned by JoinOink when the oink is private and a
+// request to join it was sent to its owner instead.
+var ErrOinkJoinRequested = errors.New("Join request sent to the oink's owner")
+
+// Errors matching any response with their status, for errors the server
+// reports without a message of their own.
+var (
+	ErrForbidden          = errors.New("forbidden")
+	ErrNotFound           = errors.New("not found")
+	ErrConflict           = errors.New("conflict")
+	ErrPreconditionFailed = errors.New("precondition failed")
+	ErrInvalid            = errors.New("invalid input")
+)
+
+var knownErrors = []error{
+	ErrUserExists, ErrUserNotFound, ErrUserCredsInvalid, ErrUserDeleteStrategyInvalid, ErrUserReassignTargetInvalid, ErrUserGhost,
+	ErrOinkNotFound, ErrOinkExists, ErrOinkVisibilityInvalid, ErrOinkLanguageInvalid, ErrOinkArchived, ErrOinkNotArchived, ErrOinkImageInvalid,
+	ErrOinkMemberNotFound, ErrOinkMemberExists, ErrOinkOwnerRole, ErrOinkInvitationNotFound, ErrOinkTransferNotFound, ErrOinkTransferToOwner,
+	ErrPostNotFound,
+	ErrTagInvalid, ErrTagsTooMany,
+	ErrIdempotencyKeyMismatch, ErrIdempotencyKeyInProgress,
+}
+
+var statusErrors = map[int]error{
+	http.StatusForbidden:           ErrForbidden,
+	http.StatusNotFound:            ErrNotFound,
+	http.StatusConflict:            ErrConflict,
+	http.StatusPreconditionFailed:  ErrPreconditionFailed,
+	http.StatusUnprocessableEntity: ErrInvalid,
+}
+
+// Error is a response the server answered with an error status.
+type Error struct {
+	StatusCode int
+	// Message is the error the server reported.
+	Message string
+	// Fields holds the problems found with each field of invalid input.
+	Fields map[string][]string
+}
+
+func (e *Error) Error() string {
+	if len(e.Fields) > 0 {
+		return fmt.Sprintf("go-oink: %d: invalid input: %v", e.StatusCode, e.Fields)
+	}
+	return fmt.Sprintf("go-oink: %d: %s", e.StatusCode, e.Message)
+}
+
+// Unwrap returns the errors of this package e matches: the one carrying its
+// message, if any, and the one standing for its status.
+func (e *Error) Unwrap() []error {
+	var errs []error
+	for _, known := range knownErrors {
+		if known.Error() == e.Message {
+			errs = append(errs, known)
+			break
+		}
+	}
+	if err, ok := statusErrors[e.StatusCode]; ok {
+		errs = append(errs, err)
+	}
+	return errs
+}
+
+// decodeError reads the {"error": ...} envelope of res into an *Error. The
+// error is either a message or, for invalid input, the problems found with
+// each field.
+func decodeError(res *http.Response) error {
+	apiErr := &Error{StatusCode: res.StatusCode, Message: http.StatusText(res.StatusCode)}
+
+	var envelope struct {
+		Error json.RawMessage `json:"error"`
+	}
+	body, err := io.ReadAll(io.LimitReader(res.Body, 1<<20))
+	if err != nil || json.Unmarshal(body, &envelope) != nil || envelope.Error == nil {
+		return apiErr
+	}
+
+	var message string
+	if json.Unmarshal(envelope.Error, &message) == nil {
+		apiErr.Message = message
+		return apiErr
+	}
+	var fields map[string][]string
+	if json.Unmarshal(envelope.Error, &fields) == nil {
+		apiErr.Fields = fields
+	}
+	return apiErr
+}
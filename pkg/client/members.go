@@ -0,0 +1,124 @@
+package client
+
+import (
+	"context"
+	"net/http"
+)
+
+// ListMembers returns a page of the members of an oink.
+func (c *Client) ListMembers(ctx context.Context, name string, opts PageOptions) (*MemberPage, error) {
+	var page MemberPage
+	req := &request{method: http.MethodGet, path: pathf("/oinks/%s/members", name), query: opts.query()}
+	if _, err := c.call(ctx, req, &page, http.StatusOK); err != nil {
+		return nil, err
+	}
+	return &page, nil
+}
+
+// JoinOink makes the user the client is logged in as a member of an oink.
+// Private oinks are not joined but asked to be: the request goes to their
+// owner and ErrOinkJoinRequested is returned.
+func (c *Client) JoinOink(ctx context.Context, name string) (*Member, error) {
+	var res struct {
+		Member *Member `json:"member"`
+	}
+	resp, err := c.call(ctx, &request{method: http.MethodPost, path: pathf("/oinks/%s/members/me", name)}, &res, http.StatusCreated, http.StatusAccepted)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode == http.StatusAccepted {
+		return nil, ErrOinkJoinRequested
+	}
+	return res.Member, nil
+}
+
+// LeaveOink ends the membership of the user the client is logged in as.
+func (c *Client) LeaveOink(ctx context.Context, name string) error {
+	_, err := c.call(ctx, &request{method: http.MethodDelete, path: pathf("/oinks/%s/members/me", name)}, nil, http.StatusNoContent)
+	return err
+}
+
+// ListInvitations returns the pending invitations to an oink and requests to
+// join it.
+func (c *Client) ListInvitations(ctx context.Context, name string) ([]Invitation, error) {
+	var res struct {
+		Invitations []Invitation `json:"invitations"`
+	}
+	if _, err := c.call(ctx, &request{method: http.MethodGet, path: pathf("/oinks/%s/invitations", name)}, &res, http.StatusOK); err != nil {
+		return nil, err
+	}
+	return res.Invitations, nil
+}
+
+func (c *Client) InviteUser(ctx context.Context, name string, userID string) (*Invitation, error) {
+	req, err := jsonRequest(http.MethodPost, pathf("/oinks/%s/invitations", name), map[string]string{"user_id": userID})
+	if err != nil {
+		return nil, err
+	}
+
+	var res struct {
+		Invitation Invitation `json:"invitation"`
+	}
+	if _, err := c.call(ctx, req, &res, http.StatusCreated); err != nil {
+		return nil, err
+	}
+	return &res.Invitation, nil
+}
+
+// DeleteInvitation withdraws or declines the invitation of a user to an
+// oink, or their request to join it.
+func (c *Client) DeleteInvitation(ctx context.Context, name string, userID string) error {
+	_, err := c.call(ctx, &request{method: http.MethodDelete, path: pathf("/oinks/%s/invitations/%s", name, userID)}, nil, http.StatusNoContent)
+	return err
+}
+
+// ApproveJoinRequest lets a user who asked to join an oink in.
+func (c *Client) ApproveJoinRequest(ctx context.Context, name string, userID string) (*Member, error) {
+	return c.member(ctx, &request{method: http.MethodPost, path: pathf("/oinks/%s/invitations/%s/approve", name, userID)}, http.StatusCreated)
+}
+
+func (c *Client) GrantModerator(ctx context.Context, name string, userID string) (*Member, error) {
+	return c.member(ctx, &request{method: http.MethodPut, path: pathf("/oinks/%s/moderators/%s", name, userID)}, http.StatusOK)
+}
+
+func (c *Client) RevokeModerator(ctx context.Context, name string, userID string) error {
+	_, err := c.call(ctx, &request{method: http.MethodDelete, path: pathf("/oinks/%s/moderators/%s", name, userID)}, nil, http.StatusNoContent)
+	return err
+}
+
+// OfferTransfer offers the ownership of an oink to one of its members.
+func (c *Client) OfferTransfer(ctx context.Context, name string, userID string) (*Transfer, error) {
+	req, err := jsonRequest(http.MethodPost, pathf("/oinks/%s/transfer", name), map[string]string{"user_id": userID})
+	if err != nil {
+		return nil, err
+	}
+
+	var res struct {
+		Transfer Transfer `json:"transfer"`
+	}
+	if _, err := c.call(ctx, req, &res, http.StatusCreated); err != nil {
+		return nil, err
+	}
+	return &res.Transfer, nil
+}
+
+func (c *Client) CancelTransfer(ctx context.Context, name string) error {
+	_, err := c.call(ctx, &request{method: http.MethodDelete, path: pathf("/oinks/%s/transfer", name)}, nil, http.StatusNoContent)
+	return err
+}
+
+// AcceptTransfer takes over the ownership of an oink offered to the user the
+// client is logged in as.
+func (c *Client) AcceptTransfer(ctx context.Context, name string) (*Member, error) {
+	return c.member(ctx, &request{method: http.MethodPost, path: pathf("/oinks/%s/transfer/accept", name)}, http.StatusOK)
+}
+
+func (c *Client) member(ctx context.Context, req *request, ok ...int) (*Member, error) {
+	var res struct {
+		Member Member `json:"member"`
+	}
+	if _, err := c.call(ctx, req, &res, ok...); err != nil {
+		return nil, err
+	}
+	return &res.Member, nil
+}
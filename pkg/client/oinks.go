@@ -0,0 +1,209 @@
+package client
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// OinkListOptions narrows down and orders the oinks ListOinks returns. Zero
+// values leave the server's defaults in place.
+type OinkListOptions struct {
+	// Creator is the ID of the user who created the oinks.
+	Creator       string
+	CreatedAfter  time.Time
+	CreatedBefore time.Time
+	NamePrefix    string
+	// Tags lists tags the oinks all carry.
+	Tags            []string
+	IncludeArchived bool
+	// Sort is created_at or name, prefixed with - for descending order.
+	Sort string
+	// Cursor is the NextCursor of the previous page.
+	Cursor string
+	Limit  int
+}
+
+func (o OinkListOptions) query() url.Values {
+	q := url.Values{}
+	if o.Creator != "" {
+		q.Set("creator", o.Creator)
+	}
+	if !o.CreatedAfter.IsZero() {
+		q.Set("created_after", o.CreatedAfter.Format(time.RFC3339Nano))
+	}
+	if !o.CreatedBefore.IsZero() {
+		q.Set("created_before", o.CreatedBefore.Format(time.RFC3339Nano))
+	}
+	if o.NamePrefix != "" {
+		q.Set("name_prefix", o.NamePrefix)
+	}
+	if len(o.Tags) > 0 {
+		q.Set("tag", strings.Join(o.Tags, ","))
+	}
+	if o.IncludeArchived {
+		q.Set("include", "archived")
+	}
+	if o.Sort != "" {
+		q.Set("sort", o.Sort)
+	}
+	if o.Cursor != "" {
+		q.Set("cursor", o.Cursor)
+	}
+	if o.Limit > 0 {
+		q.Set("limit", strconv.Itoa(o.Limit))
+	}
+	return q
+}
+
+// ListOinks returns a page of the oinks listed for the user the client is
+// logged in as.
+func (c *Client) ListOinks(ctx context.Context, opts OinkListOptions) (*OinkPage, error) {
+	var res struct {
+		Oinks    []Oink `json:"oinks"`
+		Metadata struct {
+			NextCursor string `json:"next_cursor"`
+		} `json:"metadata"`
+	}
+	req := &request{method: http.MethodGet, path: "/oinks", query: opts.query()}
+	if _, err := c.call(ctx, req, &res, http.StatusOK); err != nil {
+		return nil, err
+	}
+
+	return &OinkPage{Oinks: res.Oinks, NextCursor: res.Metadata.NextCursor}, nil
+}
+
+func (c *Client) CreateOink(ctx context.Context, oink OinkCreate) (*Oink, error) {
+	req, err := jsonRequest(http.MethodPost, "/oinks", oink)
+	if err != nil {
+		return nil, err
+	}
+	return c.oink(ctx, req, http.StatusCreated)
+}
+
+// OinkSearchOptions is a full text search of oinks. Zero values leave the
+// server's defaults in place.
+type OinkSearchOptions struct {
+	Query string
+	// Language is the text search language to match Query in.
+	Language string
+	Limit    int
+	Offset   int
+}
+
+// SearchOinks returns a page of the oinks matching opts, best match first.
+func (c *Client) SearchOinks(ctx context.Context, opts OinkSearchOptions) (*OinkSearchPage, error) {
+	req := &request{method: http.MethodGet, path: "/oinks/search", query: PageOptions{Limit: opts.Limit, Offset: opts.Offset}.query()}
+	req.query.Set("q", opts.Query)
+	if opts.Language != "" {
+		req.query.Set("lang", opts.Language)
+	}
+
+	var page OinkSearchPage
+	if _, err := c.call(ctx, req, &page, http.StatusOK); err != nil {
+		return nil, err
+	}
+	return &page, nil
+}
+
+// GetOink returns an oink. Oinks that were renamed are found under their
+// former names as well.
+func (c *Client) GetOink(ctx context.Context, name string) (*Oink, error) {
+	return c.oink(ctx, &request{method: http.MethodGet, path: pathf("/oinks/%s", name)}, http.StatusOK)
+}
+
+// UpdateOink changes an oink. etag is the ETag of the oink as last
+// retrieved; the update fails with ErrPreconditionFailed when the oink
+// changed since.
+func (c *Client) UpdateOink(ctx context.Context, name string, etag string, update OinkUpdate) (*Oink, error) {
+	req, err := jsonRequest(http.MethodPatch, pathf("/oinks/%s", name), update)
+	if err != nil {
+		return nil, err
+	}
+	req.header = http.Header{"If-Match": []string{etag}}
+	return c.oink(ctx, req, http.StatusOK)
+}
+
+// DeleteOink deletes an oink. etag is the ETag of the oink as last
+// retrieved; the deletion fails with ErrPreconditionFailed when the oink
+// changed since.
+func (c *Client) DeleteOink(ctx context.Context, name string, etag string) error {
+	req := &request{method: http.MethodDelete, path: pathf("/oinks/%s", name), header: http.Header{"If-Match": []string{etag}}}
+	_, err := c.call(ctx, req, nil, http.StatusNoContent)
+	return err
+}
+
+func (c *Client) ArchiveOink(ctx context.Context, name string) (*Oink, error) {
+	return c.oink(ctx, &request{method: http.MethodPost, path: pathf("/oinks/%s/archive", name)}, http.StatusOK)
+}
+
+func (c *Client) UnarchiveOink(ctx context.Context, name string) (*Oink, error) {
+	return c.oink(ctx, &request{method: http.MethodDelete, path: pathf("/oinks/%s/archive", name)}, http.StatusOK)
+}
+
+// oink sends req and returns the oink in the response, along with its ETag
+// when the server sent one.
+func (c *Client) oink(ctx context.Context, req *request, ok ...int) (*Oink, error) {
+	var res struct {
+		Oink Oink `json:"oink"`
+	}
+	resp, err := c.call(ctx, req, &res, ok...)
+	if err != nil {
+		return nil, err
+	}
+
+	res.Oink.ETag = resp.Header.Get("ETag")
+	return &res.Oink, nil
+}
+
+// OinkStats returns the activity of an oink over the last days days. A days
+// of 0 leaves the server's default in place.
+func (c *Client) OinkStats(ctx context.Context, name string, days int) (*OinkStats, error) {
+	req := &request{method: http.MethodGet, path: pathf("/oinks/%s/stats", name)}
+	if days > 0 {
+		req.query = url.Values{"days": []string{strconv.Itoa(days)}}
+	}
+
+	var res struct {
+		Stats OinkStats `json:"stats"`
+	}
+	if _, err := c.call(ctx, req, &res, http.StatusOK); err != nil {
+		return nil, err
+	}
+	return &res.Stats, nil
+}
+
+// UploadOinkAvatar sets the avatar of an oink to the image read from r.
+func (c *Client) UploadOinkAvatar(ctx context.Context, name string, filename string, r io.Reader) (*ImageURLs, error) {
+	return c.uploadImage(ctx, pathf("/oinks/%s/avatar", name), "avatar", filename, r)
+}
+
+func (c *Client) DeleteOinkAvatar(ctx context.Context, name string) error {
+	_, err := c.call(ctx, &request{method: http.MethodDelete, path: pathf("/oinks/%s/avatar", name)}, nil, http.StatusNoContent)
+	return err
+}
+
+// UploadOinkBanner sets the banner of an oink to the image read from r.
+func (c *Client) UploadOinkBanner(ctx context.Context, name string, filename string, r io.Reader) (*ImageURLs, error) {
+	return c.uploadImage(ctx, pathf("/oinks/%s/banner", name), "banner", filename, r)
+}
+
+func (c *Client) DeleteOinkBanner(ctx context.Context, name string) error {
+	_, err := c.call(ctx, &request{method: http.MethodDelete, path: pathf("/oinks/%s/banner", name)}, nil, http.StatusNoContent)
+	return err
+}
+
+// ListTags returns the tags oinks carry.
+func (c *Client) ListTags(ctx context.Context) ([]Tag, error) {
+	var res struct {
+		Tags []Tag `json:"tags"`
+	}
+	if _, err := c.call(ctx, &request{method: http.MethodGet, path: "/tags"}, &res, http.StatusOK); err != nil {
+		return nil, err
+	}
+	return res.Tags, nil
+}
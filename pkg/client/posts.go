@@ -0,0 +1,51 @@
+package client
+
+import (
+	"context"
+	"net/http"
+)
+
+// ListPosts returns a page of the posts of an oink.
+func (c *Client) ListPosts(ctx context.Context, name string, opts PageOptions) (*PostPage, error) {
+	var page PostPage
+	req := &request{method: http.MethodGet, path: pathf("/oinks/%s/posts", name), query: opts.query()}
+	if _, err := c.call(ctx, req, &page, http.StatusOK); err != nil {
+		return nil, err
+	}
+	return &page, nil
+}
+
+func (c *Client) CreatePost(ctx context.Context, name string, body string) (*Post, error) {
+	req, err := jsonRequest(http.MethodPost, pathf("/oinks/%s/posts", name), map[string]string{"body": body})
+	if err != nil {
+		return nil, err
+	}
+	return c.post(ctx, req, http.StatusCreated)
+}
+
+func (c *Client) GetPost(ctx context.Context, name string, postID string) (*Post, error) {
+	return c.post(ctx, &request{method: http.MethodGet, path: pathf("/oinks/%s/posts/%s", name, postID)}, http.StatusOK)
+}
+
+func (c *Client) UpdatePost(ctx context.Context, name string, postID string, body string) (*Post, error) {
+	req, err := jsonRequest(http.MethodPatch, pathf("/oinks/%s/posts/%s", name, postID), map[string]string{"body": body})
+	if err != nil {
+		return nil, err
+	}
+	return c.post(ctx, req, http.StatusOK)
+}
+
+func (c *Client) DeletePost(ctx context.Context, name string, postID string) error {
+	_, err := c.call(ctx, &request{method: http.MethodDelete, path: pathf("/oinks/%s/posts/%s", name, postID)}, nil, http.StatusNoContent)
+	return err
+}
+
+func (c *Client) post(ctx context.Context, req *request, ok ...int) (*Post, error) {
+	var res struct {
+		Post Post `json:"post"`
+	}
+	if _, err := c.call(ctx, req, &res, ok...); err != nil {
+		return nil, err
+	}
+	return &res.Post, nil
+}
@@ -0,0 +1,283 @@
+package client
+
+import (
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// ImageURLs locates an uploaded image and its thumbnail.
+type ImageURLs struct {
+	URL          string `json:"url"`
+	ThumbnailURL string `json:"thumbnail_url"`
+}
+
+type Token struct {
+	Token     string    `json:"token"`
+	Type      string    `json:"type"`
+	CreatedAt time.Time `json:"create_at"`
+	UserID    string    `json:"userID"`
+}
+
+type User struct {
+	ID string `json:"id"`
+	// Email is left out of search results for other users.
+	Email     string     `json:"email,omitempty"`
+	Username  string     `json:"username"`
+	Avatar    *ImageURLs `json:"avatar"`
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at"`
+	// ETag is the version of the user DeleteUser is made against. It is only
+	// set by GetUser and Me.
+	ETag string `json:"-"`
+}
+
+type UserCreate struct {
+	Email    string `json:"email"`
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
+
+// UserPage is a page of users. NextCursor is empty on the last page.
+type UserPage struct {
+	Users      []User
+	NextCursor string
+}
+
+// The strategies DeleteUser may deal with the oinks of a user with.
+const (
+	UserDeleteReassign = "reassign"
+	UserDeleteGhost    = "ghost"
+	UserDeleteCascade  = "cascade"
+)
+
+// The modes BulkCreateUsers may run in.
+const (
+	// BulkModeAtomic creates every user or, when one of them fails, none.
+	BulkModeAtomic = "atomic"
+	// BulkModePerRow creates each user on its own.
+	BulkModePerRow = "per_row"
+)
+
+// BulkUser is a user to create with BulkCreateUsers. Password may be left
+// empty when passwords are generated.
+type BulkUser struct {
+	Email    string `json:"email"`
+	Username string `json:"username"`
+	Password string `json:"password,omitempty"`
+}
+
+// The statuses of the users of a bulk import.
+const (
+	BulkStatusCreated = "created"
+	BulkStatusInvalid = "invalid"
+	BulkStatusFailed  = "failed"
+	BulkStatusSkipped = "skipped"
+)
+
+type BulkUserResult struct {
+	// Row is the position of the user in the input, starting at 1.
+	Row      int    `json:"row"`
+	Email    string `json:"email"`
+	Username string `json:"username"`
+	Status   string `json:"status"`
+	ID       string `json:"id,omitempty"`
+	// TemporaryPassword is the password generated for the user.
+	TemporaryPassword string              `json:"temporary_password,omitempty"`
+	Error             string              `json:"error,omitempty"`
+	Errors            map[string][]string `json:"errors,omitempty"`
+}
+
+type BulkUserReport struct {
+	Results  []BulkUserResult `json:"results"`
+	Metadata struct {
+		Mode    string `json:"mode"`
+		Created int    `json:"created"`
+		Failed  int    `json:"failed"`
+	} `json:"metadata"`
+}
+
+// The statuses of an export.
+const (
+	ExportPending = "pending"
+	ExportReady   = "ready"
+	ExportFailed  = "failed"
+)
+
+type Export struct {
+	ID        string    `json:"id"`
+	Status    string    `json:"status"`
+	CreatedAt time.Time `json:"created_at"`
+	// DownloadURL is set once the export is ready.
+	DownloadURL string `json:"download_url,omitempty"`
+}
+
+// The visibilities of an oink.
+const (
+	OinkPublic   = "public"
+	OinkUnlisted = "unlisted"
+	OinkPrivate  = "private"
+)
+
+type Oink struct {
+	ID          string     `json:"id"`
+	Name        string     `json:"name"`
+	Description string     `json:"description"`
+	CreatorID   string     `json:"creator_id"`
+	Visibility  string     `json:"visibility"`
+	Language    string     `json:"language"`
+	Tags        []string   `json:"tags"`
+	Avatar      *ImageURLs `json:"avatar"`
+	Banner      *ImageURLs `json:"banner"`
+	MemberCount int64      `json:"member_count"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+	ArchivedAt  *time.Time `json:"archived_at,omitempty"`
+	ArchivedBy  string     `json:"archived_by,omitempty"`
+	// ETag is the version of the oink UpdateOink and DeleteOink are made
+	// against. It is only set by GetOink and UpdateOink.
+	ETag string `json:"-"`
+}
+
+type OinkCreate struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description,omitempty"`
+	Visibility  string   `json:"visibility,omitempty"`
+	Language    string   `json:"language,omitempty"`
+	Tags        []string `json:"tags,omitempty"`
+}
+
+// OinkUpdate lists the changes to make to an oink. Fields left nil are kept.
+type OinkUpdate struct {
+	Name        *string   `json:"name,omitempty"`
+	Description *string   `json:"description,omitempty"`
+	Visibility  *string   `json:"visibility,omitempty"`
+	Language    *string   `json:"language,omitempty"`
+	Tags        *[]string `json:"tags,omitempty"`
+}
+
+// OinkPage is a page of oinks. NextCursor is empty on the last page.
+type OinkPage struct {
+	Oinks      []Oink
+	NextCursor string
+}
+
+type OinkSearchResult struct {
+	Oink
+	Rank          float32 `json:"rank"`
+	NameHighlight string  `json:"name_highlight"`
+	Snippet       string  `json:"snippet"`
+}
+
+type OinkSearchPage struct {
+	Results  []OinkSearchResult `json:"results"`
+	Metadata PageMetadata       `json:"metadata"`
+}
+
+type OinkActivity struct {
+	Date       string `json:"date"`
+	NewMembers int64  `json:"new_members"`
+	NewPosts   int64  `json:"new_posts"`
+}
+
+type OinkStats struct {
+	Name        string         `json:"name"`
+	CreatedAt   time.Time      `json:"created_at"`
+	AgeDays     int            `json:"age_days"`
+	MemberCount int64          `json:"member_count"`
+	PostCount   int64          `json:"post_count"`
+	Days        int            `json:"days"`
+	NewMembers  int64          `json:"new_members"`
+	NewPosts    int64          `json:"new_posts"`
+	Activity    []OinkActivity `json:"activity"`
+}
+
+// The roles of the members of an oink.
+const (
+	RoleOwner     = "owner"
+	RoleModerator = "moderator"
+	RoleMember    = "member"
+)
+
+type Member struct {
+	OinkID    string    `json:"oink_id"`
+	UserID    string    `json:"user_id"`
+	Username  string    `json:"username,omitempty"`
+	Role      string    `json:"role"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type MemberPage struct {
+	Members  []Member     `json:"members"`
+	Metadata PageMetadata `json:"metadata"`
+}
+
+// The kinds of invitations.
+const (
+	// InvitationKindInvitation is an invitation a moderator sent a user.
+	InvitationKindInvitation = "invitation"
+	// InvitationKindRequest is a request to join a user sent an oink.
+	InvitationKindRequest = "request"
+)
+
+type Invitation struct {
+	OinkID    string    `json:"oink_id"`
+	UserID    string    `json:"user_id"`
+	Username  string    `json:"username,omitempty"`
+	Kind      string    `json:"kind"`
+	CreatedBy string    `json:"created_by"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type Transfer struct {
+	OinkID     string    `json:"oink_id"`
+	FromUserID string    `json:"from_user_id"`
+	ToUserID   string    `json:"to_user_id"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+type Post struct {
+	ID             string    `json:"id"`
+	OinkID         string    `json:"oink_id"`
+	OinkName       string    `json:"oink_name"`
+	AuthorID       string    `json:"author_id"`
+	AuthorUsername string    `json:"author_username"`
+	Body           string    `json:"body"`
+	CreatedAt      time.Time `json:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
+
+type PostPage struct {
+	Posts    []Post       `json:"posts"`
+	Metadata PageMetadata `json:"metadata"`
+}
+
+type Tag struct {
+	Name      string `json:"name"`
+	OinkCount int64  `json:"oink_count"`
+}
+
+// PageMetadata describes a page of an offset paginated listing.
+type PageMetadata struct {
+	Total  int64 `json:"total"`
+	Limit  int   `json:"limit"`
+	Offset int   `json:"offset"`
+}
+
+// PageOptions selects a page of an offset paginated listing. Zero values
+// leave the server's defaults in place.
+type PageOptions struct {
+	Limit  int
+	Offset int
+}
+
+func (o PageOptions) query() url.Values {
+	q := url.Values{}
+	if o.Limit > 0 {
+		q.Set("limit", strconv.Itoa(o.Limit))
+	}
+	if o.Offset > 0 {
+		q.Set("offset", strconv.Itoa(o.Offset))
+	}
+	return q
+}
@@ -0,0 +1,269 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// Login exchanges the credentials of a user for a token, which the client
+// makes its requests with from then on.
+func (c *Client) Login(ctx context.Context, email string, password string) (*Token, error) {
+	req, err := jsonRequest(http.MethodPost, "/auth/login", map[string]string{"email": email, "password": password})
+	if err != nil {
+		return nil, err
+	}
+	req.anonymous = true
+
+	var res struct {
+		Token Token `json:"token"`
+	}
+	if _, err := c.call(ctx, req, &res, http.StatusOK); err != nil {
+		return nil, err
+	}
+
+	c.SetToken(res.Token.Token)
+	return &res.Token, nil
+}
+
+// Me returns the user the client is logged in as.
+func (c *Client) Me(ctx context.Context) (*User, error) {
+	return c.getUser(ctx, "/auth/me")
+}
+
+// UserListOptions narrows down and orders the users ListUsers returns. Zero
+// values leave the server's defaults in place.
+type UserListOptions struct {
+	CreatedAfter   time.Time
+	CreatedBefore  time.Time
+	UsernamePrefix string
+	// Sort is created_at or username, prefixed with - for descending order.
+	Sort string
+	// Cursor is the NextCursor of the previous page.
+	Cursor string
+	Limit  int
+}
+
+func (o UserListOptions) query() url.Values {
+	q := url.Values{}
+	if !o.CreatedAfter.IsZero() {
+		q.Set("created_after", o.CreatedAfter.Format(time.RFC3339Nano))
+	}
+	if !o.CreatedBefore.IsZero() {
+		q.Set("created_before", o.CreatedBefore.Format(time.RFC3339Nano))
+	}
+	if o.UsernamePrefix != "" {
+		q.Set("username_prefix", o.UsernamePrefix)
+	}
+	if o.Sort != "" {
+		q.Set("sort", o.Sort)
+	}
+	if o.Cursor != "" {
+		q.Set("cursor", o.Cursor)
+	}
+	if o.Limit > 0 {
+		q.Set("limit", strconv.Itoa(o.Limit))
+	}
+	return q
+}
+
+// ListUsers returns a page of users.
+func (c *Client) ListUsers(ctx context.Context, opts UserListOptions) (*UserPage, error) {
+	var res struct {
+		Users    []User `json:"users"`
+		Metadata struct {
+			NextCursor string `json:"next_cursor"`
+		} `json:"metadata"`
+	}
+	req := &request{method: http.MethodGet, path: "/users", query: opts.query()}
+	if _, err := c.call(ctx, req, &res, http.StatusOK); err != nil {
+		return nil, err
+	}
+
+	return &UserPage{Users: res.Users, NextCursor: res.Metadata.NextCursor}, nil
+}
+
+// CreateUser signs up a user.
+func (c *Client) CreateUser(ctx context.Context, user UserCreate) (*User, error) {
+	req, err := jsonRequest(http.MethodPost, "/users", user)
+	if err != nil {
+		return nil, err
+	}
+
+	var res struct {
+		User User `json:"user"`
+	}
+	if _, err := c.call(ctx, req, &res, http.StatusCreated); err != nil {
+		return nil, err
+	}
+	return &res.User, nil
+}
+
+// BulkCreateUsers creates users in one request, in mode BulkModeAtomic or
+// BulkModePerRow. generatePasswords makes the server generate passwords for
+// users given without one. The report is returned whether or not every user
+// was created; in atomic mode, none was when Metadata.Failed is not zero.
+func (c *Client) BulkCreateUsers(ctx context.Context, users []BulkUser, mode string, generatePasswords bool) (*BulkUserReport, error) {
+	req, err := jsonRequest(http.MethodPost, "/users/bulk", users)
+	if err != nil {
+		return nil, err
+	}
+	req.query = url.Values{"generate_passwords": []string{strconv.FormatBool(generatePasswords)}}
+	if mode != "" {
+		req.query.Set("mode", mode)
+	}
+
+	var report BulkUserReport
+	if _, err := c.call(ctx, req, &report, http.StatusCreated, http.StatusOK, http.StatusUnprocessableEntity); err != nil {
+		return nil, err
+	}
+	return &report, nil
+}
+
+// SearchUsers returns up to limit users whose username or email matches q.
+// A limit of 0 leaves the server's default in place.
+func (c *Client) SearchUsers(ctx context.Context, q string, limit int) ([]User, error) {
+	req := &request{method: http.MethodGet, path: "/users/search", query: url.Values{"q": []string{q}}}
+	if limit > 0 {
+		req.query.Set("limit", strconv.Itoa(limit))
+	}
+
+	var res struct {
+		Users []User `json:"users"`
+	}
+	if _, err := c.call(ctx, req, &res, http.StatusOK); err != nil {
+		return nil, err
+	}
+	return res.Users, nil
+}
+
+func (c *Client) GetUser(ctx context.Context, userID string) (*User, error) {
+	return c.getUser(ctx, pathf("/users/%s", userID))
+}
+
+func (c *Client) getUser(ctx context.Context, path string) (*User, error) {
+	var res struct {
+		User User `json:"user"`
+	}
+	resp, err := c.call(ctx, &request{method: http.MethodGet, path: path}, &res, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	res.User.ETag = resp.Header.Get("ETag")
+	return &res.User, nil
+}
+
+// DeleteUser deletes a user, dealing with the oinks they created by
+// strategy. reassignTo names the user to hand them to with
+// UserDeleteReassign. etag is the ETag of the user as last retrieved; the
+// deletion fails with ErrPreconditionFailed when the user changed since.
+func (c *Client) DeleteUser(ctx context.Context, userID string, etag string, strategy string, reassignTo string) error {
+	req := &request{method: http.MethodDelete, path: pathf("/users/%s", userID), query: url.Values{}, header: http.Header{"If-Match": []string{etag}}}
+	if strategy != "" {
+		req.query.Set("strategy", strategy)
+	}
+	if reassignTo != "" {
+		req.query.Set("reassign_to", reassignTo)
+	}
+
+	_, err := c.call(ctx, req, nil, http.StatusOK)
+	return err
+}
+
+func (c *Client) UpdateUserPassword(ctx context.Context, userID string, password string) error {
+	req, err := jsonRequest(http.MethodPost, pathf("/users/%s/password", userID), map[string]string{"password": password})
+	if err != nil {
+		return err
+	}
+
+	_, err = c.call(ctx, req, nil, http.StatusOK)
+	return err
+}
+
+// UploadUserAvatar sets the avatar of a user to the image read from r.
+func (c *Client) UploadUserAvatar(ctx context.Context, userID string, filename string, r io.Reader) (*ImageURLs, error) {
+	return c.uploadImage(ctx, pathf("/users/%s/avatar", userID), "avatar", filename, r)
+}
+
+func (c *Client) DeleteUserAvatar(ctx context.Context, userID string) error {
+	_, err := c.call(ctx, &request{method: http.MethodDelete, path: pathf("/users/%s/avatar", userID)}, nil, http.StatusNoContent)
+	return err
+}
+
+// ListUserOinks returns the oinks a user created.
+func (c *Client) ListUserOinks(ctx context.Context, userID string) ([]Oink, error) {
+	var res struct {
+		Oinks []Oink `json:"oinks"`
+	}
+	if _, err := c.call(ctx, &request{method: http.MethodGet, path: pathf("/users/%s/oinks", userID)}, &res, http.StatusOK); err != nil {
+		return nil, err
+	}
+	return res.Oinks, nil
+}
+
+// ExportUser starts an export of the data of a user, or returns the one
+// already under way. Poll it until its Status is ExportReady, then fetch it
+// with DownloadUserExport.
+func (c *Client) ExportUser(ctx context.Context, userID string) (*Export, error) {
+	var res struct {
+		Export Export `json:"export"`
+	}
+	if _, err := c.call(ctx, &request{method: http.MethodGet, path: pathf("/users/%s/export", userID)}, &res, http.StatusOK, http.StatusAccepted); err != nil {
+		return nil, err
+	}
+	return &res.Export, nil
+}
+
+// DownloadUserExport writes the zip archive of a ready export to w.
+func (c *Client) DownloadUserExport(ctx context.Context, userID string, exportID string, w io.Writer) error {
+	req := &request{
+		method: http.MethodGet,
+		path:   pathf("/users/%s/export/%s", userID, exportID),
+		header: http.Header{"Accept": []string{"application/zip"}},
+	}
+	res, err := c.do(ctx, req, http.StatusOK)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	_, err = io.Copy(w, res.Body)
+	return err
+}
+
+// uploadImage sends the image read from r as the multipart upload the image
+// endpoints take, and returns the URLs of the image found under key in the
+// response.
+func (c *Client) uploadImage(ctx context.Context, path string, key string, filename string, r io.Reader) (*ImageURLs, error) {
+	var body bytes.Buffer
+	mw := multipart.NewWriter(&body)
+	part, err := mw.CreateFormFile("image", filename)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := io.Copy(part, r); err != nil {
+		return nil, err
+	}
+	if err := mw.Close(); err != nil {
+		return nil, err
+	}
+
+	var res map[string]json.RawMessage
+	req := &request{method: http.MethodPut, path: path, body: body.Bytes(), contentType: mw.FormDataContentType()}
+	if _, err := c.call(ctx, req, &res, http.StatusOK); err != nil {
+		return nil, err
+	}
+
+	var urls ImageURLs
+	if err := json.Unmarshal(res[key], &urls); err != nil {
+		return nil, err
+	}
+	return &urls, nil
+}